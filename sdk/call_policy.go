@@ -0,0 +1,167 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultCallTimeout 未配置超时时跨进程调用的默认超时
+	DefaultCallTimeout = 5 * time.Second
+	// DefaultSlowCallThreshold 未配置时判定为慢调用的耗时
+	DefaultSlowCallThreshold = time.Second
+)
+
+// defaultMethodTimeouts 插件生命周期调用通常耗时较长，未配置时使用更宽松的超时
+var defaultMethodTimeouts = map[string]time.Duration{
+	"Init":  30 * time.Second,
+	"Start": 30 * time.Second,
+	"Stop":  30 * time.Second,
+}
+
+// CallTimeouts 跨进程调用的超时配置
+// Methods 的键为 gRPC 方法名，如 "OnChatEvent"、"SendCommandWithResponse"、"Init"
+// 超时为负数表示不限制
+//
+// 示例:
+//
+//	client.SetCallTimeouts(sdk.CallTimeouts{
+//	    Default: 2 * time.Second,
+//	    Methods: map[string]time.Duration{"Init": 30 * time.Second},
+//	})
+type CallTimeouts struct {
+	Default       time.Duration            // 默认超时（0 表示 DefaultCallTimeout）
+	Methods       map[string]time.Duration // 按方法覆盖的超时
+	SlowThreshold time.Duration            // 超过该耗时的调用会被记录为慢调用（0 表示 DefaultSlowCallThreshold）
+}
+
+// timeout 返回方法的超时，0 表示不限制
+func (t CallTimeouts) timeout(method string) time.Duration {
+	d, ok := t.Methods[method]
+	if !ok {
+		d = t.Default
+		if def, ok := defaultMethodTimeouts[method]; ok && d < def && d >= 0 {
+			d = def
+		}
+	}
+	if d == 0 {
+		d = DefaultCallTimeout
+	}
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+func (t CallTimeouts) slowThreshold() time.Duration {
+	if t.SlowThreshold <= 0 {
+		return DefaultSlowCallThreshold
+	}
+	return t.SlowThreshold
+}
+
+// CallStat 单个方法的调用统计
+type CallStat struct {
+	Calls    uint64        // 调用次数
+	Slow     uint64        // 耗时超过 SlowThreshold 的次数
+	TimedOut uint64        // 超时被取消的次数
+	Max      time.Duration // 最长耗时
+}
+
+// callPolicy 为跨进程调用设置超时，记录慢调用，并在插件停止时取消所有进行中的调用
+type callPolicy struct {
+	mu       sync.Mutex
+	timeouts CallTimeouts
+	stats    map[string]*CallStat
+	onSlow   func(method string, elapsed time.Duration, err error)
+
+	base   context.Context
+	cancel context.CancelFunc
+}
+
+func newCallPolicy() *callPolicy {
+	base, cancel := context.WithCancel(context.Background())
+	return &callPolicy{
+		stats:  make(map[string]*CallStat),
+		base:   base,
+		cancel: cancel,
+	}
+}
+
+func (p *callPolicy) setTimeouts(timeouts CallTimeouts) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.timeouts = timeouts
+}
+
+// start 开始一次调用，返回带超时的 context 与结束回调
+// 结束回调必须调用，它会释放 context 并记录耗时
+func (p *callPolicy) start(method string) (context.Context, func(error)) {
+	return p.startAtLeast(method, 0)
+}
+
+// startAtLeast 与 start 相同，但超时不少于 min
+// 用于本身带有超时参数的调用（如 SendCommandWithResponse）
+func (p *callPolicy) startAtLeast(method string, min time.Duration) (context.Context, func(error)) {
+	p.mu.Lock()
+	timeout := p.timeouts.timeout(method)
+	threshold := p.timeouts.slowThreshold()
+	p.mu.Unlock()
+
+	ctx, cancel := p.base, context.CancelFunc(func() {})
+	if timeout > 0 {
+		if timeout < min {
+			timeout = min
+		}
+		ctx, cancel = context.WithTimeout(p.base, timeout)
+	}
+
+	begin := time.Now()
+	return ctx, func(err error) {
+		cancel()
+		elapsed := time.Since(begin)
+		timedOut := errors.Is(err, context.DeadlineExceeded) || errors.Is(ctx.Err(), context.DeadlineExceeded)
+		slow := elapsed >= threshold
+
+		p.mu.Lock()
+		stat, ok := p.stats[method]
+		if !ok {
+			stat = &CallStat{}
+			p.stats[method] = stat
+		}
+		stat.Calls++
+		if slow {
+			stat.Slow++
+		}
+		if timedOut {
+			stat.TimedOut++
+		}
+		if elapsed > stat.Max {
+			stat.Max = elapsed
+		}
+		onSlow := p.onSlow
+		p.mu.Unlock()
+
+		if (slow || timedOut) && onSlow != nil {
+			onSlow(method, elapsed, err)
+		}
+	}
+}
+
+// snapshot 返回调用统计的副本
+func (p *callPolicy) snapshot() map[string]CallStat {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	stats := make(map[string]CallStat, len(p.stats))
+	for method, stat := range p.stats {
+		stats[method] = *stat
+	}
+	return stats
+}
+
+// close 取消所有进行中的调用，之后的调用立即失败
+func (p *callPolicy) close() {
+	p.cancel()
+}
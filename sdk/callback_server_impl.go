@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"sync"
+	"time"
 )
 
 // CallbackServerImpl 实现 CallbackService，运行在插件进程中
@@ -12,30 +14,85 @@ import (
 type CallbackServerImpl struct {
 	UnimplementedCallbackServiceServer
 
-	chatHandlers       map[uint32]ChatHandler
-	playerJoinHandlers map[uint32]PlayerEventHandler
+	chatHandlers        map[uint32]ChatHandler
+	playerJoinHandlers  map[uint32]PlayerEventHandler
 	playerLeaveHandlers map[uint32]PlayerEventHandler
-	packetHandlers     map[uint32]PacketHandler
-	preloadHandlers    map[uint32]PreloadHandler
-	activeHandlers     map[uint32]ActiveHandler
-	frameExitHandlers  map[uint32]FrameExitHandler
-	broadcastHandlers  map[uint32]BroadcastHandler
-	consoleHandlers    map[uint32]func([]string) error
+	packetHandlers      map[uint32]PacketHandler
+	bytesHandlers       map[uint32]BytesPacketHandler
+	preloadHandlers     map[uint32]PreloadHandler
+	activeHandlers      map[uint32]ActiveHandler
+	frameExitHandlers   map[uint32]FrameExitHandler
+	broadcastHandlers   map[uint32]BroadcastHandler
+	consoleHandlers     map[uint32]func([]string) error
+	apiHandlers         map[uint32]PluginAPIMethods
 
 	mu sync.RWMutex
+
+	// 插件停止时取消所有处理器的 context
+	base   context.Context
+	cancel context.CancelFunc
+
+	// 处理器耗时超过 slowThreshold 时调用 onSlow
+	slowThreshold time.Duration
+	onSlow        func(method string, elapsed time.Duration)
 }
 
 func NewCallbackServerImpl() *CallbackServerImpl {
+	base, cancel := context.WithCancel(context.Background())
 	return &CallbackServerImpl{
-		chatHandlers:       make(map[uint32]ChatHandler),
-		playerJoinHandlers: make(map[uint32]PlayerEventHandler),
+		base:                base,
+		cancel:              cancel,
+		slowThreshold:       DefaultSlowCallThreshold,
+		chatHandlers:        make(map[uint32]ChatHandler),
+		playerJoinHandlers:  make(map[uint32]PlayerEventHandler),
 		playerLeaveHandlers: make(map[uint32]PlayerEventHandler),
-		packetHandlers:     make(map[uint32]PacketHandler),
-		preloadHandlers:    make(map[uint32]PreloadHandler),
-		activeHandlers:     make(map[uint32]ActiveHandler),
-		frameExitHandlers:  make(map[uint32]FrameExitHandler),
-		broadcastHandlers:  make(map[uint32]BroadcastHandler),
-		consoleHandlers:    make(map[uint32]func([]string) error),
+		packetHandlers:      make(map[uint32]PacketHandler),
+		bytesHandlers:       make(map[uint32]BytesPacketHandler),
+		preloadHandlers:     make(map[uint32]PreloadHandler),
+		activeHandlers:      make(map[uint32]ActiveHandler),
+		frameExitHandlers:   make(map[uint32]FrameExitHandler),
+		broadcastHandlers:   make(map[uint32]BroadcastHandler),
+		consoleHandlers:     make(map[uint32]func([]string) error),
+		apiHandlers:         make(map[uint32]PluginAPIMethods),
+	}
+}
+
+// SetSlowHandlerReporter 设置慢处理器的上报函数
+// 处理器耗时达到 threshold 时调用 report，threshold 为 0 时使用 DefaultSlowCallThreshold
+func (s *CallbackServerImpl) SetSlowHandlerReporter(threshold time.Duration, report func(method string, elapsed time.Duration)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if threshold <= 0 {
+		threshold = DefaultSlowCallThreshold
+	}
+	s.slowThreshold = threshold
+	s.onSlow = report
+}
+
+// Close 取消所有处理器的 context，插件停止时调用
+func (s *CallbackServerImpl) Close() {
+	s.cancel()
+}
+
+// runHandler 执行处理器并统计耗时
+// 处理器收到的 context 在主进程的截止时间到达、主进程取消调用或插件停止时被取消
+func (s *CallbackServerImpl) runHandler(ctx context.Context, method string, handler func(context.Context)) {
+	hctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(s.base, cancel)
+	defer func() {
+		stop()
+		cancel()
+	}()
+
+	begin := time.Now()
+	handler(hctx)
+	elapsed := time.Since(begin)
+
+	s.mu.RLock()
+	threshold, report := s.slowThreshold, s.onSlow
+	s.mu.RUnlock()
+	if report != nil && elapsed >= threshold {
+		report(method, elapsed)
 	}
 }
 
@@ -64,6 +121,12 @@ func (s *CallbackServerImpl) RegisterPacketHandler(callbackID uint32, handler Pa
 	s.packetHandlers[callbackID] = handler
 }
 
+func (s *CallbackServerImpl) RegisterBytesPacketHandler(callbackID uint32, handler BytesPacketHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bytesHandlers[callbackID] = handler
+}
+
 func (s *CallbackServerImpl) RegisterPreloadHandler(callbackID uint32, handler PreloadHandler) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -94,6 +157,30 @@ func (s *CallbackServerImpl) RegisterConsoleCommandHandler(callbackID uint32, ha
 	s.consoleHandlers[callbackID] = handler
 }
 
+// UnregisterHandler 移除回调 ID 对应的 handler
+// 回调 ID 在插件内全局唯一，因此无需区分事件类型
+func (s *CallbackServerImpl) UnregisterHandler(callbackID uint32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.chatHandlers, callbackID)
+	delete(s.playerJoinHandlers, callbackID)
+	delete(s.playerLeaveHandlers, callbackID)
+	delete(s.packetHandlers, callbackID)
+	delete(s.bytesHandlers, callbackID)
+	delete(s.preloadHandlers, callbackID)
+	delete(s.activeHandlers, callbackID)
+	delete(s.frameExitHandlers, callbackID)
+	delete(s.broadcastHandlers, callbackID)
+	delete(s.consoleHandlers, callbackID)
+	delete(s.apiHandlers, callbackID)
+}
+
+func (s *CallbackServerImpl) RegisterPluginAPIHandler(callbackID uint32, methods PluginAPIMethods) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.apiHandlers[callbackID] = methods
+}
+
 // gRPC 回调方法实现
 func (s *CallbackServerImpl) OnChatEvent(ctx context.Context, req *ChatEventRequest) (*ChatEventResponse, error) {
 	s.mu.RLock()
@@ -105,12 +192,33 @@ func (s *CallbackServerImpl) OnChatEvent(ctx context.Context, req *ChatEventRequ
 	}
 
 	event := &ChatEvent{
-		Sender:  req.Sender,
-		Message: req.Message,
+		Sender:     req.Sender,
+		Message:    req.Message,
+		TextType:   byte(req.TextType),
+		Parameters: slices.Clone(req.Parameters),
+		Cancelled:  req.Cancelled,
+	}
+	if len(req.RawData) > 0 {
+		if err := json.Unmarshal(req.RawData, &event.Raw); err != nil {
+			return &ChatEventResponse{Cancel: false}, err
+		}
 	}
 
-	handler(event)
-	return &ChatEventResponse{Cancel: event.Cancelled}, nil
+	s.runHandler(ctx, "OnChatEvent", func(ctx context.Context) {
+		event.ctx = ctx
+		handler(event)
+	})
+
+	// 处理器修改了消息或取消状态时回传完整状态，由主进程写回事件
+	modified := event.Message != req.Message ||
+		event.Cancelled != req.Cancelled ||
+		!slices.Equal(event.Parameters, req.Parameters)
+	resp := &ChatEventResponse{Cancel: event.Cancelled, Modified: modified}
+	if modified {
+		resp.Message = event.Message
+		resp.Parameters = event.Parameters
+	}
+	return resp, nil
 }
 
 func (s *CallbackServerImpl) OnPlayerJoinEvent(ctx context.Context, req *PlayerEventRequest) (*PlayerEventResponse, error) {
@@ -122,14 +230,14 @@ func (s *CallbackServerImpl) OnPlayerJoinEvent(ctx context.Context, req *PlayerE
 		return &PlayerEventResponse{Success: false}, fmt.Errorf("player join handler %d not found", req.CallbackId)
 	}
 
-	// 反序列化 PlayerEvent.Raw
-	var raw interface{}
-	if err := json.Unmarshal(req.RawData, &raw); err != nil {
+	event, err := playerEventFromRequest(req)
+	if err != nil {
 		return &PlayerEventResponse{Success: false}, err
 	}
-
-	event := PlayerEvent{Raw: raw}
-	handler(event)
+	s.runHandler(ctx, "OnPlayerJoinEvent", func(ctx context.Context) {
+		event.ctx = ctx
+		handler(event)
+	})
 
 	return &PlayerEventResponse{Success: true}, nil
 }
@@ -143,17 +251,39 @@ func (s *CallbackServerImpl) OnPlayerLeaveEvent(ctx context.Context, req *Player
 		return &PlayerEventResponse{Success: false}, fmt.Errorf("player leave handler %d not found", req.CallbackId)
 	}
 
-	var raw interface{}
-	if err := json.Unmarshal(req.RawData, &raw); err != nil {
+	event, err := playerEventFromRequest(req)
+	if err != nil {
 		return &PlayerEventResponse{Success: false}, err
 	}
-
-	event := PlayerEvent{Raw: raw}
-	handler(event)
+	s.runHandler(ctx, "OnPlayerLeaveEvent", func(ctx context.Context) {
+		event.ctx = ctx
+		handler(event)
+	})
 
 	return &PlayerEventResponse{Success: true}, nil
 }
 
+// playerEventFromRequest 从 PlayerEventRequest 还原 PlayerEvent
+func playerEventFromRequest(req *PlayerEventRequest) (PlayerEvent, error) {
+	event := PlayerEvent{
+		Name:            req.Name,
+		XUID:            req.Xuid,
+		UUID:            req.Uuid,
+		EntityUniqueID:  req.EntityUniqueId,
+		EntityRuntimeID: req.EntityRuntimeId,
+		BuildPlatform:   req.BuildPlatform,
+		EntryIndex:      int(req.EntryIndex),
+	}
+
+	// 反序列化 PlayerEvent.Raw
+	if len(req.RawData) > 0 {
+		if err := json.Unmarshal(req.RawData, &event.Raw); err != nil {
+			return PlayerEvent{}, err
+		}
+	}
+	return event, nil
+}
+
 func (s *CallbackServerImpl) OnPacketEvent(ctx context.Context, req *PacketEventRequest) (*PacketEventResponse, error) {
 	s.mu.RLock()
 	handler, ok := s.packetHandlers[req.CallbackId]
@@ -163,18 +293,52 @@ func (s *CallbackServerImpl) OnPacketEvent(ctx context.Context, req *PacketEvent
 		return &PacketEventResponse{Success: false}, fmt.Errorf("packet handler %d not found", req.CallbackId)
 	}
 
-	var packet interface{}
-	if err := json.Unmarshal(req.PacketData, &packet); err != nil {
+	event, err := packetEventFromRequest(req)
+	if err != nil {
 		return &PacketEventResponse{Success: false}, err
 	}
+	s.runHandler(ctx, "OnPacketEvent", func(ctx context.Context) {
+		event.ctx = ctx
+		handler(event)
+	})
 
-	event := PacketEvent{
-		ID:  req.PacketId,
-		Raw: packet,
+	return &PacketEventResponse{Success: true}, nil
+}
+
+func (s *CallbackServerImpl) OnBytesPacketEvent(ctx context.Context, req *BytesPacketEventRequest) (*BytesPacketEventResponse, error) {
+	s.mu.RLock()
+	handler, ok := s.bytesHandlers[req.CallbackId]
+	s.mu.RUnlock()
+
+	if !ok {
+		return &BytesPacketEventResponse{Intercept: false}, fmt.Errorf("bytes packet handler %d not found", req.CallbackId)
 	}
-	handler(event)
 
-	return &PacketEventResponse{Success: true}, nil
+	var intercept bool
+	s.runHandler(ctx, "OnBytesPacketEvent", func(context.Context) {
+		intercept = handler(req.PacketId, req.Data)
+	})
+	return &BytesPacketEventResponse{Intercept: intercept}, nil
+}
+
+// packetEventFromRequest 从 PacketEventRequest 还原 PacketEvent
+// 原始字节通过 DefaultPacketCodecs 解码，JSON 数据解码为 interface{}
+func packetEventFromRequest(req *PacketEventRequest) (PacketEvent, error) {
+	event := PacketEvent{ID: req.PacketId}
+	if req.Raw {
+		pk, err := DefaultPacketCodecs.Decode(req.PacketId, req.PacketData)
+		if err != nil {
+			return PacketEvent{}, err
+		}
+		event.Raw = pk
+		event.Data = req.PacketData
+		return event, nil
+	}
+
+	if err := json.Unmarshal(req.PacketData, &event.Raw); err != nil {
+		return PacketEvent{}, err
+	}
+	return event, nil
 }
 
 func (s *CallbackServerImpl) OnPreloadEvent(ctx context.Context, req *PreloadEventRequest) (*PreloadEventResponse, error) {
@@ -186,7 +350,9 @@ func (s *CallbackServerImpl) OnPreloadEvent(ctx context.Context, req *PreloadEve
 		return &PreloadEventResponse{Success: false, Error: "handler not found"}, nil
 	}
 
-	handler()
+	s.runHandler(ctx, "OnPreloadEvent", func(context.Context) {
+		handler()
+	})
 	return &PreloadEventResponse{Success: true}, nil
 }
 
@@ -199,7 +365,9 @@ func (s *CallbackServerImpl) OnActiveEvent(ctx context.Context, req *ActiveEvent
 		return &ActiveEventResponse{Success: false, Error: "handler not found"}, nil
 	}
 
-	handler()
+	s.runHandler(ctx, "OnActiveEvent", func(context.Context) {
+		handler()
+	})
 	return &ActiveEventResponse{Success: true}, nil
 }
 
@@ -217,7 +385,10 @@ func (s *CallbackServerImpl) OnFrameExitEvent(ctx context.Context, req *FrameExi
 		Signal: "",
 		Reason: "",
 	}
-	handler(event)
+	s.runHandler(ctx, "OnFrameExitEvent", func(ctx context.Context) {
+		event.ctx = ctx
+		handler(event)
+	})
 	return &FrameExitEventResponse{Success: true}, nil
 }
 
@@ -240,7 +411,11 @@ func (s *CallbackServerImpl) OnBroadcastEvent(ctx context.Context, req *Broadcas
 		Data: data,
 	}
 
-	result := handler(broadcast)
+	var result interface{}
+	s.runHandler(ctx, "OnBroadcastEvent", func(ctx context.Context) {
+		broadcast.ctx = ctx
+		result = handler(broadcast)
+	})
 
 	resultBytes, err := json.Marshal(result)
 	if err != nil {
@@ -259,10 +434,39 @@ func (s *CallbackServerImpl) OnConsoleCommand(ctx context.Context, req *ConsoleC
 		return &ConsoleCommandResponse{Success: false, Error: "handler not found"}, nil
 	}
 
-	err := handler(req.Args)
+	var err error
+	s.runHandler(ctx, "OnConsoleCommand", func(context.Context) {
+		err = handler(req.Args)
+	})
 	if err != nil {
 		return &ConsoleCommandResponse{Success: false, Error: err.Error()}, nil
 	}
 
 	return &ConsoleCommandResponse{Success: true}, nil
 }
+
+func (s *CallbackServerImpl) OnPluginAPICall(ctx context.Context, req *PluginAPICallRequest) (*PluginAPICallResponse, error) {
+	s.mu.RLock()
+	methods, ok := s.apiHandlers[req.CallbackId]
+	s.mu.RUnlock()
+
+	if !ok {
+		return &PluginAPICallResponse{Success: false, Error: "handler not found"}, nil
+	}
+
+	method, ok := methods[req.Method]
+	if !ok {
+		return &PluginAPICallResponse{Success: false, Error: fmt.Sprintf("method %s not found", req.Method)}, nil
+	}
+
+	var result []byte
+	var err error
+	s.runHandler(ctx, "OnPluginAPICall", func(context.Context) {
+		result, err = method(req.Args)
+	})
+	if err != nil {
+		return &PluginAPICallResponse{Success: false, Error: err.Error()}, nil
+	}
+
+	return &PluginAPICallResponse{Success: true, Result: result}, nil
+}
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.1
-// 	protoc        v4.25.1
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.28.3
 // source: callback_service.proto
 
 package sdk
@@ -11,6 +11,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -22,22 +23,23 @@ const (
 
 // 聊天事件
 type ChatEventRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallbackId    uint32                 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	Sender        string                 `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	TextType      uint32                 `protobuf:"varint,4,opt,name=text_type,json=textType,proto3" json:"text_type,omitempty"`
+	Parameters    []string               `protobuf:"bytes,5,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	RawData       []byte                 `protobuf:"bytes,6,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"` // JSON-encoded ChatEvent.Raw
+	Cancelled     bool                   `protobuf:"varint,7,opt,name=cancelled,proto3" json:"cancelled,omitempty"`           // 更高优先级的处理器是否已取消
 	unknownFields protoimpl.UnknownFields
-
-	CallbackId uint32 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
-	Sender     string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	Message    string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ChatEventRequest) Reset() {
 	*x = ChatEventRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_callback_service_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_callback_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ChatEventRequest) String() string {
@@ -48,7 +50,7 @@ func (*ChatEventRequest) ProtoMessage() {}
 
 func (x *ChatEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_callback_service_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -84,21 +86,50 @@ func (x *ChatEventRequest) GetMessage() string {
 	return ""
 }
 
+func (x *ChatEventRequest) GetTextType() uint32 {
+	if x != nil {
+		return x.TextType
+	}
+	return 0
+}
+
+func (x *ChatEventRequest) GetParameters() []string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *ChatEventRequest) GetRawData() []byte {
+	if x != nil {
+		return x.RawData
+	}
+	return nil
+}
+
+func (x *ChatEventRequest) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
 type ChatEventResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Cancel bool                   `protobuf:"varint,1,opt,name=cancel,proto3" json:"cancel,omitempty"` // 是否取消事件传播
+	// modified 为 true 时主进程以下列字段覆盖事件，供后续处理器使用
+	Modified      bool     `protobuf:"varint,2,opt,name=modified,proto3" json:"modified,omitempty"`
+	Message       string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Parameters    []string `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Cancel bool `protobuf:"varint,1,opt,name=cancel,proto3" json:"cancel,omitempty"` // 是否取消事件传播
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ChatEventResponse) Reset() {
 	*x = ChatEventResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_callback_service_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_callback_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ChatEventResponse) String() string {
@@ -109,7 +140,7 @@ func (*ChatEventResponse) ProtoMessage() {}
 
 func (x *ChatEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_callback_service_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -131,23 +162,48 @@ func (x *ChatEventResponse) GetCancel() bool {
 	return false
 }
 
+func (x *ChatEventResponse) GetModified() bool {
+	if x != nil {
+		return x.Modified
+	}
+	return false
+}
+
+func (x *ChatEventResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ChatEventResponse) GetParameters() []string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
 // 玩家事件
 type PlayerEventRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CallbackId uint32 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
-	RawData    []byte `protobuf:"bytes,2,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"` // protocol.packet.PlayerListEntry 序列化数据
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CallbackId      uint32                 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	RawData         []byte                 `protobuf:"bytes,2,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"` // protocol.packet.PlayerListEntry 序列化数据
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Xuid            string                 `protobuf:"bytes,4,opt,name=xuid,proto3" json:"xuid,omitempty"`
+	Uuid            string                 `protobuf:"bytes,5,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	EntityUniqueId  int64                  `protobuf:"varint,6,opt,name=entity_unique_id,json=entityUniqueId,proto3" json:"entity_unique_id,omitempty"`
+	EntityRuntimeId uint64                 `protobuf:"varint,7,opt,name=entity_runtime_id,json=entityRuntimeId,proto3" json:"entity_runtime_id,omitempty"`
+	BuildPlatform   int32                  `protobuf:"varint,8,opt,name=build_platform,json=buildPlatform,proto3" json:"build_platform,omitempty"`
+	EntryIndex      int32                  `protobuf:"varint,9,opt,name=entry_index,json=entryIndex,proto3" json:"entry_index,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *PlayerEventRequest) Reset() {
 	*x = PlayerEventRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_callback_service_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_callback_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *PlayerEventRequest) String() string {
@@ -158,7 +214,7 @@ func (*PlayerEventRequest) ProtoMessage() {}
 
 func (x *PlayerEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_callback_service_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -187,21 +243,67 @@ func (x *PlayerEventRequest) GetRawData() []byte {
 	return nil
 }
 
+func (x *PlayerEventRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerEventRequest) GetXuid() string {
+	if x != nil {
+		return x.Xuid
+	}
+	return ""
+}
+
+func (x *PlayerEventRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *PlayerEventRequest) GetEntityUniqueId() int64 {
+	if x != nil {
+		return x.EntityUniqueId
+	}
+	return 0
+}
+
+func (x *PlayerEventRequest) GetEntityRuntimeId() uint64 {
+	if x != nil {
+		return x.EntityRuntimeId
+	}
+	return 0
+}
+
+func (x *PlayerEventRequest) GetBuildPlatform() int32 {
+	if x != nil {
+		return x.BuildPlatform
+	}
+	return 0
+}
+
+func (x *PlayerEventRequest) GetEntryIndex() int32 {
+	if x != nil {
+		return x.EntryIndex
+	}
+	return 0
+}
+
 type PlayerEventResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PlayerEventResponse) Reset() {
 	*x = PlayerEventResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_callback_service_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_callback_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *PlayerEventResponse) String() string {
@@ -212,7 +314,7 @@ func (*PlayerEventResponse) ProtoMessage() {}
 
 func (x *PlayerEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_callback_service_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -236,22 +338,20 @@ func (x *PlayerEventResponse) GetSuccess() bool {
 
 // 数据包事件
 type PacketEventRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallbackId    uint32                 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	PacketId      uint32                 `protobuf:"varint,2,opt,name=packet_id,json=packetId,proto3" json:"packet_id,omitempty"`
+	PacketData    []byte                 `protobuf:"bytes,3,opt,name=packet_data,json=packetData,proto3" json:"packet_data,omitempty"` // packet 序列化数据
+	Raw           bool                   `protobuf:"varint,4,opt,name=raw,proto3" json:"raw,omitempty"`                                // true 时 packet_data 为原始协议字节，否则为 JSON
 	unknownFields protoimpl.UnknownFields
-
-	CallbackId uint32 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
-	PacketId   uint32 `protobuf:"varint,2,opt,name=packet_id,json=packetId,proto3" json:"packet_id,omitempty"`
-	PacketData []byte `protobuf:"bytes,3,opt,name=packet_data,json=packetData,proto3" json:"packet_data,omitempty"` // packet 序列化数据
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PacketEventRequest) Reset() {
 	*x = PacketEventRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_callback_service_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_callback_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *PacketEventRequest) String() string {
@@ -262,7 +362,7 @@ func (*PacketEventRequest) ProtoMessage() {}
 
 func (x *PacketEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_callback_service_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -298,21 +398,25 @@ func (x *PacketEventRequest) GetPacketData() []byte {
 	return nil
 }
 
+func (x *PacketEventRequest) GetRaw() bool {
+	if x != nil {
+		return x.Raw
+	}
+	return false
+}
+
 type PacketEventResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PacketEventResponse) Reset() {
 	*x = PacketEventResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_callback_service_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_callback_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *PacketEventResponse) String() string {
@@ -323,7 +427,7 @@ func (*PacketEventResponse) ProtoMessage() {}
 
 func (x *PacketEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_callback_service_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -345,22 +449,124 @@ func (x *PacketEventResponse) GetSuccess() bool {
 	return false
 }
 
-// 预加载事件
-type PreloadEventRequest struct {
-	state         protoimpl.MessageState
+// 二进制数据包事件
+type BytesPacketEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallbackId    uint32                 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	PacketId      uint32                 `protobuf:"varint,2,opt,name=packet_id,json=packetId,proto3" json:"packet_id,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"` // 原始协议字节
+	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BytesPacketEventRequest) Reset() {
+	*x = BytesPacketEventRequest{}
+	mi := &file_callback_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BytesPacketEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesPacketEventRequest) ProtoMessage() {}
+
+func (x *BytesPacketEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_callback_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesPacketEventRequest.ProtoReflect.Descriptor instead.
+func (*BytesPacketEventRequest) Descriptor() ([]byte, []int) {
+	return file_callback_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BytesPacketEventRequest) GetCallbackId() uint32 {
+	if x != nil {
+		return x.CallbackId
+	}
+	return 0
+}
+
+func (x *BytesPacketEventRequest) GetPacketId() uint32 {
+	if x != nil {
+		return x.PacketId
+	}
+	return 0
+}
+
+func (x *BytesPacketEventRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BytesPacketEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Intercept     bool                   `protobuf:"varint,1,opt,name=intercept,proto3" json:"intercept,omitempty"` // 是否拦截：阻止低优先级处理器与主进程默认处理
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-	CallbackId uint32 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+func (x *BytesPacketEventResponse) Reset() {
+	*x = BytesPacketEventResponse{}
+	mi := &file_callback_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *PreloadEventRequest) Reset() {
-	*x = PreloadEventRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_callback_service_proto_msgTypes[6]
+func (x *BytesPacketEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesPacketEventResponse) ProtoMessage() {}
+
+func (x *BytesPacketEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_callback_service_proto_msgTypes[7]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesPacketEventResponse.ProtoReflect.Descriptor instead.
+func (*BytesPacketEventResponse) Descriptor() ([]byte, []int) {
+	return file_callback_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BytesPacketEventResponse) GetIntercept() bool {
+	if x != nil {
+		return x.Intercept
 	}
+	return false
+}
+
+// 预加载事件
+type PreloadEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallbackId    uint32                 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreloadEventRequest) Reset() {
+	*x = PreloadEventRequest{}
+	mi := &file_callback_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *PreloadEventRequest) String() string {
@@ -370,8 +576,8 @@ func (x *PreloadEventRequest) String() string {
 func (*PreloadEventRequest) ProtoMessage() {}
 
 func (x *PreloadEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_callback_service_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_callback_service_proto_msgTypes[8]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -383,7 +589,7 @@ func (x *PreloadEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PreloadEventRequest.ProtoReflect.Descriptor instead.
 func (*PreloadEventRequest) Descriptor() ([]byte, []int) {
-	return file_callback_service_proto_rawDescGZIP(), []int{6}
+	return file_callback_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PreloadEventRequest) GetCallbackId() uint32 {
@@ -394,21 +600,18 @@ func (x *PreloadEventRequest) GetCallbackId() uint32 {
 }
 
 type PreloadEventResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PreloadEventResponse) Reset() {
 	*x = PreloadEventResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_callback_service_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_callback_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *PreloadEventResponse) String() string {
@@ -418,8 +621,8 @@ func (x *PreloadEventResponse) String() string {
 func (*PreloadEventResponse) ProtoMessage() {}
 
 func (x *PreloadEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_callback_service_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_callback_service_proto_msgTypes[9]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -431,7 +634,7 @@ func (x *PreloadEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PreloadEventResponse.ProtoReflect.Descriptor instead.
 func (*PreloadEventResponse) Descriptor() ([]byte, []int) {
-	return file_callback_service_proto_rawDescGZIP(), []int{7}
+	return file_callback_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PreloadEventResponse) GetSuccess() bool {
@@ -450,20 +653,17 @@ func (x *PreloadEventResponse) GetError() string {
 
 // 激活事件
 type ActiveEventRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallbackId    uint32                 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	CallbackId uint32 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ActiveEventRequest) Reset() {
 	*x = ActiveEventRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_callback_service_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_callback_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ActiveEventRequest) String() string {
@@ -473,8 +673,8 @@ func (x *ActiveEventRequest) String() string {
 func (*ActiveEventRequest) ProtoMessage() {}
 
 func (x *ActiveEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_callback_service_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_callback_service_proto_msgTypes[10]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -486,7 +686,7 @@ func (x *ActiveEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActiveEventRequest.ProtoReflect.Descriptor instead.
 func (*ActiveEventRequest) Descriptor() ([]byte, []int) {
-	return file_callback_service_proto_rawDescGZIP(), []int{8}
+	return file_callback_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ActiveEventRequest) GetCallbackId() uint32 {
@@ -497,21 +697,18 @@ func (x *ActiveEventRequest) GetCallbackId() uint32 {
 }
 
 type ActiveEventResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ActiveEventResponse) Reset() {
 	*x = ActiveEventResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_callback_service_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_callback_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ActiveEventResponse) String() string {
@@ -521,8 +718,8 @@ func (x *ActiveEventResponse) String() string {
 func (*ActiveEventResponse) ProtoMessage() {}
 
 func (x *ActiveEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_callback_service_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_callback_service_proto_msgTypes[11]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -534,7 +731,7 @@ func (x *ActiveEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActiveEventResponse.ProtoReflect.Descriptor instead.
 func (*ActiveEventResponse) Descriptor() ([]byte, []int) {
-	return file_callback_service_proto_rawDescGZIP(), []int{9}
+	return file_callback_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ActiveEventResponse) GetSuccess() bool {
@@ -553,20 +750,17 @@ func (x *ActiveEventResponse) GetError() string {
 
 // 框架退出事件
 type FrameExitEventRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallbackId    uint32                 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	CallbackId uint32 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FrameExitEventRequest) Reset() {
 	*x = FrameExitEventRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_callback_service_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_callback_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *FrameExitEventRequest) String() string {
@@ -576,8 +770,8 @@ func (x *FrameExitEventRequest) String() string {
 func (*FrameExitEventRequest) ProtoMessage() {}
 
 func (x *FrameExitEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_callback_service_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_callback_service_proto_msgTypes[12]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -589,7 +783,7 @@ func (x *FrameExitEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FrameExitEventRequest.ProtoReflect.Descriptor instead.
 func (*FrameExitEventRequest) Descriptor() ([]byte, []int) {
-	return file_callback_service_proto_rawDescGZIP(), []int{10}
+	return file_callback_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *FrameExitEventRequest) GetCallbackId() uint32 {
@@ -600,20 +794,17 @@ func (x *FrameExitEventRequest) GetCallbackId() uint32 {
 }
 
 type FrameExitEventResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FrameExitEventResponse) Reset() {
 	*x = FrameExitEventResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_callback_service_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_callback_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *FrameExitEventResponse) String() string {
@@ -623,9 +814,9 @@ func (x *FrameExitEventResponse) String() string {
 func (*FrameExitEventResponse) ProtoMessage() {}
 
 func (x *FrameExitEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_callback_service_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	mi := &file_callback_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
@@ -636,7 +827,7 @@ func (x *FrameExitEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FrameExitEventResponse.ProtoReflect.Descriptor instead.
 func (*FrameExitEventResponse) Descriptor() ([]byte, []int) {
-	return file_callback_service_proto_rawDescGZIP(), []int{11}
+	return file_callback_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *FrameExitEventResponse) GetSuccess() bool {
@@ -648,22 +839,19 @@ func (x *FrameExitEventResponse) GetSuccess() bool {
 
 // 广播事件
 type BroadcastEventRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallbackId    uint32                 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"` // JSON-encoded map[string]interface{}
 	unknownFields protoimpl.UnknownFields
-
-	CallbackId uint32 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
-	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Data       []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"` // JSON-encoded map[string]interface{}
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BroadcastEventRequest) Reset() {
 	*x = BroadcastEventRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_callback_service_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_callback_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *BroadcastEventRequest) String() string {
@@ -673,8 +861,8 @@ func (x *BroadcastEventRequest) String() string {
 func (*BroadcastEventRequest) ProtoMessage() {}
 
 func (x *BroadcastEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_callback_service_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_callback_service_proto_msgTypes[14]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -686,7 +874,7 @@ func (x *BroadcastEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BroadcastEventRequest.ProtoReflect.Descriptor instead.
 func (*BroadcastEventRequest) Descriptor() ([]byte, []int) {
-	return file_callback_service_proto_rawDescGZIP(), []int{12}
+	return file_callback_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *BroadcastEventRequest) GetCallbackId() uint32 {
@@ -711,20 +899,17 @@ func (x *BroadcastEventRequest) GetData() []byte {
 }
 
 type BroadcastEventResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        []byte                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"` // JSON-encoded interface{}
 	unknownFields protoimpl.UnknownFields
-
-	Result []byte `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"` // JSON-encoded interface{}
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BroadcastEventResponse) Reset() {
 	*x = BroadcastEventResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_callback_service_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_callback_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *BroadcastEventResponse) String() string {
@@ -734,8 +919,8 @@ func (x *BroadcastEventResponse) String() string {
 func (*BroadcastEventResponse) ProtoMessage() {}
 
 func (x *BroadcastEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_callback_service_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_callback_service_proto_msgTypes[15]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -747,7 +932,7 @@ func (x *BroadcastEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BroadcastEventResponse.ProtoReflect.Descriptor instead.
 func (*BroadcastEventResponse) Descriptor() ([]byte, []int) {
-	return file_callback_service_proto_rawDescGZIP(), []int{13}
+	return file_callback_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *BroadcastEventResponse) GetResult() []byte {
@@ -759,21 +944,18 @@ func (x *BroadcastEventResponse) GetResult() []byte {
 
 // 控制台命令
 type ConsoleCommandRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallbackId    uint32                 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	Args          []string               `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	CallbackId uint32   `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
-	Args       []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ConsoleCommandRequest) Reset() {
 	*x = ConsoleCommandRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_callback_service_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_callback_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ConsoleCommandRequest) String() string {
@@ -783,8 +965,8 @@ func (x *ConsoleCommandRequest) String() string {
 func (*ConsoleCommandRequest) ProtoMessage() {}
 
 func (x *ConsoleCommandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_callback_service_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_callback_service_proto_msgTypes[16]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -796,7 +978,7 @@ func (x *ConsoleCommandRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConsoleCommandRequest.ProtoReflect.Descriptor instead.
 func (*ConsoleCommandRequest) Descriptor() ([]byte, []int) {
-	return file_callback_service_proto_rawDescGZIP(), []int{14}
+	return file_callback_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ConsoleCommandRequest) GetCallbackId() uint32 {
@@ -814,21 +996,18 @@ func (x *ConsoleCommandRequest) GetArgs() []string {
 }
 
 type ConsoleCommandResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ConsoleCommandResponse) Reset() {
 	*x = ConsoleCommandResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_callback_service_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_callback_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ConsoleCommandResponse) String() string {
@@ -838,8 +1017,8 @@ func (x *ConsoleCommandResponse) String() string {
 func (*ConsoleCommandResponse) ProtoMessage() {}
 
 func (x *ConsoleCommandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_callback_service_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_callback_service_proto_msgTypes[17]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -851,7 +1030,7 @@ func (x *ConsoleCommandResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConsoleCommandResponse.ProtoReflect.Descriptor instead.
 func (*ConsoleCommandResponse) Descriptor() ([]byte, []int) {
-	return file_callback_service_proto_rawDescGZIP(), []int{15}
+	return file_callback_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ConsoleCommandResponse) GetSuccess() bool {
@@ -868,390 +1047,876 @@ func (x *ConsoleCommandResponse) GetError() string {
 	return ""
 }
 
-var File_callback_service_proto protoreflect.FileDescriptor
+// 插件 API 方法调用
+type PluginAPICallRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallbackId    uint32                 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Args          []byte                 `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-var file_callback_service_proto_rawDesc = []byte{
-	0x0a, 0x16, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x73, 0x64, 0x6b, 0x22, 0x65, 0x0a,
-	0x10, 0x43, 0x68, 0x61, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
-	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x2b, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x74, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x22, 0x50, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x61,
-	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x61, 0x77, 0x5f,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x61, 0x77, 0x44,
-	0x61, 0x74, 0x61, 0x22, 0x2f, 0x0a, 0x13, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x22, 0x73, 0x0a, 0x12, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61,
-	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
-	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x22, 0x2f, 0x0a, 0x13, 0x50, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x36, 0x0a, 0x13, 0x50, 0x72,
-	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
-	0x49, 0x64, 0x22, 0x46, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x35, 0x0a, 0x12, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49,
-	0x64, 0x22, 0x45, 0x0a, 0x13, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x38, 0x0a, 0x15, 0x46, 0x72, 0x61, 0x6d,
-	0x65, 0x45, 0x78, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
-	0x49, 0x64, 0x22, 0x32, 0x0a, 0x16, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x45, 0x78, 0x69, 0x74, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x60, 0x0a, 0x15, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
-	0x61, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x30, 0x0a, 0x16, 0x42, 0x72, 0x6f, 0x61,
-	0x64, 0x63, 0x61, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4c, 0x0a, 0x15, 0x43, 0x6f,
-	0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61,
-	0x63, 0x6b, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x48, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x73,
-	0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x32, 0x96, 0x05, 0x0a, 0x0f, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x43, 0x68, 0x61, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73,
-	0x64, 0x6b, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x4f, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x4a, 0x6f, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x73, 0x64, 0x6b, 0x2e,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x12,
-	0x4f, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x17, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x64,
-	0x6b, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x4f, 0x6e, 0x50, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x50, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x18, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x4f, 0x6e, 0x50,
-	0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x73, 0x64,
-	0x6b, 0x2e, 0x50, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x50, 0x72, 0x65, 0x6c,
-	0x6f, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x42, 0x0a, 0x0d, 0x4f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x12, 0x17, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x64, 0x6b,
-	0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x10, 0x4f, 0x6e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x45,
-	0x78, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x46,
-	0x72, 0x61, 0x6d, 0x65, 0x45, 0x78, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65,
-	0x45, 0x78, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4b, 0x0a, 0x10, 0x4f, 0x6e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x42, 0x72, 0x6f, 0x61,
-	0x64, 0x63, 0x61, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
-	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b,
-	0x0a, 0x10, 0x4f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x12, 0x1a, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x24, 0x5a, 0x22, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6f, 0x71, 0x69, 0x6a,
-	0x69, 0x65, 0x2f, 0x46, 0x49, 0x4e, 0x2d, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x73, 0x64,
-	0x6b, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *PluginAPICallRequest) Reset() {
+	*x = PluginAPICallRequest{}
+	mi := &file_callback_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-var (
-	file_callback_service_proto_rawDescOnce sync.Once
-	file_callback_service_proto_rawDescData = file_callback_service_proto_rawDesc
-)
+func (x *PluginAPICallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_callback_service_proto_rawDescGZIP() []byte {
-	file_callback_service_proto_rawDescOnce.Do(func() {
-		file_callback_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_callback_service_proto_rawDescData)
-	})
-	return file_callback_service_proto_rawDescData
+func (*PluginAPICallRequest) ProtoMessage() {}
+
+func (x *PluginAPICallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_callback_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_callback_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
-var file_callback_service_proto_goTypes = []interface{}{
-	(*ChatEventRequest)(nil),       // 0: sdk.ChatEventRequest
-	(*ChatEventResponse)(nil),      // 1: sdk.ChatEventResponse
-	(*PlayerEventRequest)(nil),     // 2: sdk.PlayerEventRequest
-	(*PlayerEventResponse)(nil),    // 3: sdk.PlayerEventResponse
-	(*PacketEventRequest)(nil),     // 4: sdk.PacketEventRequest
-	(*PacketEventResponse)(nil),    // 5: sdk.PacketEventResponse
-	(*PreloadEventRequest)(nil),    // 6: sdk.PreloadEventRequest
-	(*PreloadEventResponse)(nil),   // 7: sdk.PreloadEventResponse
-	(*ActiveEventRequest)(nil),     // 8: sdk.ActiveEventRequest
-	(*ActiveEventResponse)(nil),    // 9: sdk.ActiveEventResponse
-	(*FrameExitEventRequest)(nil),  // 10: sdk.FrameExitEventRequest
-	(*FrameExitEventResponse)(nil), // 11: sdk.FrameExitEventResponse
-	(*BroadcastEventRequest)(nil),  // 12: sdk.BroadcastEventRequest
-	(*BroadcastEventResponse)(nil), // 13: sdk.BroadcastEventResponse
-	(*ConsoleCommandRequest)(nil),  // 14: sdk.ConsoleCommandRequest
-	(*ConsoleCommandResponse)(nil), // 15: sdk.ConsoleCommandResponse
+// Deprecated: Use PluginAPICallRequest.ProtoReflect.Descriptor instead.
+func (*PluginAPICallRequest) Descriptor() ([]byte, []int) {
+	return file_callback_service_proto_rawDescGZIP(), []int{18}
 }
-var file_callback_service_proto_depIdxs = []int32{
-	0,  // 0: sdk.CallbackService.OnChatEvent:input_type -> sdk.ChatEventRequest
-	2,  // 1: sdk.CallbackService.OnPlayerJoinEvent:input_type -> sdk.PlayerEventRequest
-	2,  // 2: sdk.CallbackService.OnPlayerLeaveEvent:input_type -> sdk.PlayerEventRequest
-	4,  // 3: sdk.CallbackService.OnPacketEvent:input_type -> sdk.PacketEventRequest
-	6,  // 4: sdk.CallbackService.OnPreloadEvent:input_type -> sdk.PreloadEventRequest
-	8,  // 5: sdk.CallbackService.OnActiveEvent:input_type -> sdk.ActiveEventRequest
-	10, // 6: sdk.CallbackService.OnFrameExitEvent:input_type -> sdk.FrameExitEventRequest
-	12, // 7: sdk.CallbackService.OnBroadcastEvent:input_type -> sdk.BroadcastEventRequest
-	14, // 8: sdk.CallbackService.OnConsoleCommand:input_type -> sdk.ConsoleCommandRequest
-	1,  // 9: sdk.CallbackService.OnChatEvent:output_type -> sdk.ChatEventResponse
-	3,  // 10: sdk.CallbackService.OnPlayerJoinEvent:output_type -> sdk.PlayerEventResponse
-	3,  // 11: sdk.CallbackService.OnPlayerLeaveEvent:output_type -> sdk.PlayerEventResponse
-	5,  // 12: sdk.CallbackService.OnPacketEvent:output_type -> sdk.PacketEventResponse
-	7,  // 13: sdk.CallbackService.OnPreloadEvent:output_type -> sdk.PreloadEventResponse
-	9,  // 14: sdk.CallbackService.OnActiveEvent:output_type -> sdk.ActiveEventResponse
-	11, // 15: sdk.CallbackService.OnFrameExitEvent:output_type -> sdk.FrameExitEventResponse
-	13, // 16: sdk.CallbackService.OnBroadcastEvent:output_type -> sdk.BroadcastEventResponse
-	15, // 17: sdk.CallbackService.OnConsoleCommand:output_type -> sdk.ConsoleCommandResponse
-	9,  // [9:18] is the sub-list for method output_type
-	0,  // [0:9] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+
+func (x *PluginAPICallRequest) GetCallbackId() uint32 {
+	if x != nil {
+		return x.CallbackId
+	}
+	return 0
 }
 
-func init() { file_callback_service_proto_init() }
-func file_callback_service_proto_init() {
-	if File_callback_service_proto != nil {
-		return
+func (x *PluginAPICallRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_callback_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChatEventRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+func (x *PluginAPICallRequest) GetArgs() []byte {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+type PluginAPICallResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result        []byte                 `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PluginAPICallResponse) Reset() {
+	*x = PluginAPICallResponse{}
+	mi := &file_callback_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginAPICallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginAPICallResponse) ProtoMessage() {}
+
+func (x *PluginAPICallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_callback_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_callback_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChatEventResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginAPICallResponse.ProtoReflect.Descriptor instead.
+func (*PluginAPICallResponse) Descriptor() ([]byte, []int) {
+	return file_callback_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PluginAPICallResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PluginAPICallResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PluginAPICallResponse) GetResult() []byte {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// 事件流
+type EventEnvelope struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sequence uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"` // 主进程分配，插件在 EventResult 中原样返回
+	// Types that are valid to be assigned to Event:
+	//
+	//	*EventEnvelope_Chat
+	//	*EventEnvelope_PlayerJoin
+	//	*EventEnvelope_PlayerLeave
+	//	*EventEnvelope_Packet
+	//	*EventEnvelope_BytesPacket
+	//	*EventEnvelope_Preload
+	//	*EventEnvelope_Active
+	//	*EventEnvelope_FrameExit
+	//	*EventEnvelope_Broadcast
+	//	*EventEnvelope_ConsoleCommand
+	//	*EventEnvelope_PluginApiCall
+	Event         isEventEnvelope_Event `protobuf_oneof:"event"`
+	TimeoutMs     int64                 `protobuf:"varint,13,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"` // 主进程为本次回调设置的超时，插件据此为处理器设置截止时间；0 表示不限制
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventEnvelope) Reset() {
+	*x = EventEnvelope{}
+	mi := &file_callback_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventEnvelope) ProtoMessage() {}
+
+func (x *EventEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_callback_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_callback_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerEventRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventEnvelope.ProtoReflect.Descriptor instead.
+func (*EventEnvelope) Descriptor() ([]byte, []int) {
+	return file_callback_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EventEnvelope) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *EventEnvelope) GetEvent() isEventEnvelope_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetChat() *ChatEventRequest {
+	if x != nil {
+		if x, ok := x.Event.(*EventEnvelope_Chat); ok {
+			return x.Chat
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetPlayerJoin() *PlayerEventRequest {
+	if x != nil {
+		if x, ok := x.Event.(*EventEnvelope_PlayerJoin); ok {
+			return x.PlayerJoin
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetPlayerLeave() *PlayerEventRequest {
+	if x != nil {
+		if x, ok := x.Event.(*EventEnvelope_PlayerLeave); ok {
+			return x.PlayerLeave
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetPacket() *PacketEventRequest {
+	if x != nil {
+		if x, ok := x.Event.(*EventEnvelope_Packet); ok {
+			return x.Packet
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetBytesPacket() *BytesPacketEventRequest {
+	if x != nil {
+		if x, ok := x.Event.(*EventEnvelope_BytesPacket); ok {
+			return x.BytesPacket
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetPreload() *PreloadEventRequest {
+	if x != nil {
+		if x, ok := x.Event.(*EventEnvelope_Preload); ok {
+			return x.Preload
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetActive() *ActiveEventRequest {
+	if x != nil {
+		if x, ok := x.Event.(*EventEnvelope_Active); ok {
+			return x.Active
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetFrameExit() *FrameExitEventRequest {
+	if x != nil {
+		if x, ok := x.Event.(*EventEnvelope_FrameExit); ok {
+			return x.FrameExit
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetBroadcast() *BroadcastEventRequest {
+	if x != nil {
+		if x, ok := x.Event.(*EventEnvelope_Broadcast); ok {
+			return x.Broadcast
 		}
-		file_callback_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerEventResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetConsoleCommand() *ConsoleCommandRequest {
+	if x != nil {
+		if x, ok := x.Event.(*EventEnvelope_ConsoleCommand); ok {
+			return x.ConsoleCommand
 		}
-		file_callback_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PacketEventRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetPluginApiCall() *PluginAPICallRequest {
+	if x != nil {
+		if x, ok := x.Event.(*EventEnvelope_PluginApiCall); ok {
+			return x.PluginApiCall
 		}
-		file_callback_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PacketEventResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type isEventEnvelope_Event interface {
+	isEventEnvelope_Event()
+}
+
+type EventEnvelope_Chat struct {
+	Chat *ChatEventRequest `protobuf:"bytes,2,opt,name=chat,proto3,oneof"`
+}
+
+type EventEnvelope_PlayerJoin struct {
+	PlayerJoin *PlayerEventRequest `protobuf:"bytes,3,opt,name=player_join,json=playerJoin,proto3,oneof"`
+}
+
+type EventEnvelope_PlayerLeave struct {
+	PlayerLeave *PlayerEventRequest `protobuf:"bytes,4,opt,name=player_leave,json=playerLeave,proto3,oneof"`
+}
+
+type EventEnvelope_Packet struct {
+	Packet *PacketEventRequest `protobuf:"bytes,5,opt,name=packet,proto3,oneof"`
+}
+
+type EventEnvelope_BytesPacket struct {
+	BytesPacket *BytesPacketEventRequest `protobuf:"bytes,6,opt,name=bytes_packet,json=bytesPacket,proto3,oneof"`
+}
+
+type EventEnvelope_Preload struct {
+	Preload *PreloadEventRequest `protobuf:"bytes,7,opt,name=preload,proto3,oneof"`
+}
+
+type EventEnvelope_Active struct {
+	Active *ActiveEventRequest `protobuf:"bytes,8,opt,name=active,proto3,oneof"`
+}
+
+type EventEnvelope_FrameExit struct {
+	FrameExit *FrameExitEventRequest `protobuf:"bytes,9,opt,name=frame_exit,json=frameExit,proto3,oneof"`
+}
+
+type EventEnvelope_Broadcast struct {
+	Broadcast *BroadcastEventRequest `protobuf:"bytes,10,opt,name=broadcast,proto3,oneof"`
+}
+
+type EventEnvelope_ConsoleCommand struct {
+	ConsoleCommand *ConsoleCommandRequest `protobuf:"bytes,11,opt,name=console_command,json=consoleCommand,proto3,oneof"`
+}
+
+type EventEnvelope_PluginApiCall struct {
+	PluginApiCall *PluginAPICallRequest `protobuf:"bytes,12,opt,name=plugin_api_call,json=pluginApiCall,proto3,oneof"`
+}
+
+func (*EventEnvelope_Chat) isEventEnvelope_Event() {}
+
+func (*EventEnvelope_PlayerJoin) isEventEnvelope_Event() {}
+
+func (*EventEnvelope_PlayerLeave) isEventEnvelope_Event() {}
+
+func (*EventEnvelope_Packet) isEventEnvelope_Event() {}
+
+func (*EventEnvelope_BytesPacket) isEventEnvelope_Event() {}
+
+func (*EventEnvelope_Preload) isEventEnvelope_Event() {}
+
+func (*EventEnvelope_Active) isEventEnvelope_Event() {}
+
+func (*EventEnvelope_FrameExit) isEventEnvelope_Event() {}
+
+func (*EventEnvelope_Broadcast) isEventEnvelope_Event() {}
+
+func (*EventEnvelope_ConsoleCommand) isEventEnvelope_Event() {}
+
+func (*EventEnvelope_PluginApiCall) isEventEnvelope_Event() {}
+
+type EventResult struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sequence uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Error    string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // 非空表示回调失败，对应单次调用返回的 gRPC 错误
+	// Types that are valid to be assigned to Result:
+	//
+	//	*EventResult_Chat
+	//	*EventResult_Player
+	//	*EventResult_Packet
+	//	*EventResult_BytesPacket
+	//	*EventResult_Preload
+	//	*EventResult_Active
+	//	*EventResult_FrameExit
+	//	*EventResult_Broadcast
+	//	*EventResult_ConsoleCommand
+	//	*EventResult_PluginApiCall
+	Result isEventResult_Result `protobuf_oneof:"result"`
+	// 插件该类事件的有序队列已满，事件未被处理，主进程应改用单次调用投递该事件
+	// 同时设置 error，旧版主进程仍按回调失败处理
+	Overflow      bool `protobuf:"varint,13,opt,name=overflow,proto3" json:"overflow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventResult) Reset() {
+	*x = EventResult{}
+	mi := &file_callback_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventResult) ProtoMessage() {}
+
+func (x *EventResult) ProtoReflect() protoreflect.Message {
+	mi := &file_callback_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_callback_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PreloadEventRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventResult.ProtoReflect.Descriptor instead.
+func (*EventResult) Descriptor() ([]byte, []int) {
+	return file_callback_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *EventResult) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *EventResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *EventResult) GetResult() isEventResult_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *EventResult) GetChat() *ChatEventResponse {
+	if x != nil {
+		if x, ok := x.Result.(*EventResult_Chat); ok {
+			return x.Chat
 		}
-		file_callback_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PreloadEventResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	}
+	return nil
+}
+
+func (x *EventResult) GetPlayer() *PlayerEventResponse {
+	if x != nil {
+		if x, ok := x.Result.(*EventResult_Player); ok {
+			return x.Player
 		}
-		file_callback_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActiveEventRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	}
+	return nil
+}
+
+func (x *EventResult) GetPacket() *PacketEventResponse {
+	if x != nil {
+		if x, ok := x.Result.(*EventResult_Packet); ok {
+			return x.Packet
 		}
-		file_callback_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActiveEventResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	}
+	return nil
+}
+
+func (x *EventResult) GetBytesPacket() *BytesPacketEventResponse {
+	if x != nil {
+		if x, ok := x.Result.(*EventResult_BytesPacket); ok {
+			return x.BytesPacket
 		}
-		file_callback_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FrameExitEventRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	}
+	return nil
+}
+
+func (x *EventResult) GetPreload() *PreloadEventResponse {
+	if x != nil {
+		if x, ok := x.Result.(*EventResult_Preload); ok {
+			return x.Preload
 		}
-		file_callback_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FrameExitEventResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	}
+	return nil
+}
+
+func (x *EventResult) GetActive() *ActiveEventResponse {
+	if x != nil {
+		if x, ok := x.Result.(*EventResult_Active); ok {
+			return x.Active
 		}
-		file_callback_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BroadcastEventRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	}
+	return nil
+}
+
+func (x *EventResult) GetFrameExit() *FrameExitEventResponse {
+	if x != nil {
+		if x, ok := x.Result.(*EventResult_FrameExit); ok {
+			return x.FrameExit
 		}
-		file_callback_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BroadcastEventResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	}
+	return nil
+}
+
+func (x *EventResult) GetBroadcast() *BroadcastEventResponse {
+	if x != nil {
+		if x, ok := x.Result.(*EventResult_Broadcast); ok {
+			return x.Broadcast
 		}
-		file_callback_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConsoleCommandRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	}
+	return nil
+}
+
+func (x *EventResult) GetConsoleCommand() *ConsoleCommandResponse {
+	if x != nil {
+		if x, ok := x.Result.(*EventResult_ConsoleCommand); ok {
+			return x.ConsoleCommand
 		}
-		file_callback_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConsoleCommandResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	}
+	return nil
+}
+
+func (x *EventResult) GetPluginApiCall() *PluginAPICallResponse {
+	if x != nil {
+		if x, ok := x.Result.(*EventResult_PluginApiCall); ok {
+			return x.PluginApiCall
 		}
 	}
+	return nil
+}
+
+func (x *EventResult) GetOverflow() bool {
+	if x != nil {
+		return x.Overflow
+	}
+	return false
+}
+
+type isEventResult_Result interface {
+	isEventResult_Result()
+}
+
+type EventResult_Chat struct {
+	Chat *ChatEventResponse `protobuf:"bytes,3,opt,name=chat,proto3,oneof"`
+}
+
+type EventResult_Player struct {
+	Player *PlayerEventResponse `protobuf:"bytes,4,opt,name=player,proto3,oneof"`
+}
+
+type EventResult_Packet struct {
+	Packet *PacketEventResponse `protobuf:"bytes,5,opt,name=packet,proto3,oneof"`
+}
+
+type EventResult_BytesPacket struct {
+	BytesPacket *BytesPacketEventResponse `protobuf:"bytes,6,opt,name=bytes_packet,json=bytesPacket,proto3,oneof"`
+}
+
+type EventResult_Preload struct {
+	Preload *PreloadEventResponse `protobuf:"bytes,7,opt,name=preload,proto3,oneof"`
+}
+
+type EventResult_Active struct {
+	Active *ActiveEventResponse `protobuf:"bytes,8,opt,name=active,proto3,oneof"`
+}
+
+type EventResult_FrameExit struct {
+	FrameExit *FrameExitEventResponse `protobuf:"bytes,9,opt,name=frame_exit,json=frameExit,proto3,oneof"`
+}
+
+type EventResult_Broadcast struct {
+	Broadcast *BroadcastEventResponse `protobuf:"bytes,10,opt,name=broadcast,proto3,oneof"`
+}
+
+type EventResult_ConsoleCommand struct {
+	ConsoleCommand *ConsoleCommandResponse `protobuf:"bytes,11,opt,name=console_command,json=consoleCommand,proto3,oneof"`
+}
+
+type EventResult_PluginApiCall struct {
+	PluginApiCall *PluginAPICallResponse `protobuf:"bytes,12,opt,name=plugin_api_call,json=pluginApiCall,proto3,oneof"`
+}
+
+func (*EventResult_Chat) isEventResult_Result() {}
+
+func (*EventResult_Player) isEventResult_Result() {}
+
+func (*EventResult_Packet) isEventResult_Result() {}
+
+func (*EventResult_BytesPacket) isEventResult_Result() {}
+
+func (*EventResult_Preload) isEventResult_Result() {}
+
+func (*EventResult_Active) isEventResult_Result() {}
+
+func (*EventResult_FrameExit) isEventResult_Result() {}
+
+func (*EventResult_Broadcast) isEventResult_Result() {}
+
+func (*EventResult_ConsoleCommand) isEventResult_Result() {}
+
+func (*EventResult_PluginApiCall) isEventResult_Result() {}
+
+var File_callback_service_proto protoreflect.FileDescriptor
+
+const file_callback_service_proto_rawDesc = "" +
+	"\n" +
+	"\x16callback_service.proto\x12\x03sdk\"\xdb\x01\n" +
+	"\x10ChatEventRequest\x12\x1f\n" +
+	"\vcallback_id\x18\x01 \x01(\rR\n" +
+	"callbackId\x12\x16\n" +
+	"\x06sender\x18\x02 \x01(\tR\x06sender\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1b\n" +
+	"\ttext_type\x18\x04 \x01(\rR\btextType\x12\x1e\n" +
+	"\n" +
+	"parameters\x18\x05 \x03(\tR\n" +
+	"parameters\x12\x19\n" +
+	"\braw_data\x18\x06 \x01(\fR\arawData\x12\x1c\n" +
+	"\tcancelled\x18\a \x01(\bR\tcancelled\"\x81\x01\n" +
+	"\x11ChatEventResponse\x12\x16\n" +
+	"\x06cancel\x18\x01 \x01(\bR\x06cancel\x12\x1a\n" +
+	"\bmodified\x18\x02 \x01(\bR\bmodified\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1e\n" +
+	"\n" +
+	"parameters\x18\x04 \x03(\tR\n" +
+	"parameters\"\xaa\x02\n" +
+	"\x12PlayerEventRequest\x12\x1f\n" +
+	"\vcallback_id\x18\x01 \x01(\rR\n" +
+	"callbackId\x12\x19\n" +
+	"\braw_data\x18\x02 \x01(\fR\arawData\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04xuid\x18\x04 \x01(\tR\x04xuid\x12\x12\n" +
+	"\x04uuid\x18\x05 \x01(\tR\x04uuid\x12(\n" +
+	"\x10entity_unique_id\x18\x06 \x01(\x03R\x0eentityUniqueId\x12*\n" +
+	"\x11entity_runtime_id\x18\a \x01(\x04R\x0fentityRuntimeId\x12%\n" +
+	"\x0ebuild_platform\x18\b \x01(\x05R\rbuildPlatform\x12\x1f\n" +
+	"\ventry_index\x18\t \x01(\x05R\n" +
+	"entryIndex\"/\n" +
+	"\x13PlayerEventResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x85\x01\n" +
+	"\x12PacketEventRequest\x12\x1f\n" +
+	"\vcallback_id\x18\x01 \x01(\rR\n" +
+	"callbackId\x12\x1b\n" +
+	"\tpacket_id\x18\x02 \x01(\rR\bpacketId\x12\x1f\n" +
+	"\vpacket_data\x18\x03 \x01(\fR\n" +
+	"packetData\x12\x10\n" +
+	"\x03raw\x18\x04 \x01(\bR\x03raw\"/\n" +
+	"\x13PacketEventResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"k\n" +
+	"\x17BytesPacketEventRequest\x12\x1f\n" +
+	"\vcallback_id\x18\x01 \x01(\rR\n" +
+	"callbackId\x12\x1b\n" +
+	"\tpacket_id\x18\x02 \x01(\rR\bpacketId\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"8\n" +
+	"\x18BytesPacketEventResponse\x12\x1c\n" +
+	"\tintercept\x18\x01 \x01(\bR\tintercept\"6\n" +
+	"\x13PreloadEventRequest\x12\x1f\n" +
+	"\vcallback_id\x18\x01 \x01(\rR\n" +
+	"callbackId\"F\n" +
+	"\x14PreloadEventResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"5\n" +
+	"\x12ActiveEventRequest\x12\x1f\n" +
+	"\vcallback_id\x18\x01 \x01(\rR\n" +
+	"callbackId\"E\n" +
+	"\x13ActiveEventResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"8\n" +
+	"\x15FrameExitEventRequest\x12\x1f\n" +
+	"\vcallback_id\x18\x01 \x01(\rR\n" +
+	"callbackId\"2\n" +
+	"\x16FrameExitEventResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"`\n" +
+	"\x15BroadcastEventRequest\x12\x1f\n" +
+	"\vcallback_id\x18\x01 \x01(\rR\n" +
+	"callbackId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"0\n" +
+	"\x16BroadcastEventResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\fR\x06result\"L\n" +
+	"\x15ConsoleCommandRequest\x12\x1f\n" +
+	"\vcallback_id\x18\x01 \x01(\rR\n" +
+	"callbackId\x12\x12\n" +
+	"\x04args\x18\x02 \x03(\tR\x04args\"H\n" +
+	"\x16ConsoleCommandResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"c\n" +
+	"\x14PluginAPICallRequest\x12\x1f\n" +
+	"\vcallback_id\x18\x01 \x01(\rR\n" +
+	"callbackId\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x12\n" +
+	"\x04args\x18\x03 \x01(\fR\x04args\"_\n" +
+	"\x15PluginAPICallResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x16\n" +
+	"\x06result\x18\x03 \x01(\fR\x06result\"\xde\x05\n" +
+	"\rEventEnvelope\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12+\n" +
+	"\x04chat\x18\x02 \x01(\v2\x15.sdk.ChatEventRequestH\x00R\x04chat\x12:\n" +
+	"\vplayer_join\x18\x03 \x01(\v2\x17.sdk.PlayerEventRequestH\x00R\n" +
+	"playerJoin\x12<\n" +
+	"\fplayer_leave\x18\x04 \x01(\v2\x17.sdk.PlayerEventRequestH\x00R\vplayerLeave\x121\n" +
+	"\x06packet\x18\x05 \x01(\v2\x17.sdk.PacketEventRequestH\x00R\x06packet\x12A\n" +
+	"\fbytes_packet\x18\x06 \x01(\v2\x1c.sdk.BytesPacketEventRequestH\x00R\vbytesPacket\x124\n" +
+	"\apreload\x18\a \x01(\v2\x18.sdk.PreloadEventRequestH\x00R\apreload\x121\n" +
+	"\x06active\x18\b \x01(\v2\x17.sdk.ActiveEventRequestH\x00R\x06active\x12;\n" +
+	"\n" +
+	"frame_exit\x18\t \x01(\v2\x1a.sdk.FrameExitEventRequestH\x00R\tframeExit\x12:\n" +
+	"\tbroadcast\x18\n" +
+	" \x01(\v2\x1a.sdk.BroadcastEventRequestH\x00R\tbroadcast\x12E\n" +
+	"\x0fconsole_command\x18\v \x01(\v2\x1a.sdk.ConsoleCommandRequestH\x00R\x0econsoleCommand\x12C\n" +
+	"\x0fplugin_api_call\x18\f \x01(\v2\x19.sdk.PluginAPICallRequestH\x00R\rpluginApiCall\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\r \x01(\x03R\ttimeoutMsB\a\n" +
+	"\x05event\"\xb3\x05\n" +
+	"\vEventResult\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12,\n" +
+	"\x04chat\x18\x03 \x01(\v2\x16.sdk.ChatEventResponseH\x00R\x04chat\x122\n" +
+	"\x06player\x18\x04 \x01(\v2\x18.sdk.PlayerEventResponseH\x00R\x06player\x122\n" +
+	"\x06packet\x18\x05 \x01(\v2\x18.sdk.PacketEventResponseH\x00R\x06packet\x12B\n" +
+	"\fbytes_packet\x18\x06 \x01(\v2\x1d.sdk.BytesPacketEventResponseH\x00R\vbytesPacket\x125\n" +
+	"\apreload\x18\a \x01(\v2\x19.sdk.PreloadEventResponseH\x00R\apreload\x122\n" +
+	"\x06active\x18\b \x01(\v2\x18.sdk.ActiveEventResponseH\x00R\x06active\x12<\n" +
+	"\n" +
+	"frame_exit\x18\t \x01(\v2\x1b.sdk.FrameExitEventResponseH\x00R\tframeExit\x12;\n" +
+	"\tbroadcast\x18\n" +
+	" \x01(\v2\x1b.sdk.BroadcastEventResponseH\x00R\tbroadcast\x12F\n" +
+	"\x0fconsole_command\x18\v \x01(\v2\x1b.sdk.ConsoleCommandResponseH\x00R\x0econsoleCommand\x12D\n" +
+	"\x0fplugin_api_call\x18\f \x01(\v2\x1a.sdk.PluginAPICallResponseH\x00R\rpluginApiCall\x12\x1a\n" +
+	"\boverflow\x18\r \x01(\bR\boverflowB\b\n" +
+	"\x06result2\xec\x06\n" +
+	"\x0fCallbackService\x12<\n" +
+	"\vOnChatEvent\x12\x15.sdk.ChatEventRequest\x1a\x16.sdk.ChatEventResponse\x12F\n" +
+	"\x11OnPlayerJoinEvent\x12\x17.sdk.PlayerEventRequest\x1a\x18.sdk.PlayerEventResponse\x12G\n" +
+	"\x12OnPlayerLeaveEvent\x12\x17.sdk.PlayerEventRequest\x1a\x18.sdk.PlayerEventResponse\x12B\n" +
+	"\rOnPacketEvent\x12\x17.sdk.PacketEventRequest\x1a\x18.sdk.PacketEventResponse\x12Q\n" +
+	"\x12OnBytesPacketEvent\x12\x1c.sdk.BytesPacketEventRequest\x1a\x1d.sdk.BytesPacketEventResponse\x12E\n" +
+	"\x0eOnPreloadEvent\x12\x18.sdk.PreloadEventRequest\x1a\x19.sdk.PreloadEventResponse\x12B\n" +
+	"\rOnActiveEvent\x12\x17.sdk.ActiveEventRequest\x1a\x18.sdk.ActiveEventResponse\x12K\n" +
+	"\x10OnFrameExitEvent\x12\x1a.sdk.FrameExitEventRequest\x1a\x1b.sdk.FrameExitEventResponse\x12K\n" +
+	"\x10OnBroadcastEvent\x12\x1a.sdk.BroadcastEventRequest\x1a\x1b.sdk.BroadcastEventResponse\x12K\n" +
+	"\x10OnConsoleCommand\x12\x1a.sdk.ConsoleCommandRequest\x1a\x1b.sdk.ConsoleCommandResponse\x12H\n" +
+	"\x0fOnPluginAPICall\x12\x19.sdk.PluginAPICallRequest\x1a\x1a.sdk.PluginAPICallResponse\x127\n" +
+	"\vEventStream\x12\x12.sdk.EventEnvelope\x1a\x10.sdk.EventResult(\x010\x01B$Z\"github.com/maoqijie/FIN-plugin/sdkb\x06proto3"
+
+var (
+	file_callback_service_proto_rawDescOnce sync.Once
+	file_callback_service_proto_rawDescData []byte
+)
+
+func file_callback_service_proto_rawDescGZIP() []byte {
+	file_callback_service_proto_rawDescOnce.Do(func() {
+		file_callback_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_callback_service_proto_rawDesc), len(file_callback_service_proto_rawDesc)))
+	})
+	return file_callback_service_proto_rawDescData
+}
+
+var file_callback_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_callback_service_proto_goTypes = []any{
+	(*ChatEventRequest)(nil),         // 0: sdk.ChatEventRequest
+	(*ChatEventResponse)(nil),        // 1: sdk.ChatEventResponse
+	(*PlayerEventRequest)(nil),       // 2: sdk.PlayerEventRequest
+	(*PlayerEventResponse)(nil),      // 3: sdk.PlayerEventResponse
+	(*PacketEventRequest)(nil),       // 4: sdk.PacketEventRequest
+	(*PacketEventResponse)(nil),      // 5: sdk.PacketEventResponse
+	(*BytesPacketEventRequest)(nil),  // 6: sdk.BytesPacketEventRequest
+	(*BytesPacketEventResponse)(nil), // 7: sdk.BytesPacketEventResponse
+	(*PreloadEventRequest)(nil),      // 8: sdk.PreloadEventRequest
+	(*PreloadEventResponse)(nil),     // 9: sdk.PreloadEventResponse
+	(*ActiveEventRequest)(nil),       // 10: sdk.ActiveEventRequest
+	(*ActiveEventResponse)(nil),      // 11: sdk.ActiveEventResponse
+	(*FrameExitEventRequest)(nil),    // 12: sdk.FrameExitEventRequest
+	(*FrameExitEventResponse)(nil),   // 13: sdk.FrameExitEventResponse
+	(*BroadcastEventRequest)(nil),    // 14: sdk.BroadcastEventRequest
+	(*BroadcastEventResponse)(nil),   // 15: sdk.BroadcastEventResponse
+	(*ConsoleCommandRequest)(nil),    // 16: sdk.ConsoleCommandRequest
+	(*ConsoleCommandResponse)(nil),   // 17: sdk.ConsoleCommandResponse
+	(*PluginAPICallRequest)(nil),     // 18: sdk.PluginAPICallRequest
+	(*PluginAPICallResponse)(nil),    // 19: sdk.PluginAPICallResponse
+	(*EventEnvelope)(nil),            // 20: sdk.EventEnvelope
+	(*EventResult)(nil),              // 21: sdk.EventResult
+}
+var file_callback_service_proto_depIdxs = []int32{
+	0,  // 0: sdk.EventEnvelope.chat:type_name -> sdk.ChatEventRequest
+	2,  // 1: sdk.EventEnvelope.player_join:type_name -> sdk.PlayerEventRequest
+	2,  // 2: sdk.EventEnvelope.player_leave:type_name -> sdk.PlayerEventRequest
+	4,  // 3: sdk.EventEnvelope.packet:type_name -> sdk.PacketEventRequest
+	6,  // 4: sdk.EventEnvelope.bytes_packet:type_name -> sdk.BytesPacketEventRequest
+	8,  // 5: sdk.EventEnvelope.preload:type_name -> sdk.PreloadEventRequest
+	10, // 6: sdk.EventEnvelope.active:type_name -> sdk.ActiveEventRequest
+	12, // 7: sdk.EventEnvelope.frame_exit:type_name -> sdk.FrameExitEventRequest
+	14, // 8: sdk.EventEnvelope.broadcast:type_name -> sdk.BroadcastEventRequest
+	16, // 9: sdk.EventEnvelope.console_command:type_name -> sdk.ConsoleCommandRequest
+	18, // 10: sdk.EventEnvelope.plugin_api_call:type_name -> sdk.PluginAPICallRequest
+	1,  // 11: sdk.EventResult.chat:type_name -> sdk.ChatEventResponse
+	3,  // 12: sdk.EventResult.player:type_name -> sdk.PlayerEventResponse
+	5,  // 13: sdk.EventResult.packet:type_name -> sdk.PacketEventResponse
+	7,  // 14: sdk.EventResult.bytes_packet:type_name -> sdk.BytesPacketEventResponse
+	9,  // 15: sdk.EventResult.preload:type_name -> sdk.PreloadEventResponse
+	11, // 16: sdk.EventResult.active:type_name -> sdk.ActiveEventResponse
+	13, // 17: sdk.EventResult.frame_exit:type_name -> sdk.FrameExitEventResponse
+	15, // 18: sdk.EventResult.broadcast:type_name -> sdk.BroadcastEventResponse
+	17, // 19: sdk.EventResult.console_command:type_name -> sdk.ConsoleCommandResponse
+	19, // 20: sdk.EventResult.plugin_api_call:type_name -> sdk.PluginAPICallResponse
+	0,  // 21: sdk.CallbackService.OnChatEvent:input_type -> sdk.ChatEventRequest
+	2,  // 22: sdk.CallbackService.OnPlayerJoinEvent:input_type -> sdk.PlayerEventRequest
+	2,  // 23: sdk.CallbackService.OnPlayerLeaveEvent:input_type -> sdk.PlayerEventRequest
+	4,  // 24: sdk.CallbackService.OnPacketEvent:input_type -> sdk.PacketEventRequest
+	6,  // 25: sdk.CallbackService.OnBytesPacketEvent:input_type -> sdk.BytesPacketEventRequest
+	8,  // 26: sdk.CallbackService.OnPreloadEvent:input_type -> sdk.PreloadEventRequest
+	10, // 27: sdk.CallbackService.OnActiveEvent:input_type -> sdk.ActiveEventRequest
+	12, // 28: sdk.CallbackService.OnFrameExitEvent:input_type -> sdk.FrameExitEventRequest
+	14, // 29: sdk.CallbackService.OnBroadcastEvent:input_type -> sdk.BroadcastEventRequest
+	16, // 30: sdk.CallbackService.OnConsoleCommand:input_type -> sdk.ConsoleCommandRequest
+	18, // 31: sdk.CallbackService.OnPluginAPICall:input_type -> sdk.PluginAPICallRequest
+	20, // 32: sdk.CallbackService.EventStream:input_type -> sdk.EventEnvelope
+	1,  // 33: sdk.CallbackService.OnChatEvent:output_type -> sdk.ChatEventResponse
+	3,  // 34: sdk.CallbackService.OnPlayerJoinEvent:output_type -> sdk.PlayerEventResponse
+	3,  // 35: sdk.CallbackService.OnPlayerLeaveEvent:output_type -> sdk.PlayerEventResponse
+	5,  // 36: sdk.CallbackService.OnPacketEvent:output_type -> sdk.PacketEventResponse
+	7,  // 37: sdk.CallbackService.OnBytesPacketEvent:output_type -> sdk.BytesPacketEventResponse
+	9,  // 38: sdk.CallbackService.OnPreloadEvent:output_type -> sdk.PreloadEventResponse
+	11, // 39: sdk.CallbackService.OnActiveEvent:output_type -> sdk.ActiveEventResponse
+	13, // 40: sdk.CallbackService.OnFrameExitEvent:output_type -> sdk.FrameExitEventResponse
+	15, // 41: sdk.CallbackService.OnBroadcastEvent:output_type -> sdk.BroadcastEventResponse
+	17, // 42: sdk.CallbackService.OnConsoleCommand:output_type -> sdk.ConsoleCommandResponse
+	19, // 43: sdk.CallbackService.OnPluginAPICall:output_type -> sdk.PluginAPICallResponse
+	21, // 44: sdk.CallbackService.EventStream:output_type -> sdk.EventResult
+	33, // [33:45] is the sub-list for method output_type
+	21, // [21:33] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_callback_service_proto_init() }
+func file_callback_service_proto_init() {
+	if File_callback_service_proto != nil {
+		return
+	}
+	file_callback_service_proto_msgTypes[20].OneofWrappers = []any{
+		(*EventEnvelope_Chat)(nil),
+		(*EventEnvelope_PlayerJoin)(nil),
+		(*EventEnvelope_PlayerLeave)(nil),
+		(*EventEnvelope_Packet)(nil),
+		(*EventEnvelope_BytesPacket)(nil),
+		(*EventEnvelope_Preload)(nil),
+		(*EventEnvelope_Active)(nil),
+		(*EventEnvelope_FrameExit)(nil),
+		(*EventEnvelope_Broadcast)(nil),
+		(*EventEnvelope_ConsoleCommand)(nil),
+		(*EventEnvelope_PluginApiCall)(nil),
+	}
+	file_callback_service_proto_msgTypes[21].OneofWrappers = []any{
+		(*EventResult_Chat)(nil),
+		(*EventResult_Player)(nil),
+		(*EventResult_Packet)(nil),
+		(*EventResult_BytesPacket)(nil),
+		(*EventResult_Preload)(nil),
+		(*EventResult_Active)(nil),
+		(*EventResult_FrameExit)(nil),
+		(*EventResult_Broadcast)(nil),
+		(*EventResult_ConsoleCommand)(nil),
+		(*EventResult_PluginApiCall)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_callback_service_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_callback_service_proto_rawDesc), len(file_callback_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1260,7 +1925,6 @@ func file_callback_service_proto_init() {
 		MessageInfos:      file_callback_service_proto_msgTypes,
 	}.Build()
 	File_callback_service_proto = out.File
-	file_callback_service_proto_rawDesc = nil
 	file_callback_service_proto_goTypes = nil
 	file_callback_service_proto_depIdxs = nil
 }
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.3.0
-// - protoc             v4.25.1
+// - protoc             v5.28.3
 // source: callback_service.proto
 
 package sdk
@@ -23,11 +23,14 @@ const (
 	CallbackService_OnPlayerJoinEvent_FullMethodName  = "/sdk.CallbackService/OnPlayerJoinEvent"
 	CallbackService_OnPlayerLeaveEvent_FullMethodName = "/sdk.CallbackService/OnPlayerLeaveEvent"
 	CallbackService_OnPacketEvent_FullMethodName      = "/sdk.CallbackService/OnPacketEvent"
+	CallbackService_OnBytesPacketEvent_FullMethodName = "/sdk.CallbackService/OnBytesPacketEvent"
 	CallbackService_OnPreloadEvent_FullMethodName     = "/sdk.CallbackService/OnPreloadEvent"
 	CallbackService_OnActiveEvent_FullMethodName      = "/sdk.CallbackService/OnActiveEvent"
 	CallbackService_OnFrameExitEvent_FullMethodName   = "/sdk.CallbackService/OnFrameExitEvent"
 	CallbackService_OnBroadcastEvent_FullMethodName   = "/sdk.CallbackService/OnBroadcastEvent"
 	CallbackService_OnConsoleCommand_FullMethodName   = "/sdk.CallbackService/OnConsoleCommand"
+	CallbackService_OnPluginAPICall_FullMethodName    = "/sdk.CallbackService/OnPluginAPICall"
+	CallbackService_EventStream_FullMethodName        = "/sdk.CallbackService/EventStream"
 )
 
 // CallbackServiceClient is the client API for CallbackService service.
@@ -39,12 +42,20 @@ type CallbackServiceClient interface {
 	OnPlayerJoinEvent(ctx context.Context, in *PlayerEventRequest, opts ...grpc.CallOption) (*PlayerEventResponse, error)
 	OnPlayerLeaveEvent(ctx context.Context, in *PlayerEventRequest, opts ...grpc.CallOption) (*PlayerEventResponse, error)
 	OnPacketEvent(ctx context.Context, in *PacketEventRequest, opts ...grpc.CallOption) (*PacketEventResponse, error)
+	OnBytesPacketEvent(ctx context.Context, in *BytesPacketEventRequest, opts ...grpc.CallOption) (*BytesPacketEventResponse, error)
 	OnPreloadEvent(ctx context.Context, in *PreloadEventRequest, opts ...grpc.CallOption) (*PreloadEventResponse, error)
 	OnActiveEvent(ctx context.Context, in *ActiveEventRequest, opts ...grpc.CallOption) (*ActiveEventResponse, error)
 	OnFrameExitEvent(ctx context.Context, in *FrameExitEventRequest, opts ...grpc.CallOption) (*FrameExitEventResponse, error)
 	OnBroadcastEvent(ctx context.Context, in *BroadcastEventRequest, opts ...grpc.CallOption) (*BroadcastEventResponse, error)
 	// 控制台命令回调
 	OnConsoleCommand(ctx context.Context, in *ConsoleCommandRequest, opts ...grpc.CallOption) (*ConsoleCommandResponse, error)
+	// 插件 API 方法调用
+	OnPluginAPICall(ctx context.Context, in *PluginAPICallRequest, opts ...grpc.CallOption) (*PluginAPICallResponse, error)
+	// 事件流：在一条双向流上复用所有事件回调
+	// 主进程发送 EventEnvelope，插件按 sequence 回传 EventResult
+	// 插件建立流后先发送 sequence 为 0 的 EventResult 表示就绪
+	// 不支持事件流的旧版插件由主进程回退到上面的单次调用
+	EventStream(ctx context.Context, opts ...grpc.CallOption) (CallbackService_EventStreamClient, error)
 }
 
 type callbackServiceClient struct {
@@ -91,6 +102,15 @@ func (c *callbackServiceClient) OnPacketEvent(ctx context.Context, in *PacketEve
 	return out, nil
 }
 
+func (c *callbackServiceClient) OnBytesPacketEvent(ctx context.Context, in *BytesPacketEventRequest, opts ...grpc.CallOption) (*BytesPacketEventResponse, error) {
+	out := new(BytesPacketEventResponse)
+	err := c.cc.Invoke(ctx, CallbackService_OnBytesPacketEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *callbackServiceClient) OnPreloadEvent(ctx context.Context, in *PreloadEventRequest, opts ...grpc.CallOption) (*PreloadEventResponse, error) {
 	out := new(PreloadEventResponse)
 	err := c.cc.Invoke(ctx, CallbackService_OnPreloadEvent_FullMethodName, in, out, opts...)
@@ -136,6 +156,46 @@ func (c *callbackServiceClient) OnConsoleCommand(ctx context.Context, in *Consol
 	return out, nil
 }
 
+func (c *callbackServiceClient) OnPluginAPICall(ctx context.Context, in *PluginAPICallRequest, opts ...grpc.CallOption) (*PluginAPICallResponse, error) {
+	out := new(PluginAPICallResponse)
+	err := c.cc.Invoke(ctx, CallbackService_OnPluginAPICall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbackServiceClient) EventStream(ctx context.Context, opts ...grpc.CallOption) (CallbackService_EventStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CallbackService_ServiceDesc.Streams[0], CallbackService_EventStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &callbackServiceEventStreamClient{stream}
+	return x, nil
+}
+
+type CallbackService_EventStreamClient interface {
+	Send(*EventEnvelope) error
+	Recv() (*EventResult, error)
+	grpc.ClientStream
+}
+
+type callbackServiceEventStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *callbackServiceEventStreamClient) Send(m *EventEnvelope) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *callbackServiceEventStreamClient) Recv() (*EventResult, error) {
+	m := new(EventResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // CallbackServiceServer is the server API for CallbackService service.
 // All implementations must embed UnimplementedCallbackServiceServer
 // for forward compatibility
@@ -145,12 +205,20 @@ type CallbackServiceServer interface {
 	OnPlayerJoinEvent(context.Context, *PlayerEventRequest) (*PlayerEventResponse, error)
 	OnPlayerLeaveEvent(context.Context, *PlayerEventRequest) (*PlayerEventResponse, error)
 	OnPacketEvent(context.Context, *PacketEventRequest) (*PacketEventResponse, error)
+	OnBytesPacketEvent(context.Context, *BytesPacketEventRequest) (*BytesPacketEventResponse, error)
 	OnPreloadEvent(context.Context, *PreloadEventRequest) (*PreloadEventResponse, error)
 	OnActiveEvent(context.Context, *ActiveEventRequest) (*ActiveEventResponse, error)
 	OnFrameExitEvent(context.Context, *FrameExitEventRequest) (*FrameExitEventResponse, error)
 	OnBroadcastEvent(context.Context, *BroadcastEventRequest) (*BroadcastEventResponse, error)
 	// 控制台命令回调
 	OnConsoleCommand(context.Context, *ConsoleCommandRequest) (*ConsoleCommandResponse, error)
+	// 插件 API 方法调用
+	OnPluginAPICall(context.Context, *PluginAPICallRequest) (*PluginAPICallResponse, error)
+	// 事件流：在一条双向流上复用所有事件回调
+	// 主进程发送 EventEnvelope，插件按 sequence 回传 EventResult
+	// 插件建立流后先发送 sequence 为 0 的 EventResult 表示就绪
+	// 不支持事件流的旧版插件由主进程回退到上面的单次调用
+	EventStream(CallbackService_EventStreamServer) error
 	mustEmbedUnimplementedCallbackServiceServer()
 }
 
@@ -170,6 +238,9 @@ func (UnimplementedCallbackServiceServer) OnPlayerLeaveEvent(context.Context, *P
 func (UnimplementedCallbackServiceServer) OnPacketEvent(context.Context, *PacketEventRequest) (*PacketEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OnPacketEvent not implemented")
 }
+func (UnimplementedCallbackServiceServer) OnBytesPacketEvent(context.Context, *BytesPacketEventRequest) (*BytesPacketEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnBytesPacketEvent not implemented")
+}
 func (UnimplementedCallbackServiceServer) OnPreloadEvent(context.Context, *PreloadEventRequest) (*PreloadEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OnPreloadEvent not implemented")
 }
@@ -185,6 +256,12 @@ func (UnimplementedCallbackServiceServer) OnBroadcastEvent(context.Context, *Bro
 func (UnimplementedCallbackServiceServer) OnConsoleCommand(context.Context, *ConsoleCommandRequest) (*ConsoleCommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OnConsoleCommand not implemented")
 }
+func (UnimplementedCallbackServiceServer) OnPluginAPICall(context.Context, *PluginAPICallRequest) (*PluginAPICallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnPluginAPICall not implemented")
+}
+func (UnimplementedCallbackServiceServer) EventStream(CallbackService_EventStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method EventStream not implemented")
+}
 func (UnimplementedCallbackServiceServer) mustEmbedUnimplementedCallbackServiceServer() {}
 
 // UnsafeCallbackServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -270,6 +347,24 @@ func _CallbackService_OnPacketEvent_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CallbackService_OnBytesPacketEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesPacketEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackServiceServer).OnBytesPacketEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CallbackService_OnBytesPacketEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackServiceServer).OnBytesPacketEvent(ctx, req.(*BytesPacketEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CallbackService_OnPreloadEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PreloadEventRequest)
 	if err := dec(in); err != nil {
@@ -360,6 +455,50 @@ func _CallbackService_OnConsoleCommand_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CallbackService_OnPluginAPICall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginAPICallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackServiceServer).OnPluginAPICall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CallbackService_OnPluginAPICall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackServiceServer).OnPluginAPICall(ctx, req.(*PluginAPICallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CallbackService_EventStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CallbackServiceServer).EventStream(&callbackServiceEventStreamServer{stream})
+}
+
+type CallbackService_EventStreamServer interface {
+	Send(*EventResult) error
+	Recv() (*EventEnvelope, error)
+	grpc.ServerStream
+}
+
+type callbackServiceEventStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *callbackServiceEventStreamServer) Send(m *EventResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *callbackServiceEventStreamServer) Recv() (*EventEnvelope, error) {
+	m := new(EventEnvelope)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // CallbackService_ServiceDesc is the grpc.ServiceDesc for CallbackService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -383,6 +522,10 @@ var CallbackService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OnPacketEvent",
 			Handler:    _CallbackService_OnPacketEvent_Handler,
 		},
+		{
+			MethodName: "OnBytesPacketEvent",
+			Handler:    _CallbackService_OnBytesPacketEvent_Handler,
+		},
 		{
 			MethodName: "OnPreloadEvent",
 			Handler:    _CallbackService_OnPreloadEvent_Handler,
@@ -403,7 +546,18 @@ var CallbackService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OnConsoleCommand",
 			Handler:    _CallbackService_OnConsoleCommand_Handler,
 		},
+		{
+			MethodName: "OnPluginAPICall",
+			Handler:    _CallbackService_OnPluginAPICall_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "EventStream",
+			Handler:       _CallbackService_EventStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "callback_service.proto",
 }
@@ -0,0 +1,107 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrNotSupportedByHost 表示主进程不支持所调用的功能（主进程版本过旧或未启用该功能）
+// 可通过 errors.Is(err, sdk.ErrNotSupportedByHost) 判断
+var ErrNotSupportedByHost = errors.New("not supported by host")
+
+// notSupportedByHost 返回包装了 ErrNotSupportedByHost 的错误
+func notSupportedByHost(feature string) error {
+	return fmt.Errorf("%s: %w", feature, ErrNotSupportedByHost)
+}
+
+// 主进程能力名称
+// 协议版本 1 的主进程不进行能力协商，能力未知时 HostSupports 总是返回 true，
+// 主进程实际未实现的功能在调用时返回 ErrNotSupportedByHost
+const (
+	CapabilityGameUtils         = "game_utils"         // GameUtils 的远程调用（SendCommand、GetScore 等）
+	CapabilityPlayerManager     = "player_manager"     // ListPlayers / GetPlayer
+	CapabilityPluginAPI         = "plugin_api"         // 跨插件 API 导出与调用
+	CapabilityBytesPacket       = "bytes_packet"       // ListenBytesPacket 拦截原始数据包
+	CapabilityRawPacket         = "raw_packet"         // 以原始协议字节转发 PacketEvent
+	CapabilityUnregisterHandler = "unregister_handler" // ListenerHandle.Unregister 移除主进程侧监听器
+	CapabilityEventStream       = "event_stream"       // 通过双向事件流投递事件
+	CapabilityFullInfo          = "full_info"          // BotInfo 等信息查询携带完整字段
+	CapabilityCallDeadlines     = "call_deadlines"     // 回调携带超时，处理器 context 会被取消
+)
+
+// Capabilities 是当前 SDK 实现的全部能力
+// 主进程实际公布的能力还取决于其 Context 启用了哪些功能
+var Capabilities = []string{
+	CapabilityGameUtils,
+	CapabilityPlayerManager,
+	CapabilityPluginAPI,
+	CapabilityBytesPacket,
+	CapabilityRawPacket,
+	CapabilityUnregisterHandler,
+	CapabilityEventStream,
+	CapabilityFullInfo,
+	CapabilityCallDeadlines,
+}
+
+// CapabilityRequirer 是插件可选实现的接口，声明运行所必需的主进程能力
+// 主进程缺少其中任一能力时，插件的 Init 会失败并返回 ErrNotSupportedByHost；
+// 协议版本 1 的主进程无法检查，缺少的功能在调用时才返回 ErrNotSupportedByHost
+//
+// 示例:
+//
+//	func (p *MyPlugin) RequiredCapabilities() []string {
+//	    return []string{sdk.CapabilityPluginAPI}
+//	}
+type CapabilityRequirer interface {
+	RequiredCapabilities() []string
+}
+
+// requiredCapabilities 返回插件声明的必需能力
+func requiredCapabilities(p Plugin) []string {
+	if r, ok := p.(CapabilityRequirer); ok {
+		return r.RequiredCapabilities()
+	}
+	return nil
+}
+
+// missingCapabilities 返回 required 中不在 available 里的能力
+func missingCapabilities(required, available []string) []string {
+	var missing []string
+	for _, capability := range required {
+		if !slices.Contains(available, capability) {
+			missing = append(missing, capability)
+		}
+	}
+	return missing
+}
+
+// checkCapabilities 在主进程缺少必需能力时返回错误
+func checkCapabilities(required, available []string) error {
+	missing := missingCapabilities(required, available)
+	if len(missing) == 0 {
+		return nil
+	}
+	return notSupportedByHost("required capabilities " + strings.Join(missing, ", "))
+}
+
+// hostConn 包装插件到主进程的连接
+// 旧版主进程没有实现的 RPC 返回 Unimplemented，这里统一转换为 ErrNotSupportedByHost
+type hostConn struct {
+	grpc.ClientConnInterface
+}
+
+func (c hostConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	err := c.ClientConnInterface.Invoke(ctx, method, args, reply, opts...)
+	if status.Code(err) == codes.Unimplemented {
+		return notSupportedByHost(path.Base(method))
+	}
+	return err
+}
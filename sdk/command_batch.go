@@ -0,0 +1,177 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// commandBatchSize 是批量命令中一次流水线发送、同时等待响应的命令数上限
+const commandBatchSize = 32
+
+// CommandBatchResult 是批量命令中一条命令的执行结果
+type CommandBatchResult struct {
+	Command  string         // 发送的命令
+	Result   *CommandResult // 命令输出，超时或出错时可能为 nil
+	TimedOut bool           // 是否超时
+	Err      error          // 发送或执行错误
+}
+
+// Succeeded 判断命令是否在超时前执行成功
+func (r CommandBatchResult) Succeeded() bool {
+	return r.Err == nil && !r.TimedOut && r.Result.Succeeded()
+}
+
+// SendCommandBatch 流水线发送多条命令并等待各自的响应，结果与 cmds 顺序一致
+// 每 32 条为一组：先连续发送整组命令，再按 CommandOrigin.RequestID 对应各自的响应，整组返回后发送下一组；
+// 主进程的命令发送器未实现 CommandBatchSender（或旧版主进程不支持批量调用）时，组内命令改为并发的 SendCommandWithResponse。
+// 启用命令队列时按顺序为每条命令取得 WS 通道的令牌后再发送，遵守 WSRate 限速
+// timeout: 每条命令的超时时间（秒），默认 30 秒
+//
+// 示例:
+//
+//	results := utils.SendCommandBatch([]string{"testfor Steve", "testfor Alex"}, 5)
+//	for _, r := range results {
+//	    ctx.Logf("%s: %v", r.Command, r.Succeeded())
+//	}
+func (g *GameUtils) SendCommandBatch(cmds []string, timeout ...float64) []CommandBatchResult {
+	t := 30.0
+	if len(timeout) > 0 && timeout[0] > 0 {
+		t = timeout[0]
+	}
+
+	results := make([]CommandBatchResult, len(cmds))
+	queue := g.queue.Load()
+	for start := 0; start < len(cmds); start += commandBatchSize {
+		end := min(start+commandBatchSize, len(cmds))
+		var batch []*CommandBatchResult
+		for i := start; i < end; i++ {
+			results[i].Command = cmds[i]
+			if queue != nil {
+				if err := queue.wait(CommandChannelWS); err != nil {
+					results[i].Err = err
+					continue
+				}
+			}
+			batch = append(batch, &results[i])
+		}
+		g.sendBatch(batch, t)
+	}
+	return results
+}
+
+// sendBatch 流水线发送一组命令，把结果写回 batch
+func (g *GameUtils) sendBatch(batch []*CommandBatchResult, timeout float64) {
+	if len(batch) == 0 {
+		return
+	}
+	cmds := make([]string, len(batch))
+	for i, r := range batch {
+		cmds[i] = r.Command
+	}
+
+	if g.remote != nil {
+		results, err := g.remote.SendCommandBatch(cmds, timeout)
+		switch {
+		case err == nil:
+			for i, r := range results {
+				*batch[i] = r
+			}
+			return
+		case !errors.Is(err, ErrNotSupportedByHost):
+			for _, r := range batch {
+				r.Err = err
+			}
+			return
+		}
+		// 旧版主进程没有批量调用，逐条并发发送
+		g.sendConcurrent(batch, timeout)
+		return
+	}
+
+	commands, err := g.commands()
+	if err != nil {
+		for _, r := range batch {
+			r.Err = err
+		}
+		return
+	}
+	sender, ok := commands.(CommandBatchSender)
+	if !ok {
+		g.sendConcurrent(batch, timeout)
+		return
+	}
+	outputs := sender.SendWSCommandBatch(cmds, time.Duration(timeout*float64(time.Second)))
+	for i, r := range batch {
+		if i >= len(outputs) {
+			r.Err = fmt.Errorf("主进程没有返回该命令的结果")
+			continue
+		}
+		r.Result = ParseCommandOutput(outputs[i].Output)
+		r.TimedOut = outputs[i].TimedOut
+		r.Err = outputs[i].Err
+	}
+}
+
+// sendConcurrent 为每条命令并发调用 SendCommandWithResponse
+func (g *GameUtils) sendConcurrent(batch []*CommandBatchResult, timeout float64) {
+	var wg sync.WaitGroup
+	for _, r := range batch {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.Result, r.TimedOut, r.Err = g.SendCommandWithResponse(r.Command, timeout)
+		}()
+	}
+	wg.Wait()
+}
+
+// GetMultiScore 批量获取多个目标在同一计分板中的分数
+// 返回成功获取的分数；部分目标失败时同时返回列出失败目标的错误
+// timeout: 每个目标的超时时间（秒），默认 30 秒
+//
+// 示例:
+//
+//	scores, err := ctx.GameUtils().GetMultiScore("money", []string{"Steve", "Alex", "Bob"})
+//	// scores: {"Steve": 1000, "Alex": 500, "Bob": 200}
+func (g *GameUtils) GetMultiScore(scoreboard string, targets []string, timeout ...float64) (map[string]int, error) {
+	scores := make(map[string]int, len(targets))
+	var failures []string
+
+	cmds := make([]string, 0, len(targets))
+	names := make([]string, 0, len(targets))
+	for _, target := range targets {
+		cmd, err := NewCommand("scoreboard players test").Target(target).Name(scoreboard).Keyword("* *").Build()
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", target, err))
+			continue
+		}
+		cmds = append(cmds, cmd)
+		names = append(names, target)
+	}
+
+	for i, r := range g.SendCommandBatch(cmds, timeout...) {
+		var err error
+		switch {
+		case r.TimedOut:
+			err = fmt.Errorf("获取分数超时")
+		case r.Err != nil:
+			err = fmt.Errorf("计分板或目标不存在: %v", r.Err)
+		default:
+			var score int
+			if score, err = parseScore(r.Result); err == nil {
+				scores[names[i]] = score
+			}
+		}
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", names[i], err))
+		}
+	}
+
+	if len(failures) > 0 {
+		return scores, fmt.Errorf("获取 %d 个目标的分数失败: %s", len(failures), strings.Join(failures, "; "))
+	}
+	return scores, nil
+}
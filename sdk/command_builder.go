@@ -0,0 +1,226 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// bareWordPattern 匹配无需引号即可作为命令参数的名称
+	bareWordPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// identifierPattern 匹配物品、方块、效果等 ID
+	identifierPattern = regexp.MustCompile(`^[A-Za-z0-9_.\-]+(:[A-Za-z0-9_.\-/]+)?$`)
+	// selectorHeadPattern 匹配选择器的类型部分
+	selectorHeadPattern = regexp.MustCompile(`^@[a-z]+`)
+)
+
+// Command 用于安全地拼接 Minecraft 命令
+// 每个参数按类型加引号或转义，玩家名、选择器、JSON 中的特殊字符无法改变命令结构；
+// 参数不合法时记录第一个错误，并由 Build 返回
+//
+// 示例:
+//
+//	cmd, err := sdk.NewCommand("give").Name(player.Name).Identifier("minecraft:diamond").Int(64).Build()
+//	// give "Steve Jobs" minecraft:diamond 64
+type Command struct {
+	parts []string
+	err   error
+}
+
+// NewCommand 以命令名（如 "give"、"scoreboard players test"）创建命令
+func NewCommand(name string) *Command {
+	return &Command{parts: []string{name}}
+}
+
+// Keyword 追加固定关键字（如 "clear"、"actionbar"），关键字由调用方给出，不做转义
+func (c *Command) Keyword(keyword string) *Command {
+	c.parts = append(c.parts, keyword)
+	return c
+}
+
+// Target 追加目标：Selector 或以 @ 开头的选择器字符串经检查后输出，其余字符串作为玩家名在需要时加引号
+func (c *Command) Target(target Target) *Command {
+	formatted, err := FormatTarget(target)
+	return c.add(formatted, err)
+}
+
+// Name 追加玩家名或计分板名等名称，在需要时加引号
+func (c *Command) Name(name string) *Command {
+	if name == "" {
+		return c.add("", fmt.Errorf("名称不能为空"))
+	}
+	return c.add(QuoteName(name), nil)
+}
+
+// Quoted 追加字符串参数，总是加引号
+func (c *Command) Quoted(s string) *Command {
+	return c.add(QuoteString(s), nil)
+}
+
+// Identifier 追加物品、方块、效果等 ID（如 "minecraft:diamond"、"speed"）
+func (c *Command) Identifier(id string) *Command {
+	if !identifierPattern.MatchString(id) {
+		return c.add("", fmt.Errorf("无效的 ID: %q", id))
+	}
+	return c.add(id, nil)
+}
+
+// Int 追加整数参数
+func (c *Command) Int(n int) *Command {
+	return c.add(strconv.Itoa(n), nil)
+}
+
+// Float 追加浮点数参数
+func (c *Command) Float(f float64) *Command {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return c.add("", fmt.Errorf("无效的数值: %v", f))
+	}
+	return c.add(strconv.FormatFloat(f, 'f', -1, 64), nil)
+}
+
+// Bool 追加 true / false
+func (c *Command) Bool(b bool) *Command {
+	return c.add(strconv.FormatBool(b), nil)
+}
+
+// Pos 追加坐标（与 Position 一样使用 float32）
+func (c *Command) Pos(x, y, z float32) *Command {
+	for _, v := range []float32{x, y, z} {
+		f := float64(v)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return c.add("", fmt.Errorf("无效的坐标: %v", v))
+		}
+		c.add(strconv.FormatFloat(f, 'f', -1, 32), nil)
+	}
+	return c
+}
+
+// BlockPos 追加方块坐标
+func (c *Command) BlockPos(pos BlockPos) *Command {
+	return c.Int(pos.X).Int(pos.Y).Int(pos.Z)
+}
+
+// JSON 追加 JSON 参数（如 tellraw 的 rawtext）
+func (c *Command) JSON(v interface{}) *Command {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return c.add("", fmt.Errorf("序列化 JSON 参数失败: %w", err))
+	}
+	return c.add(string(data), nil)
+}
+
+// Message 追加位于命令末尾的自由文本（如 title、kick 的消息），换行会被替换为空格
+func (c *Command) Message(text string) *Command {
+	return c.add(strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(text), nil)
+}
+
+// Build 返回拼接好的命令
+func (c *Command) Build() (string, error) {
+	if c.err != nil {
+		return "", c.err
+	}
+	return strings.Join(c.parts, " "), nil
+}
+
+// String 返回拼接好的命令，参数不合法时返回空字符串
+func (c *Command) String() string {
+	cmd, _ := c.Build()
+	return cmd
+}
+
+func (c *Command) add(part string, err error) *Command {
+	if c.err != nil {
+		return c
+	}
+	if err != nil {
+		c.err = err
+		return c
+	}
+	c.parts = append(c.parts, part)
+	return c
+}
+
+// QuoteString 把 s 转换为带引号的命令参数，转义其中的反斜杠与引号
+func QuoteString(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) + 2)
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '\\', '"':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\n', '\r':
+			b.WriteByte(' ')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// QuoteName 把玩家名等名称转换为命令参数：简单名称原样返回，其余加引号
+func QuoteName(name string) string {
+	if bareWordPattern.MatchString(name) {
+		return name
+	}
+	return QuoteString(name)
+}
+
+// FormatTarget 把目标转换为命令参数
+// Selector 与以 @ 开头的字符串经 ParseSelector 检查并规范化；
+// 已由 QuoteName / QuoteString 加过引号的名称原样返回，其余字符串作为玩家名处理
+func FormatTarget(target Target) (string, error) {
+	switch t := target.(type) {
+	case *Selector:
+		if t == nil {
+			return "", fmt.Errorf("目标不能为空")
+		}
+		return FormatTarget(t.String())
+	case Selector:
+		return FormatTarget(t.String())
+	case string:
+		if t == "" {
+			return "", fmt.Errorf("目标不能为空")
+		}
+		if isQuotedString(t) {
+			return t, nil
+		}
+		if !strings.HasPrefix(t, "@") {
+			return QuoteName(t), nil
+		}
+		sel, err := ParseSelector(t)
+		if err != nil {
+			return "", err
+		}
+		return sel.String(), nil
+	case nil:
+		return "", fmt.Errorf("目标不能为空")
+	default:
+		return "", fmt.Errorf("不支持的目标类型: %T", target)
+	}
+}
+
+// isQuotedString 判断 s 是否为 QuoteString 生成的完整引号字符串
+func isQuotedString(s string) bool {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return false
+	}
+	escaped := false
+	for i := 1; i < len(s)-1; i++ {
+		switch {
+		case escaped:
+			escaped = false
+		case s[i] == '\\':
+			escaped = true
+		case s[i] == '"':
+			return false
+		}
+	}
+	return !escaped
+}
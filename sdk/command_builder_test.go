@@ -0,0 +1,74 @@
+package sdk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCommandBuilder(t *testing.T) {
+	cases := []struct {
+		cmd  *Command
+		want string
+	}{
+		{NewCommand("give").Name("Steve").Identifier("minecraft:diamond").Int(64), "give Steve minecraft:diamond 64"},
+		{NewCommand("give").Name("Steve Jobs").Identifier("diamond").Int(1), `give "Steve Jobs" diamond 1`},
+		{NewCommand("kill").Name(`a" @e[type=!player] "`), `kill "a\" @e[type=!player] \""`},
+		{NewCommand("kill").Name("@a"), `kill "@a"`},
+		{NewCommand("tp").Target("@p[r=5,name=\"x y\"]").Pos(1.5, 64, -2.25), `tp @p[r=5,name="x y"] 1.5 64 -2.25`},
+		{NewCommand("effect").Target(`"Steve Jobs"`).Keyword("clear"), `effect "Steve Jobs" clear`},
+		{NewCommand("tellraw").Target("@a").JSON(map[string]string{"text": `"}`}), `tellraw @a {"text":"\"}"}`},
+		{NewCommand("title").Target("@a").Keyword("title").Message("line1\nline2"), "title @a title line1 line2"},
+	}
+	for _, c := range cases {
+		got, err := c.cmd.Build()
+		if err != nil || got != c.want {
+			t.Errorf("Build() = %q, %v; want %q", got, err, c.want)
+		}
+	}
+
+	invalid := []*Command{
+		NewCommand("give").Name("Steve").Identifier("diamond 64\nop Steve"),
+		NewCommand("kill").Target("@a] @e"),
+		NewCommand("kill").Target("@a[name=\"x]"),
+		NewCommand("kill").Target("@a[r=1] extra"),
+		NewCommand("kill").Target(""),
+	}
+	for _, cmd := range invalid {
+		if got, err := cmd.Build(); err == nil {
+			t.Errorf("Build() = %q; want error", got)
+		}
+	}
+}
+
+func TestToPlayerSelector(t *testing.T) {
+	utils := &Utils{}
+	if got := utils.ToPlayerSelector(`x"] @e[name="y`); got != `@a[name="x\"] @e[name=\"y"]` {
+		t.Fatalf("ToPlayerSelector = %q", got)
+	}
+	if got := utils.ToPlayerSelector("@a[tag=vip]"); got != "@a[tag=vip]" {
+		t.Fatalf("ToPlayerSelector(selector) = %q", got)
+	}
+}
+
+func TestPlayerCommandsQuoteName(t *testing.T) {
+	commands := &legacyTestCommands{}
+	player := &Player{Name: "Steve Jobs", gameUtils: NewGameUtils(LegacyGameInterface(&legacyTestGame{commands: commands}))}
+
+	player.Teleport(1, 2, 3)
+	player.GiveItem("minecraft:apple", 5)
+	player.ClearItem("minecraft:apple", 2)
+	player.Kick("bye\nop Steve")
+	if err := player.GiveItem("apple 1 0\nop me", 1); err == nil {
+		t.Fatalf("GiveItem with invalid item: want error")
+	}
+
+	want := []string{
+		`tp "Steve Jobs" 1 2 3`,
+		`give "Steve Jobs" minecraft:apple 5 0`,
+		`clear "Steve Jobs" minecraft:apple -1 2`,
+		`kick "Steve Jobs" bye op Steve`,
+	}
+	if !reflect.DeepEqual(commands.sent, want) {
+		t.Fatalf("commands = %q, want %q", commands.sent, want)
+	}
+}
@@ -0,0 +1,130 @@
+package sdk
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CommandPipeline 帮助主进程实现 CommandBatchSender：
+// 连续发送多条命令，再按 CommandOrigin.RequestID 把收到的命令输出对应回各条命令
+//
+// send 负责发送命令且不等待响应，requestID 需要原样填入命令请求的 CommandOrigin.RequestID；
+// 主进程收到命令输出时调用 Deliver，输出需实现 CommandOutputDetails 才能按请求 ID 对应
+//
+// 示例:
+//
+//	pipeline := sdk.NewCommandPipeline(func(cmd, requestID string) error {
+//	    return host.sendCommandRequest(cmd, requestID)
+//	})
+//	// 收到 CommandOutput 数据包时
+//	if !pipeline.Deliver(output) {
+//	    // 不是批量命令的响应，交给原来的处理逻辑
+//	}
+//	// 命令发送器实现 sdk.CommandBatchSender
+//	func (s *HostCommands) SendWSCommandBatch(cmds []string, timeout time.Duration) []sdk.CommandBatchOutput {
+//	    return s.pipeline.SendBatch(cmds, timeout)
+//	}
+type CommandPipeline struct {
+	send func(cmd, requestID string) error
+
+	mu      sync.Mutex
+	pending map[string]chan CommandOutput
+}
+
+// NewCommandPipeline 创建命令流水线
+func NewCommandPipeline(send func(cmd, requestID string) error) *CommandPipeline {
+	return &CommandPipeline{
+		send:    send,
+		pending: make(map[string]chan CommandOutput),
+	}
+}
+
+// Deliver 把命令输出交给等待该请求 ID 的命令，返回是否有命令在等待
+func (p *CommandPipeline) Deliver(output CommandOutput) bool {
+	details, ok := output.(CommandOutputDetails)
+	if !ok {
+		return false
+	}
+	requestID := details.GetCommandOrigin().RequestID
+
+	p.mu.Lock()
+	ch, ok := p.pending[requestID]
+	delete(p.pending, requestID)
+	p.mu.Unlock()
+	if ok {
+		ch <- output
+	}
+	return ok
+}
+
+// SendBatch 连续发送 cmds 中的全部命令，再等待各自的响应，结果与 cmds 顺序一致
+// timeout: 从发送完成起每条命令等待响应的时间
+func (p *CommandPipeline) SendBatch(cmds []string, timeout time.Duration) []CommandBatchOutput {
+	outputs := make([]CommandBatchOutput, len(cmds))
+	ids := make([]string, len(cmds))
+	waits := make([]chan CommandOutput, len(cmds))
+
+	for i, cmd := range cmds {
+		id, err := newRequestID()
+		if err != nil {
+			outputs[i].Err = err
+			continue
+		}
+		ch := make(chan CommandOutput, 1)
+		p.mu.Lock()
+		p.pending[id] = ch
+		p.mu.Unlock()
+		if err := p.send(cmd, id); err != nil {
+			p.forget(id)
+			outputs[i].Err = err
+			continue
+		}
+		ids[i] = id
+		waits[i] = ch
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	expired := false
+	for i, ch := range waits {
+		if ch == nil {
+			continue
+		}
+		if !expired {
+			select {
+			case outputs[i].Output = <-ch:
+				continue
+			case <-timer.C:
+				expired = true
+			}
+		}
+		// 已超时：仍取走在此之前到达的响应
+		select {
+		case outputs[i].Output = <-ch:
+		default:
+			p.forget(ids[i])
+			outputs[i].TimedOut = true
+		}
+	}
+	return outputs
+}
+
+// forget 放弃等待 requestID 的响应
+func (p *CommandPipeline) forget(requestID string) {
+	p.mu.Lock()
+	delete(p.pending, requestID)
+	p.mu.Unlock()
+}
+
+// newRequestID 生成 UUID 格式的命令请求 ID
+func newRequestID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("生成请求 ID 失败: %w", err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
@@ -0,0 +1,61 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCommandPipelineMatchesByRequestID(t *testing.T) {
+	var sent []string
+	var ids []string
+	var pipeline *CommandPipeline
+	pipeline = NewCommandPipeline(func(cmd, requestID string) error {
+		if cmd == "bad" {
+			return errors.New("connection closed")
+		}
+		sent = append(sent, cmd)
+		ids = append(ids, requestID)
+		if len(sent) < 3 {
+			return nil
+		}
+		// 全部命令发送后才按相反顺序返回响应，"lost" 没有响应
+		go func() {
+			for i := len(sent) - 1; i >= 0; i-- {
+				if sent[i] == "lost" {
+					continue
+				}
+				pipeline.Deliver(&CommandResult{
+					Origin:       CommandOrigin{RequestID: ids[i]},
+					SuccessCount: uint32(i + 1),
+				})
+			}
+		}()
+		return nil
+	})
+
+	outputs := pipeline.SendBatch([]string{"first", "bad", "lost", "third"}, 100*time.Millisecond)
+	if len(outputs) != 4 {
+		t.Fatalf("got %d outputs, want 4", len(outputs))
+	}
+	if out := outputs[0]; out.Err != nil || out.TimedOut || out.Output.GetSuccessCount() != 1 {
+		t.Errorf("first = %+v, want the response sent with its request ID", out)
+	}
+	if out := outputs[1]; out.Err == nil || out.Output != nil {
+		t.Errorf("bad = %+v, want send error", out)
+	}
+	if out := outputs[2]; !out.TimedOut || out.Output != nil {
+		t.Errorf("lost = %+v, want timeout", out)
+	}
+	if out := outputs[3]; out.Err != nil || out.TimedOut || out.Output.GetSuccessCount() != 3 {
+		t.Errorf("third = %+v, want the response sent with its request ID", out)
+	}
+	if ids[0] == ids[1] || ids[1] == ids[2] {
+		t.Errorf("request IDs are not unique: %v", ids)
+	}
+
+	// 已超时或未知请求 ID 的响应不会被接收
+	if pipeline.Deliver(&CommandResult{Origin: CommandOrigin{RequestID: ids[1]}}) {
+		t.Errorf("Deliver accepted a response for a timed out command")
+	}
+}
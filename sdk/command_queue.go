@@ -0,0 +1,552 @@
+package sdk
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CommandChannel 是命令发送通道，每个通道独立限速
+type CommandChannel int
+
+const (
+	CommandChannelWS     CommandChannel = iota // SendCommand 使用的 WebSocket 命令通道
+	CommandChannelPlayer                       // SendChat / Title 使用的玩家消息通道
+	CommandChannelWO                           // SendWOCommand 使用的控制台通道
+)
+
+var commandChannels = []CommandChannel{CommandChannelWS, CommandChannelPlayer, CommandChannelWO}
+
+// String 返回通道名称
+func (c CommandChannel) String() string {
+	switch c {
+	case CommandChannelWS:
+		return "ws"
+	case CommandChannelPlayer:
+		return "player"
+	case CommandChannelWO:
+		return "wo"
+	}
+	return fmt.Sprintf("channel(%d)", int(c))
+}
+
+// CommandPriority 是排队命令的优先级，同一通道中优先级高的命令先发送
+type CommandPriority int
+
+const (
+	CommandPriorityLow    CommandPriority = iota // 批量操作、广播等可以延后的命令
+	CommandPriorityNormal                        // SendCommand 等方法的默认优先级
+	CommandPriorityHigh                          // 需要尽快执行的命令（如踢出、传送）
+
+	commandPriorityCount = 3
+)
+
+const (
+	// DefaultCommandQueueMaxPending 是每个通道默认最多排队的命令数
+	DefaultCommandQueueMaxPending = 1000
+	// DefaultCommandQueueDrainTimeout 是插件停止时等待队列发送完毕的默认时间
+	DefaultCommandQueueDrainTimeout = 5 * time.Second
+)
+
+// CommandQueueOptions 是命令队列的配置
+type CommandQueueOptions struct {
+	WSRate     float64 // WS 通道每秒最多发送的命令数，<=0 表示不限速
+	PlayerRate float64 // 玩家消息通道每秒最多发送的消息数，<=0 表示不限速
+	WORate     float64 // 控制台通道每秒最多发送的命令数，<=0 表示不限速
+	Burst      int     // 空闲后允许连续发送的命令数，默认 1
+	MaxPending int     // 每个通道最多排队的命令数，默认 DefaultCommandQueueMaxPending
+	// Coalesce 为 true 时 Enqueue 合并与排队中命令完全相同的新命令，默认关闭
+	// 只适合重复执行没有额外效果的命令；give、扣分等命令合并后会少执行，需要合并时优先使用 EnqueueCoalesced
+	Coalesce bool
+
+	// OnError 在排队命令发送失败时调用（命令入队后发送错误无法返回给调用方）
+	OnError func(channel CommandChannel, cmd string, err error)
+}
+
+// DefaultCommandQueueOptions 返回适合大多数服务器的默认配置
+func DefaultCommandQueueOptions() CommandQueueOptions {
+	return CommandQueueOptions{
+		WSRate:     20,
+		PlayerRate: 2,
+		WORate:     10,
+		Burst:      5,
+		MaxPending: DefaultCommandQueueMaxPending,
+	}
+}
+
+func (o CommandQueueOptions) rate(channel CommandChannel) float64 {
+	switch channel {
+	case CommandChannelWS:
+		return o.WSRate
+	case CommandChannelPlayer:
+		return o.PlayerRate
+	case CommandChannelWO:
+		return o.WORate
+	}
+	return 0
+}
+
+// CommandQueueStats 是单个通道的队列统计
+type CommandQueueStats struct {
+	Pending           int                     // 排队中的命令数
+	PendingByPriority map[CommandPriority]int // 各优先级排队中的命令数
+	Sent              uint64                  // 已发送的命令数
+	Failed            uint64                  // 发送失败的命令数
+	Coalesced         uint64                  // 因合并键与排队命令相同而合并的命令数
+	Dropped           uint64                  // 因队列已满或关闭时被丢弃的命令数
+}
+
+// queuedCommand 是排队中的一条命令
+type queuedCommand struct {
+	cmd      string
+	key      string // 合并用的键，为空时不参与合并
+	priority CommandPriority
+	send     func() error
+}
+
+// commandLane 是单个通道的排队与限速状态
+type commandLane struct {
+	channel CommandChannel
+	rate    float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+	queues  [commandPriorityCount][]*queuedCommand
+	keys    map[string]*queuedCommand
+	sending int
+	stats   CommandQueueStats
+}
+
+func (l *commandLane) pending() int {
+	n := 0
+	for _, queue := range l.queues {
+		n += len(queue)
+	}
+	return n
+}
+
+// reserve 尝试取得一个发送令牌，返回还需等待的时间
+func (l *commandLane) reserve(now time.Time) time.Duration {
+	if l.rate <= 0 {
+		return 0
+	}
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+func (l *commandLane) pop() *queuedCommand {
+	for p := commandPriorityCount - 1; p >= 0; p-- {
+		if queue := l.queues[p]; len(queue) > 0 {
+			item := queue[0]
+			queue[0] = nil
+			l.queues[p] = queue[1:]
+			if item.key != "" {
+				delete(l.keys, item.key)
+			}
+			return item
+		}
+	}
+	return nil
+}
+
+func (l *commandLane) remove(item *queuedCommand) {
+	queue := l.queues[item.priority]
+	for i, queued := range queue {
+		if queued == item {
+			l.queues[item.priority] = append(queue[:i], queue[i+1:]...)
+			return
+		}
+	}
+}
+
+// CommandQueue 按通道限速发送命令，避免短时间内大量命令触发服务器的刷屏保护
+//
+// 每个通道使用令牌桶限速，排队的命令按优先级发送，同一优先级内保持入队顺序。
+// GameUtils.EnableCommandQueue 启用后，SendCommand、SendChat、Title、SendWOCommand、
+// Tellraw、SayTo、SetEffect、ClearEffect 以及基于它们的 Player 方法都会经过队列，
+// 跨进程插件在插件进程中排队；需要等待返回结果的命令（SendCommandWithResponse、GetScore 等）不排队，
+// 其中 SendCommandBatch 与 GetMultiScore 发送每条命令前会先取得 WS 通道的令牌。
+//
+// 示例:
+//
+//	queue := ctx.GameUtils().EnableCommandQueue(sdk.DefaultCommandQueueOptions())
+//	for _, name := range names {
+//	    utils.SendCommandWithPriority("effect "+name+" clear", sdk.CommandPriorityLow)
+//	}
+//	stats := queue.Stats()[sdk.CommandChannelWS]
+//	ctx.Logf("排队 %d 条，已发送 %d 条", stats.Pending, stats.Sent)
+type CommandQueue struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	send    func(channel CommandChannel, cmd string) error
+	opts    CommandQueueOptions
+	lanes   map[CommandChannel]*commandLane
+	closed  bool
+	stopped bool
+	stopCh  chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewCommandQueue 创建命令队列并启动各通道的发送协程
+// send 负责实际发送命令，在队列的发送协程中调用
+func NewCommandQueue(send func(channel CommandChannel, cmd string) error, opts CommandQueueOptions) *CommandQueue {
+	if opts.Burst <= 0 {
+		opts.Burst = 1
+	}
+	if opts.MaxPending <= 0 {
+		opts.MaxPending = DefaultCommandQueueMaxPending
+	}
+	q := &CommandQueue{
+		send:   send,
+		opts:   opts,
+		lanes:  make(map[CommandChannel]*commandLane, len(commandChannels)),
+		stopCh: make(chan struct{}),
+	}
+	q.cond = sync.NewCond(&q.mu)
+	now := time.Now()
+	for _, channel := range commandChannels {
+		lane := &commandLane{
+			channel: channel,
+			rate:    opts.rate(channel),
+			burst:   float64(opts.Burst),
+			tokens:  float64(opts.Burst),
+			last:    now,
+			keys:    make(map[string]*queuedCommand),
+		}
+		q.lanes[channel] = lane
+		q.wg.Add(1)
+		go q.run(lane)
+	}
+	return q
+}
+
+// Enqueue 将命令加入指定通道的队列，命令发送前即返回
+// 队列已满或已关闭时返回错误，发送失败通过 CommandQueueOptions.OnError 通知
+func (q *CommandQueue) Enqueue(channel CommandChannel, cmd string, priority CommandPriority) error {
+	return q.enqueue(channel, cmd, q.autoKey(cmd), priority, func() error {
+		return q.send(channel, cmd)
+	})
+}
+
+// EnqueueCoalesced 与 Enqueue 相同，但同一通道中已有相同 key 的命令排队时，
+// 用 cmd 替换排队中的命令（保留排队位置，新优先级更高时提前），不再新增一条
+// 适合只需要执行最新一次的命令，key 由调用方决定，不受 CommandQueueOptions.Coalesce 影响
+//
+// 示例:
+//
+//	// 频繁刷新的侧边栏只发送最新的一条
+//	queue.EnqueueCoalesced(sdk.CommandChannelWS, "sidebar:"+name, cmd, sdk.CommandPriorityLow)
+func (q *CommandQueue) EnqueueCoalesced(channel CommandChannel, key, cmd string, priority CommandPriority) error {
+	if key == "" {
+		return fmt.Errorf("合并键不能为空")
+	}
+	return q.enqueue(channel, cmd, "key\x00"+key, priority, func() error {
+		return q.send(channel, cmd)
+	})
+}
+
+// autoKey 返回 Enqueue 使用的合并键，未开启 CommandQueueOptions.Coalesce 时不合并
+func (q *CommandQueue) autoKey(key string) string {
+	if !q.opts.Coalesce {
+		return ""
+	}
+	return key
+}
+
+func (q *CommandQueue) enqueue(channel CommandChannel, cmd, key string, priority CommandPriority, send func() error) error {
+	if priority < CommandPriorityLow {
+		priority = CommandPriorityLow
+	} else if priority > CommandPriorityHigh {
+		priority = CommandPriorityHigh
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	lane, ok := q.lanes[channel]
+	if !ok {
+		return fmt.Errorf("未知的命令通道: %v", channel)
+	}
+	if q.closed {
+		lane.stats.Dropped++
+		return fmt.Errorf("命令队列已关闭")
+	}
+	if queued, ok := lane.keys[key]; ok && key != "" {
+		// 同一键只保留一条，发送最新的命令，新命令优先级更高时提前
+		queued.cmd, queued.send = cmd, send
+		if priority > queued.priority {
+			lane.remove(queued)
+			queued.priority = priority
+			lane.queues[priority] = append(lane.queues[priority], queued)
+		}
+		lane.stats.Coalesced++
+		return nil
+	}
+	if lane.pending() >= q.opts.MaxPending {
+		lane.stats.Dropped++
+		return fmt.Errorf("%s 命令队列已满 (%d 条)", channel, q.opts.MaxPending)
+	}
+
+	item := &queuedCommand{cmd: cmd, key: key, priority: priority, send: send}
+	lane.queues[priority] = append(lane.queues[priority], item)
+	if key != "" {
+		lane.keys[key] = item
+	}
+	q.cond.Broadcast()
+	return nil
+}
+
+// wait 阻塞到 channel 通道取得一个发送令牌，用于不排队但需要遵守该通道限速的命令
+// 队列关闭后返回错误
+func (q *CommandQueue) wait(channel CommandChannel) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	lane, ok := q.lanes[channel]
+	if !ok {
+		return fmt.Errorf("未知的命令通道: %v", channel)
+	}
+	for {
+		if q.closed {
+			return fmt.Errorf("命令队列已关闭")
+		}
+		wait := lane.reserve(time.Now())
+		if wait == 0 {
+			return nil
+		}
+		q.mu.Unlock()
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-q.stopCh:
+			timer.Stop()
+		}
+		q.mu.Lock()
+	}
+}
+
+// run 是单个通道的发送协程
+func (q *CommandQueue) run(lane *commandLane) {
+	defer q.wg.Done()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for {
+		for !q.stopped && lane.pending() == 0 {
+			q.cond.Wait()
+		}
+		if q.stopped {
+			return
+		}
+		if wait := lane.reserve(time.Now()); wait > 0 {
+			q.mu.Unlock()
+			timer := time.NewTimer(wait)
+			select {
+			case <-timer.C:
+			case <-q.stopCh:
+				timer.Stop()
+			}
+			q.mu.Lock()
+			continue
+		}
+
+		item := lane.pop()
+		lane.sending++
+		q.mu.Unlock()
+		err := item.send()
+		if err != nil && q.opts.OnError != nil {
+			q.opts.OnError(lane.channel, item.cmd, err)
+		}
+		q.mu.Lock()
+		lane.sending--
+		if err != nil {
+			lane.stats.Failed++
+		} else {
+			lane.stats.Sent++
+		}
+		q.cond.Broadcast()
+	}
+}
+
+// Stats 返回各通道的队列统计
+func (q *CommandQueue) Stats() map[CommandChannel]CommandQueueStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	stats := make(map[CommandChannel]CommandQueueStats, len(q.lanes))
+	for channel, lane := range q.lanes {
+		s := lane.stats
+		s.Pending = lane.pending()
+		s.PendingByPriority = make(map[CommandPriority]int, commandPriorityCount)
+		for p, queue := range lane.queues {
+			s.PendingByPriority[CommandPriority(p)] = len(queue)
+		}
+		stats[channel] = s
+	}
+	return stats
+}
+
+// Pending 返回所有通道排队中的命令总数
+func (q *CommandQueue) Pending() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	n := 0
+	for _, lane := range q.lanes {
+		n += lane.pending()
+	}
+	return n
+}
+
+// Drain 停止接收新命令，等待排队的命令按限速发送完毕后关闭队列
+// timeout <= 0 时一直等待；超时后丢弃剩余命令并返回错误
+//
+// 示例:
+//
+//	func (p *MyPlugin) Stop() error {
+//	    return p.queue.Drain(3 * time.Second)
+//	}
+func (q *CommandQueue) Drain(timeout time.Duration) error {
+	q.mu.Lock()
+	q.closed = true
+	timedOut := false
+	if timeout > 0 {
+		timer := time.AfterFunc(timeout, func() {
+			q.mu.Lock()
+			timedOut = true
+			q.cond.Broadcast()
+			q.mu.Unlock()
+		})
+		defer timer.Stop()
+	}
+	for !q.stopped && !timedOut && q.busy() {
+		q.cond.Wait()
+	}
+	q.mu.Unlock()
+
+	if dropped := q.stop(); dropped > 0 {
+		return fmt.Errorf("命令队列排空超时，丢弃了 %d 条命令", dropped)
+	}
+	return nil
+}
+
+// Close 立即关闭队列，丢弃排队中的命令，返回丢弃的命令数
+func (q *CommandQueue) Close() int {
+	q.mu.Lock()
+	q.closed = true
+	q.mu.Unlock()
+	return q.stop()
+}
+
+func (q *CommandQueue) busy() bool {
+	for _, lane := range q.lanes {
+		if lane.pending() > 0 || lane.sending > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// stop 停止发送协程并丢弃剩余命令，等待正在发送的命令完成
+func (q *CommandQueue) stop() int {
+	q.mu.Lock()
+	dropped := 0
+	if !q.stopped {
+		q.stopped = true
+		close(q.stopCh)
+		for _, lane := range q.lanes {
+			n := lane.pending()
+			lane.stats.Dropped += uint64(n)
+			dropped += n
+			lane.queues = [commandPriorityCount][]*queuedCommand{}
+			lane.keys = make(map[string]*queuedCommand)
+		}
+		q.cond.Broadcast()
+	}
+	q.mu.Unlock()
+	q.wg.Wait()
+	return dropped
+}
+
+// EnableCommandQueue 为 GameUtils 启用命令队列，之后不等待结果的命令（见 CommandQueue）
+// 都会排队限速发送
+// 已启用时先排空旧队列再替换；跨进程插件停止时会自动排空队列
+//
+// 示例:
+//
+//	opts := sdk.DefaultCommandQueueOptions()
+//	opts.OnError = func(channel sdk.CommandChannel, cmd string, err error) {
+//	    ctx.LogWarning("命令 %s 发送失败: %v", cmd, err)
+//	}
+//	ctx.GameUtils().EnableCommandQueue(opts)
+func (g *GameUtils) EnableCommandQueue(opts CommandQueueOptions) *CommandQueue {
+	queue := NewCommandQueue(g.sendDirect, opts)
+	if old := g.queue.Swap(queue); old != nil {
+		old.Drain(DefaultCommandQueueDrainTimeout)
+	}
+	return queue
+}
+
+// CommandQueue 返回当前启用的命令队列，未启用时返回 nil
+func (g *GameUtils) CommandQueue() *CommandQueue {
+	return g.queue.Load()
+}
+
+// DisableCommandQueue 停用命令队列，等待排队的命令发送完毕（最多 timeout）
+// 之后的命令恢复为立即发送
+//
+// 示例:
+//
+//	func (p *MyPlugin) Stop() error {
+//	    return p.ctx.GameUtils().DisableCommandQueue(3 * time.Second)
+//	}
+func (g *GameUtils) DisableCommandQueue(timeout time.Duration) error {
+	queue := g.queue.Swap(nil)
+	if queue == nil {
+		return nil
+	}
+	return queue.Drain(timeout)
+}
+
+// SendCommandWithPriority 以指定优先级发送游戏命令
+// 未启用命令队列时与 SendCommand 相同
+//
+// 示例:
+//
+//	utils.SendCommandWithPriority("kick Griefer", sdk.CommandPriorityHigh)
+//	utils.SendCommandWithPriority("effect @a clear", sdk.CommandPriorityLow)
+func (g *GameUtils) SendCommandWithPriority(cmd string, priority CommandPriority) error {
+	if queue := g.queue.Load(); queue != nil {
+		return queue.Enqueue(CommandChannelWS, cmd, priority)
+	}
+	return g.sendWSCommand(cmd)
+}
+
+// SendCommandCoalesced 以指定优先级发送游戏命令，排队中已有相同 key 的命令时只发送最新的一条
+// 未启用命令队列时与 SendCommand 相同，见 CommandQueue.EnqueueCoalesced
+//
+// 示例:
+//
+//	utils.SendCommandCoalesced("sidebar", "scoreboard objectives setdisplay sidebar "+board, sdk.CommandPriorityLow)
+func (g *GameUtils) SendCommandCoalesced(key, cmd string, priority CommandPriority) error {
+	if queue := g.queue.Load(); queue != nil {
+		return queue.EnqueueCoalesced(CommandChannelWS, key, cmd, priority)
+	}
+	return g.sendWSCommand(cmd)
+}
+
+// sendDirect 是 GameUtils 命令队列的发送函数
+func (g *GameUtils) sendDirect(channel CommandChannel, cmd string) error {
+	switch channel {
+	case CommandChannelWS:
+		return g.sendWSCommand(cmd)
+	case CommandChannelPlayer:
+		return g.sendChat(cmd)
+	case CommandChannelWO:
+		return g.sendWOCommand(cmd)
+	}
+	return fmt.Errorf("未知的命令通道: %v", channel)
+}
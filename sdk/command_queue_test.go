@@ -0,0 +1,154 @@
+package sdk
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// blockingSender 记录发送的命令，第一条命令会阻塞到 release 被调用
+type blockingSender struct {
+	mu      sync.Mutex
+	sent    []string
+	started chan struct{}
+	gate    chan struct{}
+}
+
+func newBlockingSender() *blockingSender {
+	return &blockingSender{started: make(chan struct{}, 1), gate: make(chan struct{})}
+}
+
+func (s *blockingSender) send(channel CommandChannel, cmd string) error {
+	s.mu.Lock()
+	s.sent = append(s.sent, cmd)
+	first := len(s.sent) == 1
+	s.mu.Unlock()
+	if first {
+		s.started <- struct{}{}
+		<-s.gate
+	}
+	return nil
+}
+
+func TestCommandQueuePriorityAndCoalesce(t *testing.T) {
+	sender := newBlockingSender()
+	q := NewCommandQueue(sender.send, CommandQueueOptions{MaxPending: 5})
+
+	q.Enqueue(CommandChannelWS, "first", CommandPriorityNormal)
+	<-sender.started
+
+	q.Enqueue(CommandChannelWS, "say low", CommandPriorityLow)
+	// 默认不合并：两条相同的 give 都要发送
+	q.Enqueue(CommandChannelWS, "give Steve diamond 1", CommandPriorityNormal)
+	q.Enqueue(CommandChannelWS, "give Steve diamond 1", CommandPriorityNormal)
+	q.EnqueueCoalesced(CommandChannelWS, "sidebar", "say b1", CommandPriorityNormal)
+	q.EnqueueCoalesced(CommandChannelWS, "sidebar", "say b2", CommandPriorityHigh)
+	q.Enqueue(CommandChannelWS, "kick x", CommandPriorityHigh)
+	if err := q.Enqueue(CommandChannelWS, "say c", CommandPriorityNormal); err == nil {
+		t.Fatalf("Enqueue on full queue: want error")
+	}
+	if err := q.EnqueueCoalesced(CommandChannelWS, "", "say d", CommandPriorityNormal); err == nil {
+		t.Fatalf("EnqueueCoalesced with empty key: want error")
+	}
+
+	stats := q.Stats()[CommandChannelWS]
+	if stats.Pending != 5 || stats.PendingByPriority[CommandPriorityHigh] != 2 || stats.Coalesced != 1 || stats.Dropped != 1 {
+		t.Fatalf("stats = %+v", stats)
+	}
+
+	close(sender.gate)
+	if err := q.Drain(time.Second); err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+	want := []string{"first", "say b2", "kick x", "give Steve diamond 1", "give Steve diamond 1", "say low"}
+	if !reflect.DeepEqual(sender.sent, want) {
+		t.Fatalf("sent = %q, want %q", sender.sent, want)
+	}
+	if stats := q.Stats()[CommandChannelWS]; stats.Sent != 6 || stats.Pending != 0 {
+		t.Fatalf("stats after drain = %+v", stats)
+	}
+	if err := q.Enqueue(CommandChannelWS, "say late", CommandPriorityNormal); err == nil {
+		t.Fatalf("Enqueue after Drain: want error")
+	}
+
+	// 显式开启 Coalesce 时合并完全相同的命令
+	sender = newBlockingSender()
+	q = NewCommandQueue(sender.send, CommandQueueOptions{Coalesce: true})
+	q.Enqueue(CommandChannelWS, "first", CommandPriorityNormal)
+	<-sender.started
+	for i := 0; i < 3; i++ {
+		q.Enqueue(CommandChannelWS, "effect @a clear", CommandPriorityNormal)
+	}
+	if stats := q.Stats()[CommandChannelWS]; stats.Pending != 1 || stats.Coalesced != 2 {
+		t.Fatalf("stats with Coalesce = %+v", stats)
+	}
+	close(sender.gate)
+	q.Close()
+}
+
+func TestCommandQueueRateLimit(t *testing.T) {
+	var mu sync.Mutex
+	var sent []time.Time
+	q := NewCommandQueue(func(channel CommandChannel, cmd string) error {
+		mu.Lock()
+		sent = append(sent, time.Now())
+		mu.Unlock()
+		return nil
+	}, CommandQueueOptions{WSRate: 50, Burst: 1})
+
+	for _, cmd := range []string{"a", "b", "c", "d", "e"} {
+		q.Enqueue(CommandChannelWS, cmd, CommandPriorityNormal)
+	}
+	// 其他通道不受 WS 通道限速影响
+	q.Enqueue(CommandChannelWO, "list", CommandPriorityNormal)
+	if err := q.Drain(time.Second); err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+	if len(sent) != 6 {
+		t.Fatalf("sent %d commands", len(sent))
+	}
+	if elapsed := sent[len(sent)-1].Sub(sent[0]); elapsed < 60*time.Millisecond {
+		t.Fatalf("5 commands at 50/s sent within %v", elapsed)
+	}
+
+	slow := NewCommandQueue(func(CommandChannel, string) error { return nil }, CommandQueueOptions{WSRate: 1})
+	for _, cmd := range []string{"a", "b", "c"} {
+		slow.Enqueue(CommandChannelWS, cmd, CommandPriorityNormal)
+	}
+	if err := slow.Drain(50 * time.Millisecond); err == nil {
+		t.Fatalf("Drain timeout: want error")
+	}
+	if stats := slow.Stats()[CommandChannelWS]; stats.Sent != 1 || stats.Dropped != 2 {
+		t.Fatalf("stats = %+v", stats)
+	}
+}
+
+func TestGameUtilsCommandQueue(t *testing.T) {
+	commands := &legacyTestCommands{}
+	utils := NewGameUtils(LegacyGameInterface(&legacyTestGame{commands: commands}))
+	player := &Player{Name: "Steve", gameUtils: utils}
+
+	// 首条命令之后每 50ms 发送一条，其余命令在此之前都已排队
+	utils.EnableCommandQueue(CommandQueueOptions{WSRate: 20})
+	if utils.CommandQueue() == nil {
+		t.Fatalf("CommandQueue() = nil after EnableCommandQueue")
+	}
+	player.Teleport(1, 2, 3)
+	utils.SendCommandWithPriority("give Steve diamond 1", CommandPriorityLow)
+	utils.SendCommandWithPriority("give Steve diamond 1", CommandPriorityLow)
+	utils.SendCommandCoalesced("sidebar", "say 1", CommandPriorityLow)
+	utils.SendCommandCoalesced("sidebar", "say 2", CommandPriorityLow)
+	if err := utils.DisableCommandQueue(time.Second); err != nil {
+		t.Fatalf("DisableCommandQueue: %v", err)
+	}
+	if utils.CommandQueue() != nil {
+		t.Fatalf("CommandQueue() != nil after DisableCommandQueue")
+	}
+	utils.SendCommand("say direct")
+
+	want := []string{"tp Steve 1 2 3", "give Steve diamond 1", "give Steve diamond 1", "say 2", "say direct"}
+	if !reflect.DeepEqual(commands.sent, want) {
+		t.Fatalf("commands = %q, want %q", commands.sent, want)
+	}
+}
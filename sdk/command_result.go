@@ -0,0 +1,136 @@
+package sdk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CommandOrigin 描述命令的来源
+type CommandOrigin struct {
+	Origin         uint32 // 来源类型（如 0 为玩家，5 为自动化）
+	UUID           string // 来源 UUID
+	RequestID      string // 请求 ID
+	PlayerUniqueID int64  // 来源玩家的唯一 ID
+}
+
+// CommandResult 是命令执行后的结构化结果
+// JSON 字段与游戏协议中的 CommandOutput 数据包一致
+//
+// 示例:
+//
+//	result, timedOut, err := utils.SendCommandWithResponse("testfor @a", 5.0)
+//	if err == nil && !timedOut && result.Succeeded() {
+//	    ctx.LogInfo("%s", result.Render(sdk.NewTranslator()))
+//	}
+type CommandResult struct {
+	Origin         CommandOrigin `json:"CommandOrigin"`
+	SuccessCount   uint32
+	OutputMessages []CommandOutputMessage
+	DataSet        string
+}
+
+// CommandResult 本身也是 CommandOutput，主进程可以直接返回它
+var _ CommandOutputDetails = (*CommandResult)(nil)
+
+func (r *CommandResult) GetSuccessCount() uint32 {
+	return r.SuccessCount
+}
+
+func (r *CommandResult) GetOutputMessages() []CommandOutputMessage {
+	return r.OutputMessages
+}
+
+func (r *CommandResult) GetCommandOrigin() CommandOrigin {
+	return r.Origin
+}
+
+func (r *CommandResult) GetDataSet() string {
+	return r.DataSet
+}
+
+// ParseCommandOutput 把主进程返回的命令输出转换为 CommandResult
+// output 为 nil 时返回 nil
+func ParseCommandOutput(output CommandOutput) *CommandResult {
+	if output == nil {
+		return nil
+	}
+	if result, ok := output.(*CommandResult); ok {
+		return result
+	}
+	result := &CommandResult{
+		SuccessCount:   output.GetSuccessCount(),
+		OutputMessages: output.GetOutputMessages(),
+	}
+	if details, ok := output.(CommandOutputDetails); ok {
+		result.Origin = details.GetCommandOrigin()
+		result.DataSet = details.GetDataSet()
+	}
+	return result
+}
+
+// Succeeded 判断命令是否执行成功（SuccessCount 大于 0）
+func (r *CommandResult) Succeeded() bool {
+	return r != nil && r.SuccessCount > 0
+}
+
+// Parameters 返回第一条输出消息的参数
+func (r *CommandResult) Parameters() []string {
+	if r == nil || len(r.OutputMessages) == 0 {
+		return nil
+	}
+	return r.OutputMessages[0].Parameters
+}
+
+// Render 使用 translator 把所有输出消息翻译为文本，每条消息一行
+// translator 为 nil 时使用内置翻译
+func (r *CommandResult) Render(translator *Translator) string {
+	if r == nil {
+		return ""
+	}
+	if translator == nil {
+		translator = NewTranslator()
+	}
+	lines := make([]string, 0, len(r.OutputMessages))
+	for _, msg := range r.OutputMessages {
+		lines = append(lines, msg.Render(translator))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Render 使用 translator 翻译该消息，以 % 开头的参数同样会被翻译
+// translator 为 nil 时使用内置翻译
+//
+// 示例:
+//
+//	msg := sdk.CommandOutputMessage{MessageID: "commands.give.success", Parameters: []string{"%item.diamond.name", "1", "Steve"}}
+//	text := msg.Render(nil)
+func (m CommandOutputMessage) Render(translator *Translator) string {
+	if translator == nil {
+		translator = NewTranslator()
+	}
+	args := make([]interface{}, len(m.Parameters))
+	for i, param := range m.Parameters {
+		args[i] = param
+	}
+	return translator.Translate(m.MessageID, args, true)
+}
+
+// parseScore 从 scoreboard players test 的结果中解析分数
+func parseScore(result *CommandResult) (int, error) {
+	if result == nil || len(result.OutputMessages) == 0 {
+		return 0, fmt.Errorf("无法获取分数")
+	}
+
+	parameters := result.Parameters()
+	if len(parameters) == 0 {
+		return 0, fmt.Errorf("无法获取分数参数")
+	}
+
+	// 通常分数在第一个参数中
+	var score int
+	if _, err := fmt.Sscanf(parameters[0], "%d", &score); err != nil {
+		return 0, fmt.Errorf("解析分数失败: %w", err)
+	}
+
+	return score, nil
+}
@@ -0,0 +1,40 @@
+package sdk
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCommandResultRender(t *testing.T) {
+	translator := NewTranslator()
+	translator.AddTranslation("commands.give.success", "已将 %1$s * %2$s 给予 %3$s")
+
+	result := &CommandResult{
+		SuccessCount: 1,
+		OutputMessages: []CommandOutputMessage{
+			{Success: true, MessageID: "commands.give.success", Parameters: []string{"%item.diamond.name", "1", "Steve"}},
+		},
+	}
+	if text := result.Render(translator); text != "已将 钻石 * 1 给予 Steve" {
+		t.Fatalf("Render = %q", text)
+	}
+}
+
+func TestCommandResultJSON(t *testing.T) {
+	// 与游戏协议中 CommandOutput 数据包的 JSON 字段一致
+	data := []byte(`{"CommandOrigin":{"Origin":5,"RequestID":"r1"},"OutputType":3,"SuccessCount":1,` +
+		`"OutputMessages":[{"Success":true,"Message":"commands.scoreboard.players.test.success","Parameters":["7","0","2147483647"]}],"DataSet":""}`)
+	var result CommandResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if result.Origin.Origin != 5 || result.Origin.RequestID != "r1" {
+		t.Fatalf("origin = %+v", result.Origin)
+	}
+	if score, err := parseScore(&result); err != nil || score != 7 {
+		t.Fatalf("parseScore = %d, %v", score, err)
+	}
+	if result.OutputMessages[0].MessageID != "commands.scoreboard.players.test.success" {
+		t.Fatalf("message id = %q", result.OutputMessages[0].MessageID)
+	}
+}
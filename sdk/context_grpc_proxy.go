@@ -3,7 +3,9 @@ package sdk
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"time"
 )
@@ -11,28 +13,63 @@ import (
 // ContextGRPCProxy 是 Context 的 gRPC 代理实现
 // 运行在插件进程中，将所有方法调用转发到主进程的 ContextServer
 type ContextGRPCProxy struct {
-	pluginName    string
-	client        ContextServiceClient
+	pluginName     string
+	client         ContextServiceClient
 	callbackServer *CallbackServerImpl
+	gameUtils      *GameUtils
+	playerManager  *PlayerManager
+	trackPlayers   sync.Once
+	apiRegistry    *PluginAPIRegistry
+	calls          *callPolicy
+	hostCaps       []string
 
 	nextCallbackID uint32
 	callbacksMu    sync.Mutex
 }
 
 func NewContextGRPCProxy(pluginName string, client ContextServiceClient, callbackServer *CallbackServerImpl) *ContextGRPCProxy {
-	return &ContextGRPCProxy{
+	calls := newCallPolicy()
+	gameUtils := newGRPCGameUtils(client, calls)
+	c := &ContextGRPCProxy{
 		pluginName:     pluginName,
 		client:         client,
 		callbackServer: callbackServer,
+		gameUtils:      gameUtils,
+		playerManager:  newGRPCPlayerManager(client, calls, gameUtils),
+		calls:          calls,
 		nextCallbackID: 1,
 	}
+	c.apiRegistry = newGRPCPluginAPIRegistry(c)
+	return c
+}
+
+// SetHostCapabilities 设置 Init 时主进程公布的能力，需在 ToContext 之前调用
+// nil 表示主进程没有协商能力（协议版本 1），此时按支持全部能力处理
+func (c *ContextGRPCProxy) SetHostCapabilities(capabilities []string) {
+	c.hostCaps = capabilities
+}
+
+// SetCallTimeouts 设置插件调用主进程时的超时
+func (c *ContextGRPCProxy) SetCallTimeouts(timeouts CallTimeouts) {
+	c.calls.setTimeouts(timeouts)
+}
+
+// CallStats 返回插件调用主进程的统计，键为方法名
+func (c *ContextGRPCProxy) CallStats() map[string]CallStat {
+	return c.calls.snapshot()
+}
+
+// Close 取消插件对主进程的所有进行中的调用，插件停止时调用
+func (c *ContextGRPCProxy) Close() {
+	c.calls.close()
 }
 
 // ToContext 将 ContextGRPCProxy 转换为 Context
 // 通过创建 ContextOptions 并委托所有调用给 proxy
 func (c *ContextGRPCProxy) ToContext() *Context {
 	opts := ContextOptions{
-		PluginName: c.pluginName,
+		PluginName:       c.pluginName,
+		HostCapabilities: c.hostCaps,
 		BotInfoFunc: func() BotInfo {
 			return c.BotInfo()
 		},
@@ -45,35 +82,47 @@ func (c *ContextGRPCProxy) ToContext() *Context {
 		InterworkInfoFunc: func() InterworkInfo {
 			return c.InterworkInfo()
 		},
+		GameUtilsProvider: func() *GameUtils {
+			return c.GameUtils()
+		},
+		PlayerManagerProvider: func() *PlayerManager {
+			return c.PlayerManager()
+		},
+		APIRegistryProvider: func() *PluginAPIRegistry {
+			return c.apiRegistry
+		},
 		ConsoleRegistrar: func(cmd ConsoleCommand) error {
 			return c.RegisterConsoleCommand(cmd)
 		},
 		Logger: func(format string, args ...interface{}) {
 			c.Logf(format, args...)
 		},
-		RegisterPreload: func(handler PreloadHandler, priority int) error {
-			return c.ListenPreloadWithPriority(handler, priority)
+		RegisterPreload: func(handler PreloadHandler, priority int) (func(), error) {
+			return unregisterFunc(c.ListenPreloadWithPriority(handler, priority))
+		},
+		RegisterActive: func(handler ActiveHandler, priority int) (func(), error) {
+			return unregisterFunc(c.ListenActiveWithPriority(handler, priority))
 		},
-		RegisterActive: func(handler ActiveHandler, priority int) error {
-			return c.ListenActiveWithPriority(handler, priority)
+		RegisterPlayerJoin: func(handler PlayerEventHandler, priority int) (func(), error) {
+			return unregisterFunc(c.ListenPlayerJoinWithPriority(handler, priority))
 		},
-		RegisterPlayerJoin: func(handler PlayerEventHandler, priority int) error {
-			return c.ListenPlayerJoinWithPriority(handler, priority)
+		RegisterPlayerLeave: func(handler PlayerEventHandler, priority int) (func(), error) {
+			return unregisterFunc(c.ListenPlayerLeaveWithPriority(handler, priority))
 		},
-		RegisterPlayerLeave: func(handler PlayerEventHandler, priority int) error {
-			return c.ListenPlayerLeaveWithPriority(handler, priority)
+		RegisterChat: func(handler ChatHandler, priority int) (func(), error) {
+			return unregisterFunc(c.ListenChatWithPriority(handler, priority))
 		},
-		RegisterChat: func(handler ChatHandler, priority int) error {
-			return c.ListenChatWithPriority(handler, priority)
+		RegisterFrameExit: func(handler FrameExitHandler, priority int) (func(), error) {
+			return unregisterFunc(c.ListenFrameExitWithPriority(handler, priority))
 		},
-		RegisterFrameExit: func(handler FrameExitHandler, priority int) error {
-			return c.ListenFrameExitWithPriority(handler, priority)
+		RegisterPacket: func(handler PacketHandler, packetIDs []uint32, priority int) (func(), error) {
+			return unregisterFunc(c.ListenPacketWithPriority(handler, priority, packetIDs...))
 		},
-		RegisterPacket: func(handler PacketHandler, packetIDs []uint32, priority int) error {
-			return c.ListenPacketWithPriority(handler, priority, packetIDs...)
+		RegisterPacketAll: func(handler PacketHandler, priority int) (func(), error) {
+			return unregisterFunc(c.ListenPacketAllWithPriority(handler, priority))
 		},
-		RegisterPacketAll: func(handler PacketHandler, priority int) error {
-			return c.ListenPacketAllWithPriority(handler, priority)
+		RegisterBytesPacket: func(handler BytesPacketHandler, packetIDs []uint32, priority int) (func(), error) {
+			return unregisterFunc(c.ListenBytesPacketWithPriority(handler, priority, packetIDs...))
 		},
 		CancelChatMessage: func(sender, message string) {
 			c.CancelMessage(sender, message)
@@ -81,8 +130,9 @@ func (c *ContextGRPCProxy) ToContext() *Context {
 		WaitPlayerMessage: func(playerName string, timeout time.Duration) (string, error) {
 			return c.WaitMessage(playerName, timeout)
 		},
-		RegisterBroadcast: func(name string, handler BroadcastHandler, priority int) error {
-			return c.ListenBroadcastWithPriority(name, handler, priority)
+		WaitPlayerMessageContext: c.WaitMessageContext,
+		RegisterBroadcast: func(name string, handler BroadcastHandler, priority int) (func(), error) {
+			return unregisterFunc(c.ListenBroadcastWithPriority(name, handler, priority))
 		},
 		TriggerBroadcast: func(broadcast Broadcast) []interface{} {
 			return c.Broadcast(broadcast)
@@ -91,6 +141,33 @@ func (c *ContextGRPCProxy) ToContext() *Context {
 	return NewContext(opts)
 }
 
+// listenerHandle 处理事件注册的响应
+// 注册失败时移除插件侧的回调，成功时返回可同时移除主进程监听器与插件侧回调的句柄
+func (c *ContextGRPCProxy) listenerHandle(callbackID uint32, resp *RegisterHandlerResponse, err error) (*ListenerHandle, error) {
+	if err == nil && !resp.Success {
+		err = errors.New(resp.Error)
+	}
+	if err != nil {
+		c.callbackServer.UnregisterHandler(callbackID)
+		return nil, err
+	}
+
+	return newListenerHandle(func() {
+		callCtx, done := c.calls.start("UnregisterHandler")
+		resp, err := c.client.UnregisterHandler(callCtx, &UnregisterHandlerRequest{
+			HandlerId: callbackID,
+		})
+		done(err)
+		if err == nil && !resp.Success {
+			err = errors.New(resp.Error)
+		}
+		if err != nil {
+			c.LogWarning("移除监听器 %d 失败: %v", callbackID, err)
+		}
+		c.callbackServer.UnregisterHandler(callbackID)
+	}), nil
+}
+
 func (c *ContextGRPCProxy) allocCallbackID() uint32 {
 	c.callbacksMu.Lock()
 	defer c.callbacksMu.Unlock()
@@ -102,7 +179,9 @@ func (c *ContextGRPCProxy) allocCallbackID() uint32 {
 // 实现 Context 接口
 
 func (c *ContextGRPCProxy) PluginName() string {
-	resp, err := c.client.GetPluginName(context.Background(), &Empty{})
+	callCtx, done := c.calls.start("GetPluginName")
+	resp, err := c.client.GetPluginName(callCtx, &Empty{})
+	done(err)
 	if err != nil {
 		return c.pluginName
 	}
@@ -110,22 +189,38 @@ func (c *ContextGRPCProxy) PluginName() string {
 }
 
 func (c *ContextGRPCProxy) BotInfo() BotInfo {
-	resp, err := c.client.GetBotInfo(context.Background(), &Empty{})
+	callCtx, done := c.calls.start("GetBotInfo")
+	resp, err := c.client.GetBotInfo(callCtx, &Empty{})
+	done(err)
 	if err != nil {
 		return BotInfo{}
 	}
+	if resp.SchemaVersion == 0 {
+		// 旧版主进程把 XUID 放在 bot_uuid 中
+		return BotInfo{
+			Name: resp.BotName,
+			XUID: resp.BotUuid,
+		}
+	}
 	return BotInfo{
-		Name: resp.BotName,
-		XUID: resp.BotUuid,
+		Name:            resp.BotName,
+		XUID:            resp.Xuid,
+		EntityUniqueID:  resp.EntityUniqueId,
+		EntityRuntimeID: resp.EntityRuntimeId,
 	}
 }
 
 func (c *ContextGRPCProxy) ServerInfo() ServerInfo {
-	resp, err := c.client.GetServerInfo(context.Background(), &Empty{})
+	callCtx, done := c.calls.start("GetServerInfo")
+	resp, err := c.client.GetServerInfo(callCtx, &Empty{})
+	done(err)
 	if err != nil {
 		return ServerInfo{}
 	}
-	passcodeSet := resp.ServerPassword == "true"
+	passcodeSet := resp.PasscodeSet
+	if resp.SchemaVersion == 0 {
+		passcodeSet = resp.ServerPassword == "true"
+	}
 	return ServerInfo{
 		Code:        resp.ServerCode,
 		PasscodeSet: passcodeSet,
@@ -133,22 +228,24 @@ func (c *ContextGRPCProxy) ServerInfo() ServerInfo {
 }
 
 func (c *ContextGRPCProxy) QQInfo() QQInfo {
-	_, err := c.client.GetQQInfo(context.Background(), &Empty{})
+	callCtx, done := c.calls.start("GetQQInfo")
+	resp, err := c.client.GetQQInfo(callCtx, &Empty{})
+	done(err)
 	if err != nil {
 		return QQInfo{}
 	}
-	// QQInfo 实际字段: Adapter, WSURL, HasAccessToken
-	// proto 定义: BotQq, BotNick, AdminQq
-	// 暂时返回空值
+	// 旧版主进程不提供 QQInfo 的任何字段，此时新字段均为零值
 	return QQInfo{
-		Adapter:        "",
-		WSURL:          "",
-		HasAccessToken: false,
+		Adapter:        resp.Adapter,
+		WSURL:          resp.WsUrl,
+		HasAccessToken: resp.HasAccessToken,
 	}
 }
 
 func (c *ContextGRPCProxy) InterworkInfo() InterworkInfo {
-	resp, err := c.client.GetInterworkInfo(context.Background(), &Empty{})
+	callCtx, done := c.calls.start("GetInterworkInfo")
+	resp, err := c.client.GetInterworkInfo(callCtx, &Empty{})
+	done(err)
 	if err != nil {
 		return InterworkInfo{}
 	}
@@ -161,217 +258,219 @@ func (c *ContextGRPCProxy) RegisterConsoleCommand(cmd ConsoleCommand) error {
 	callbackID := c.allocCallbackID()
 	c.callbackServer.RegisterConsoleCommandHandler(callbackID, cmd.Handler)
 
-	resp, err := c.client.RegisterConsoleCommand(context.Background(), &RegisterConsoleCommandRequest{
+	callCtx, done := c.calls.start("RegisterConsoleCommand")
+	resp, err := c.client.RegisterConsoleCommand(callCtx, &RegisterConsoleCommandRequest{
 		Name:       cmd.Name,
 		Triggers:   cmd.Triggers,
 		Usage:      cmd.Usage,
 		CallbackId: callbackID,
 	})
+	done(err)
 	if err != nil {
 		return err
 	}
 	if !resp.Success {
-		return fmt.Errorf(resp.Error)
+		return errors.New(resp.Error)
 	}
 	return nil
 }
 
 func (c *ContextGRPCProxy) Logf(format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
-	c.client.Log(context.Background(), &LogRequest{Message: msg})
+	callCtx, done := c.calls.start("Log")
+	_, err := c.client.Log(callCtx, &LogRequest{Message: msg})
+	done(err)
 }
 
 func (c *ContextGRPCProxy) LogInfo(format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
-	c.client.LogInfo(context.Background(), &LogRequest{Message: msg})
+	callCtx, done := c.calls.start("LogInfo")
+	_, err := c.client.LogInfo(callCtx, &LogRequest{Message: msg})
+	done(err)
 }
 
 func (c *ContextGRPCProxy) LogSuccess(format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
-	c.client.LogSuccess(context.Background(), &LogRequest{Message: msg})
+	callCtx, done := c.calls.start("LogSuccess")
+	_, err := c.client.LogSuccess(callCtx, &LogRequest{Message: msg})
+	done(err)
 }
 
 func (c *ContextGRPCProxy) LogWarning(format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
-	c.client.LogWarning(context.Background(), &LogRequest{Message: msg})
+	callCtx, done := c.calls.start("LogWarning")
+	_, err := c.client.LogWarning(callCtx, &LogRequest{Message: msg})
+	done(err)
 }
 
 func (c *ContextGRPCProxy) LogError(format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
-	c.client.LogError(context.Background(), &LogRequest{Message: msg})
+	callCtx, done := c.calls.start("LogError")
+	_, err := c.client.LogError(callCtx, &LogRequest{Message: msg})
+	done(err)
 }
 
-func (c *ContextGRPCProxy) ListenPreload(handler PreloadHandler) error {
+func (c *ContextGRPCProxy) ListenPreload(handler PreloadHandler) (*ListenerHandle, error) {
 	return c.ListenPreloadWithPriority(handler, 0)
 }
 
-func (c *ContextGRPCProxy) ListenPreloadWithPriority(handler PreloadHandler, priority int) error {
+func (c *ContextGRPCProxy) ListenPreloadWithPriority(handler PreloadHandler, priority int) (*ListenerHandle, error) {
 	callbackID := c.allocCallbackID()
 	c.callbackServer.RegisterPreloadHandler(callbackID, handler)
 
-	resp, err := c.client.RegisterPreloadHandler(context.Background(), &RegisterHandlerRequest{
+	callCtx, done := c.calls.start("RegisterPreloadHandler")
+	resp, err := c.client.RegisterPreloadHandler(callCtx, &RegisterHandlerRequest{
 		CallbackId: callbackID,
 		Priority:   int32(priority),
 	})
-	if err != nil {
-		return err
-	}
-	if !resp.Success {
-		return fmt.Errorf(resp.Error)
-	}
-	return nil
+	done(err)
+	return c.listenerHandle(callbackID, resp, err)
 }
 
-func (c *ContextGRPCProxy) ListenActive(handler ActiveHandler) error {
+func (c *ContextGRPCProxy) ListenActive(handler ActiveHandler) (*ListenerHandle, error) {
 	return c.ListenActiveWithPriority(handler, 0)
 }
 
-func (c *ContextGRPCProxy) ListenActiveWithPriority(handler ActiveHandler, priority int) error {
+func (c *ContextGRPCProxy) ListenActiveWithPriority(handler ActiveHandler, priority int) (*ListenerHandle, error) {
 	callbackID := c.allocCallbackID()
 	c.callbackServer.RegisterActiveHandler(callbackID, handler)
 
-	resp, err := c.client.RegisterActiveHandler(context.Background(), &RegisterHandlerRequest{
+	callCtx, done := c.calls.start("RegisterActiveHandler")
+	resp, err := c.client.RegisterActiveHandler(callCtx, &RegisterHandlerRequest{
 		CallbackId: callbackID,
 		Priority:   int32(priority),
 	})
-	if err != nil {
-		return err
-	}
-	if !resp.Success {
-		return fmt.Errorf(resp.Error)
-	}
-	return nil
+	done(err)
+	return c.listenerHandle(callbackID, resp, err)
 }
 
-func (c *ContextGRPCProxy) ListenPlayerJoin(handler PlayerEventHandler) error {
+func (c *ContextGRPCProxy) ListenPlayerJoin(handler PlayerEventHandler) (*ListenerHandle, error) {
 	return c.ListenPlayerJoinWithPriority(handler, 0)
 }
 
-func (c *ContextGRPCProxy) ListenPlayerJoinWithPriority(handler PlayerEventHandler, priority int) error {
+func (c *ContextGRPCProxy) ListenPlayerJoinWithPriority(handler PlayerEventHandler, priority int) (*ListenerHandle, error) {
 	callbackID := c.allocCallbackID()
 	c.callbackServer.RegisterPlayerJoinHandler(callbackID, handler)
 
-	resp, err := c.client.RegisterPlayerJoinHandler(context.Background(), &RegisterHandlerRequest{
+	callCtx, done := c.calls.start("RegisterPlayerJoinHandler")
+	resp, err := c.client.RegisterPlayerJoinHandler(callCtx, &RegisterHandlerRequest{
 		CallbackId: callbackID,
 		Priority:   int32(priority),
 	})
-	if err != nil {
-		return err
-	}
-	if !resp.Success {
-		return fmt.Errorf(resp.Error)
-	}
-	return nil
+	done(err)
+	return c.listenerHandle(callbackID, resp, err)
 }
 
-func (c *ContextGRPCProxy) ListenPlayerLeave(handler PlayerEventHandler) error {
+func (c *ContextGRPCProxy) ListenPlayerLeave(handler PlayerEventHandler) (*ListenerHandle, error) {
 	return c.ListenPlayerLeaveWithPriority(handler, 0)
 }
 
-func (c *ContextGRPCProxy) ListenPlayerLeaveWithPriority(handler PlayerEventHandler, priority int) error {
+func (c *ContextGRPCProxy) ListenPlayerLeaveWithPriority(handler PlayerEventHandler, priority int) (*ListenerHandle, error) {
 	callbackID := c.allocCallbackID()
 	c.callbackServer.RegisterPlayerLeaveHandler(callbackID, handler)
 
-	resp, err := c.client.RegisterPlayerLeaveHandler(context.Background(), &RegisterHandlerRequest{
+	callCtx, done := c.calls.start("RegisterPlayerLeaveHandler")
+	resp, err := c.client.RegisterPlayerLeaveHandler(callCtx, &RegisterHandlerRequest{
 		CallbackId: callbackID,
 		Priority:   int32(priority),
 	})
-	if err != nil {
-		return err
-	}
-	if !resp.Success {
-		return fmt.Errorf(resp.Error)
-	}
-	return nil
+	done(err)
+	return c.listenerHandle(callbackID, resp, err)
 }
 
-func (c *ContextGRPCProxy) ListenChat(handler ChatHandler) error {
+func (c *ContextGRPCProxy) ListenChat(handler ChatHandler) (*ListenerHandle, error) {
 	return c.ListenChatWithPriority(handler, 0)
 }
 
-func (c *ContextGRPCProxy) ListenChatWithPriority(handler ChatHandler, priority int) error {
+func (c *ContextGRPCProxy) ListenChatWithPriority(handler ChatHandler, priority int) (*ListenerHandle, error) {
 	callbackID := c.allocCallbackID()
 	c.callbackServer.RegisterChatHandler(callbackID, handler)
 
-	resp, err := c.client.RegisterChatHandler(context.Background(), &RegisterHandlerRequest{
+	callCtx, done := c.calls.start("RegisterChatHandler")
+	resp, err := c.client.RegisterChatHandler(callCtx, &RegisterHandlerRequest{
 		CallbackId: callbackID,
 		Priority:   int32(priority),
 	})
-	if err != nil {
-		return err
-	}
-	if !resp.Success {
-		return fmt.Errorf(resp.Error)
-	}
-	return nil
+	done(err)
+	return c.listenerHandle(callbackID, resp, err)
 }
 
-func (c *ContextGRPCProxy) ListenFrameExit(handler FrameExitHandler) error {
+func (c *ContextGRPCProxy) ListenFrameExit(handler FrameExitHandler) (*ListenerHandle, error) {
 	return c.ListenFrameExitWithPriority(handler, 0)
 }
 
-func (c *ContextGRPCProxy) ListenFrameExitWithPriority(handler FrameExitHandler, priority int) error {
+func (c *ContextGRPCProxy) ListenFrameExitWithPriority(handler FrameExitHandler, priority int) (*ListenerHandle, error) {
 	callbackID := c.allocCallbackID()
 	c.callbackServer.RegisterFrameExitHandler(callbackID, handler)
 
-	resp, err := c.client.RegisterFrameExitHandler(context.Background(), &RegisterHandlerRequest{
+	callCtx, done := c.calls.start("RegisterFrameExitHandler")
+	resp, err := c.client.RegisterFrameExitHandler(callCtx, &RegisterHandlerRequest{
 		CallbackId: callbackID,
 		Priority:   int32(priority),
 	})
-	if err != nil {
-		return err
-	}
-	if !resp.Success {
-		return fmt.Errorf(resp.Error)
-	}
-	return nil
+	done(err)
+	return c.listenerHandle(callbackID, resp, err)
 }
 
-func (c *ContextGRPCProxy) ListenPacket(handler PacketHandler, packetIDs ...uint32) error {
+func (c *ContextGRPCProxy) ListenPacket(handler PacketHandler, packetIDs ...uint32) (*ListenerHandle, error) {
 	return c.ListenPacketWithPriority(handler, 0, packetIDs...)
 }
 
-func (c *ContextGRPCProxy) ListenPacketWithPriority(handler PacketHandler, priority int, packetIDs ...uint32) error {
+func (c *ContextGRPCProxy) ListenPacketWithPriority(handler PacketHandler, priority int, packetIDs ...uint32) (*ListenerHandle, error) {
 	callbackID := c.allocCallbackID()
 	c.callbackServer.RegisterPacketHandler(callbackID, handler)
 
-	resp, err := c.client.RegisterPacketHandler(context.Background(), &RegisterPacketHandlerRequest{
+	callCtx, done := c.calls.start("RegisterPacketHandler")
+	resp, err := c.client.RegisterPacketHandler(callCtx, &RegisterPacketHandlerRequest{
+		CallbackId:   callbackID,
+		PacketIds:    packetIDs,
+		Priority:     int32(priority),
+		RawPacketIds: DefaultPacketCodecs.Filter(packetIDs),
+	})
+	done(err)
+	return c.listenerHandle(callbackID, resp, err)
+}
+
+func (c *ContextGRPCProxy) ListenBytesPacket(handler BytesPacketHandler, packetIDs ...uint32) (*ListenerHandle, error) {
+	return c.ListenBytesPacketWithPriority(handler, 0, packetIDs...)
+}
+
+func (c *ContextGRPCProxy) ListenBytesPacketWithPriority(handler BytesPacketHandler, priority int, packetIDs ...uint32) (*ListenerHandle, error) {
+	callbackID := c.allocCallbackID()
+	c.callbackServer.RegisterBytesPacketHandler(callbackID, handler)
+
+	callCtx, done := c.calls.start("RegisterBytesPacketHandler")
+	resp, err := c.client.RegisterBytesPacketHandler(callCtx, &RegisterPacketHandlerRequest{
 		CallbackId: callbackID,
 		PacketIds:  packetIDs,
 		Priority:   int32(priority),
 	})
-	if err != nil {
-		return err
-	}
-	if !resp.Success {
-		return fmt.Errorf(resp.Error)
-	}
-	return nil
+	done(err)
+	return c.listenerHandle(callbackID, resp, err)
 }
 
-func (c *ContextGRPCProxy) ListenPacketAll(handler PacketHandler) error {
+func (c *ContextGRPCProxy) ListenPacketAll(handler PacketHandler) (*ListenerHandle, error) {
 	return c.ListenPacketAllWithPriority(handler, 0)
 }
 
-func (c *ContextGRPCProxy) ListenPacketAllWithPriority(handler PacketHandler, priority int) error {
+func (c *ContextGRPCProxy) ListenPacketAllWithPriority(handler PacketHandler, priority int) (*ListenerHandle, error) {
 	callbackID := c.allocCallbackID()
 	c.callbackServer.RegisterPacketHandler(callbackID, handler)
 
-	resp, err := c.client.RegisterPacketAllHandler(context.Background(), &RegisterHandlerRequest{
-		CallbackId: callbackID,
-		Priority:   int32(priority),
+	callCtx, done := c.calls.start("RegisterPacketAllHandler")
+	resp, err := c.client.RegisterPacketAllHandler(callCtx, &RegisterHandlerRequest{
+		CallbackId:   callbackID,
+		Priority:     int32(priority),
+		RawPacketIds: DefaultPacketCodecs.IDs(),
 	})
-	if err != nil {
-		return err
-	}
-	if !resp.Success {
-		return fmt.Errorf(resp.Error)
-	}
-	return nil
+	done(err)
+	return c.listenerHandle(callbackID, resp, err)
 }
 
 func (c *ContextGRPCProxy) DataPath() string {
-	resp, err := c.client.GetDataPath(context.Background(), &Empty{})
+	callCtx, done := c.calls.start("GetDataPath")
+	resp, err := c.client.GetDataPath(callCtx, &Empty{})
+	done(err)
 	if err != nil {
 		return ""
 	}
@@ -379,9 +478,11 @@ func (c *ContextGRPCProxy) DataPath() string {
 }
 
 func (c *ContextGRPCProxy) FormatDataPath(path ...string) string {
-	resp, err := c.client.FormatDataPath(context.Background(), &FormatDataPathRequest{
+	callCtx, done := c.calls.start("FormatDataPath")
+	resp, err := c.client.FormatDataPath(callCtx, &FormatDataPathRequest{
 		PathParts: path,
 	})
+	done(err)
 	if err != nil {
 		return ""
 	}
@@ -389,46 +490,57 @@ func (c *ContextGRPCProxy) FormatDataPath(path ...string) string {
 }
 
 func (c *ContextGRPCProxy) CancelMessage(sender, message string) {
-	c.client.CancelMessage(context.Background(), &CancelMessageRequest{
+	callCtx, done := c.calls.start("CancelMessage")
+	_, err := c.client.CancelMessage(callCtx, &CancelMessageRequest{
 		Sender:  sender,
 		Message: message,
 	})
+	done(err)
 }
 
 func (c *ContextGRPCProxy) WaitMessage(playerName string, timeout time.Duration) (string, error) {
-	resp, err := c.client.WaitMessage(context.Background(), &WaitMessageRequest{
+	return c.WaitMessageContext(context.Background(), playerName, timeout)
+}
+
+// WaitMessageContext 等待玩家消息，ctx 结束时取消调用，主进程随之移除等待者
+func (c *ContextGRPCProxy) WaitMessageContext(ctx context.Context, playerName string, timeout time.Duration) (string, error) {
+	callCtx, done := c.calls.startAtLeast("WaitMessage", timeout+time.Second)
+	callCtx, cancel := context.WithCancel(callCtx)
+	defer context.AfterFunc(ctx, cancel)()
+	resp, err := c.client.WaitMessage(callCtx, &WaitMessageRequest{
 		PlayerName: playerName,
 		TimeoutMs:  int64(timeout / time.Millisecond),
 	})
+	cancel()
+	done(err)
 	if err != nil {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
 		return "", err
 	}
 	if !resp.Success {
-		return "", fmt.Errorf(resp.Error)
+		return "", errors.New(resp.Error)
 	}
 	return resp.Message, nil
 }
 
-func (c *ContextGRPCProxy) ListenBroadcast(name string, handler BroadcastHandler) error {
+func (c *ContextGRPCProxy) ListenBroadcast(name string, handler BroadcastHandler) (*ListenerHandle, error) {
 	return c.ListenBroadcastWithPriority(name, handler, 0)
 }
 
-func (c *ContextGRPCProxy) ListenBroadcastWithPriority(name string, handler BroadcastHandler, priority int) error {
+func (c *ContextGRPCProxy) ListenBroadcastWithPriority(name string, handler BroadcastHandler, priority int) (*ListenerHandle, error) {
 	callbackID := c.allocCallbackID()
 	c.callbackServer.RegisterBroadcastHandler(callbackID, handler)
 
-	resp, err := c.client.RegisterBroadcastHandler(context.Background(), &RegisterBroadcastHandlerRequest{
+	callCtx, done := c.calls.start("RegisterBroadcastHandler")
+	resp, err := c.client.RegisterBroadcastHandler(callCtx, &RegisterBroadcastHandlerRequest{
 		EventName:  name,
 		CallbackId: callbackID,
 		Priority:   int32(priority),
 	})
-	if err != nil {
-		return err
-	}
-	if !resp.Success {
-		return fmt.Errorf(resp.Error)
-	}
-	return nil
+	done(err)
+	return c.listenerHandle(callbackID, resp, err)
 }
 
 func (c *ContextGRPCProxy) Broadcast(broadcast Broadcast) []interface{} {
@@ -437,10 +549,12 @@ func (c *ContextGRPCProxy) Broadcast(broadcast Broadcast) []interface{} {
 		return []interface{}{}
 	}
 
-	resp, err := c.client.TriggerBroadcast(context.Background(), &TriggerBroadcastRequest{
+	callCtx, done := c.calls.start("TriggerBroadcast")
+	resp, err := c.client.TriggerBroadcast(callCtx, &TriggerBroadcastRequest{
 		Name: broadcast.Name,
 		Data: dataBytes,
 	})
+	done(err)
 	if err != nil {
 		return []interface{}{}
 	}
@@ -452,40 +566,71 @@ func (c *ContextGRPCProxy) Broadcast(broadcast Broadcast) []interface{} {
 	return results
 }
 
-// 这些方法返回 nil 或代理实现
+// GameUtils 返回转发到主进程的 GameUtils 代理
 func (c *ContextGRPCProxy) GameUtils() *GameUtils {
-	return &GameUtils{
-		sayToFunc: func(player, message string) {
-			resp, err := c.client.SayTo(context.Background(), &SayToRequest{
-				Player:  player,
-				Message: message,
-			})
-			if err != nil {
-				fmt.Printf("[GameUtils gRPC] SayTo 调用失败: %v\n", err)
-				return
-			}
-			if !resp.Success {
-				fmt.Printf("[GameUtils gRPC] SayTo 返回失败: %s\n", resp.Error)
-			}
-		},
+	return c.gameUtils
+}
+
+// PlayerManager 返回与主进程同步的玩家管理器
+// 首次调用时从主进程拉取玩家列表，并注册进出服事件以保持缓存最新
+func (c *ContextGRPCProxy) PlayerManager() *PlayerManager {
+	c.trackPlayers.Do(func() {
+		if err := c.playerManager.Refresh(); err != nil {
+			c.LogWarning("同步玩家列表失败: %v", err)
+		}
+		// 以最高优先级更新进服玩家、最低优先级移除退服玩家，
+		// 保证插件自身的处理器执行时缓存中的状态是正确的
+		if _, err := c.ListenPlayerJoinWithPriority(c.onPlayerJoin, math.MaxInt32); err != nil {
+			c.LogWarning("注册玩家进服跟踪失败: %v", err)
+		}
+		if _, err := c.ListenPlayerLeaveWithPriority(c.onPlayerLeave, math.MinInt32); err != nil {
+			c.LogWarning("注册玩家退服跟踪失败: %v", err)
+		}
+	})
+	return c.playerManager
+}
+
+func (c *ContextGRPCProxy) onPlayerJoin(event PlayerEvent) {
+	if event.Name == "" {
+		// 事件未携带玩家信息时回退为全量同步
+		go c.playerManager.Refresh()
+		return
 	}
+	c.playerManager.AddPlayer(event.Name, event.UUID, event.XUID, event.EntityUniqueID, event.EntityRuntimeID)
 }
+
+func (c *ContextGRPCProxy) onPlayerLeave(event PlayerEvent) {
+	if event.Name == "" {
+		go c.playerManager.Refresh()
+		return
+	}
+	c.playerManager.RemovePlayer(event.Name)
+}
+
 func (c *ContextGRPCProxy) Utils() *Utils                   { return NewUtils() }
 func (c *ContextGRPCProxy) Translator() *Translator         { return NewTranslator() }
 func (c *ContextGRPCProxy) Console() *Console               { return NewConsole(c.pluginName) }
 func (c *ContextGRPCProxy) Config(configDir ...string) *Config { return NewConfig(c.pluginName, configDir...) }
 func (c *ContextGRPCProxy) TempJSON(defaultDir ...string) *TempJSON { return NewTempJSON(defaultDir...) }
-func (c *ContextGRPCProxy) PlayerManager() *PlayerManager   { return nil }
 func (c *ContextGRPCProxy) PacketWaiter() *PacketWaiter     { return nil }
 func (c *ContextGRPCProxy) GetPluginAPI(name string) (Plugin, PluginAPIVersion, error) {
-	return nil, PluginAPIVersion{}, fmt.Errorf("GetPluginAPI not supported in gRPC plugins")
+	return c.apiRegistry.Get(name)
 }
 func (c *ContextGRPCProxy) GetPluginAPIWithVersion(name string, version PluginAPIVersion) (Plugin, error) {
-	return nil, fmt.Errorf("GetPluginAPIWithVersion not supported in gRPC plugins")
+	return c.apiRegistry.GetWithVersion(name, version)
 }
 func (c *ContextGRPCProxy) RegisterPluginAPI(name string, version PluginAPIVersion, plugin Plugin) error {
-	return fmt.Errorf("RegisterPluginAPI not supported in gRPC plugins")
+	return c.apiRegistry.Register(name, version, plugin)
+}
+func (c *ContextGRPCProxy) ExportPluginAPI(name string, version PluginAPIVersion, methods PluginAPIMethods) error {
+	return c.apiRegistry.RegisterMethods(name, version, methods)
+}
+func (c *ContextGRPCProxy) GetPluginAPIClient(name string) (*PluginAPIClient, error) {
+	return c.apiRegistry.Client(name)
+}
+func (c *ContextGRPCProxy) GetPluginAPIClientWithVersion(name string, version PluginAPIVersion) (*PluginAPIClient, error) {
+	return c.apiRegistry.ClientWithVersion(name, version)
 }
 func (c *ContextGRPCProxy) ListPluginAPIs() []PluginAPIInfo {
-	return []PluginAPIInfo{}
+	return c.apiRegistry.List()
 }
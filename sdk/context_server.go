@@ -3,7 +3,9 @@ package sdk
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 )
@@ -24,24 +26,84 @@ type ContextServer struct {
 	// 延迟注册：当 callbackClient 为 nil 时缓存注册请求
 	pendingRegistrations []func() error
 	pendingMu            sync.Mutex
+
+	// 在延迟注册执行前就被移除的回调 ID
+	removedCallbacks map[uint32]bool
+
+	// 插件进程已退出，不再接受新的注册
+	closed bool
+
+	// 回调插件时使用的超时与慢调用统计
+	calls *callPolicy
+
+	// 插件使用协议版本 1，不支持事件流
+	legacy bool
 }
 
 type callbackInfo struct {
 	callbackID  uint32
 	handlerType string // "chat", "player_join", "player_leave", etc.
+	unregister  func() // 移除主进程侧的监听器，可以为 nil
 }
 
 func NewContextServer(ctx *Context) *ContextServer {
-	return &ContextServer{
+	s := &ContextServer{
 		ctx:                  ctx,
 		callbacks:            make(map[uint32]*callbackInfo),
+		removedCallbacks:     make(map[uint32]bool),
 		nextCallbackID:       1,
 		pendingRegistrations: make([]func() error, 0),
+		calls:                newCallPolicy(),
+	}
+	s.calls.onSlow = func(method string, elapsed time.Duration, err error) {
+		if errors.Is(err, context.DeadlineExceeded) {
+			ctx.LogWarning("插件 %s 处理 %s 超时（耗时 %v），已取消", ctx.PluginName(), method, elapsed)
+			return
+		}
+		ctx.LogWarning("插件 %s 处理 %s 过慢（耗时 %v）", ctx.PluginName(), method, elapsed)
+	}
+	return s
+}
+
+// Capabilities 返回主进程向插件公布的能力
+// 协议层面的能力总是支持，功能性能力取决于主进程 Context 是否启用了对应功能
+func (s *ContextServer) Capabilities() []string {
+	opts := s.ctx.opts
+	unavailable := map[string]bool{
+		CapabilityGameUtils:     opts.GameUtilsProvider == nil,
+		CapabilityPlayerManager: opts.PlayerManagerProvider == nil,
+		CapabilityPluginAPI:     opts.APIRegistryProvider == nil,
+		CapabilityBytesPacket:   opts.RegisterBytesPacket == nil,
+		CapabilityEventStream:   s.legacy,
+	}
+	capabilities := make([]string, 0, len(Capabilities))
+	for _, capability := range s.ctx.HostCapabilities() {
+		if !unavailable[capability] {
+			capabilities = append(capabilities, capability)
+		}
 	}
+	return capabilities
+}
+
+// SetCallTimeouts 设置回调插件时的超时
+// 超时的回调会被取消，主进程的事件分发不会被无响应的插件阻塞
+func (s *ContextServer) SetCallTimeouts(timeouts CallTimeouts) {
+	s.calls.setTimeouts(timeouts)
+}
+
+// CallStats 返回回调插件的调用统计，键为方法名
+func (s *ContextServer) CallStats() map[string]CallStat {
+	return s.calls.snapshot()
 }
 
+// SetCallbackClient 设置插件的回调客户端并执行延迟的注册
+// 插件支持事件流时，事件通过 EventStream 投递，否则使用单次调用（协议版本 1 的插件直接使用单次调用）
 func (s *ContextServer) SetCallbackClient(client CallbackServiceClient) {
-	s.callbackClient = client
+	if s.legacy {
+		s.callbackClient = client
+	} else {
+		s.callbackClient = newStreamingCallbackClient(client)
+	}
 
 	// 执行所有待处理的注册
 	s.pendingMu.Lock()
@@ -56,8 +118,40 @@ func (s *ContextServer) SetCallbackClient(client CallbackServiceClient) {
 	}
 }
 
+// Close 移除插件注册的所有监听器，取消进行中的回调并关闭事件流
+// 插件进程崩溃或被卸载后调用，避免主进程继续向已失效的连接投递事件
+func (s *ContextServer) Close() {
+	s.pendingMu.Lock()
+	s.closed = true
+	s.pendingRegistrations = nil
+	s.pendingMu.Unlock()
+
+	s.callbacksMu.Lock()
+	callbacks := s.callbacks
+	s.callbacks = make(map[uint32]*callbackInfo)
+	s.removedCallbacks = make(map[uint32]bool)
+	s.callbacksMu.Unlock()
+
+	for _, info := range callbacks {
+		if info.unregister != nil {
+			info.unregister()
+		}
+	}
+
+	s.calls.close()
+	if client, ok := s.callbackClient.(*streamingCallbackClient); ok {
+		client.Close()
+	}
+}
+
 // deferOrExecute 延迟执行或立即执行注册函数
 func (s *ContextServer) deferOrExecute(register func() error) error {
+	s.pendingMu.Lock()
+	closed := s.closed
+	s.pendingMu.Unlock()
+	if closed {
+		return fmt.Errorf("plugin connection closed")
+	}
 	if s.callbackClient == nil {
 		s.pendingMu.Lock()
 		s.pendingRegistrations = append(s.pendingRegistrations, register)
@@ -67,6 +161,95 @@ func (s *ContextServer) deferOrExecute(register func() error) error {
 	return register()
 }
 
+// trackCallback 记录已注册的回调，供 UnregisterHandler 移除
+// 如果插件在延迟注册执行前就已请求移除，或连接已关闭，则立即移除监听器
+func (s *ContextServer) trackCallback(callbackID uint32, handlerType string, unregister func()) {
+	s.pendingMu.Lock()
+	closed := s.closed
+	s.pendingMu.Unlock()
+
+	s.callbacksMu.Lock()
+	if closed || s.removedCallbacks[callbackID] {
+		delete(s.removedCallbacks, callbackID)
+		s.callbacksMu.Unlock()
+		if unregister != nil {
+			unregister()
+		}
+		return
+	}
+	s.callbacks[callbackID] = &callbackInfo{
+		callbackID:  callbackID,
+		handlerType: handlerType,
+		unregister:  unregister,
+	}
+	s.callbacksMu.Unlock()
+}
+
+// UnregisterHandler 移除插件注册的监听器
+func (s *ContextServer) UnregisterHandler(ctx context.Context, req *UnregisterHandlerRequest) (*BoolResponse, error) {
+	s.callbacksMu.Lock()
+	info, ok := s.callbacks[req.HandlerId]
+	if ok {
+		delete(s.callbacks, req.HandlerId)
+	} else {
+		// 注册可能仍在等待 callbackClient，记录下来在注册时移除
+		s.removedCallbacks[req.HandlerId] = true
+	}
+	s.callbacksMu.Unlock()
+
+	if ok && info.unregister != nil {
+		info.unregister()
+	}
+	return &BoolResponse{Success: true}, nil
+}
+
+// awaitCall 在 fn 返回或 RPC 的 ctx 结束时返回
+// 插件取消调用或调用超时后立即返回 ctx.Err()，不再占用这次 RPC；
+// fn 内部的等待应先用 boundTimeout 缩短到 ctx 的截止时间，使主进程侧的命令随之结束
+func awaitCall[T any](ctx context.Context, fn func() T) (T, error) {
+	var zero T
+	if err := ctx.Err(); err != nil {
+		return zero, err
+	}
+	done := make(chan T, 1)
+	go func() { done <- fn() }()
+	select {
+	case result := <-done:
+		return result, nil
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+// awaitValue 是 awaitCall 针对返回 (值, error) 的函数的简写，ctx 结束时返回 ctx.Err()
+func awaitValue[T any](ctx context.Context, fn func() (T, error)) (T, error) {
+	type result struct {
+		value T
+		err   error
+	}
+	r, err := awaitCall(ctx, func() result {
+		value, err := fn()
+		return result{value, err}
+	})
+	if err != nil {
+		return r.value, err
+	}
+	return r.value, r.err
+}
+
+// boundTimeout 把以秒为单位的等待时间限制在 ctx 的截止时间内，0 表示默认的 30 秒
+func boundTimeout(ctx context.Context, seconds float64) float64 {
+	if seconds <= 0 {
+		seconds = 30
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline).Seconds(); remaining < seconds {
+			seconds = max(remaining, 0.001)
+		}
+	}
+	return seconds
+}
+
 // 日志方法
 func (s *ContextServer) Log(ctx context.Context, req *LogRequest) (*LogResponse, error) {
 	s.ctx.Logf("%s", req.Message)
@@ -98,42 +281,44 @@ func (s *ContextServer) GetPluginName(ctx context.Context, req *Empty) (*StringR
 	return &StringResponse{Value: s.ctx.PluginName()}, nil
 }
 
+// 信息查询同时填充旧版字段，旧版插件仍能读取到原有的值
 func (s *ContextServer) GetBotInfo(ctx context.Context, req *Empty) (*BotInfoResponse, error) {
 	info := s.ctx.BotInfo()
 	return &BotInfoResponse{
-		BotName: info.Name,
-		BotUuid: info.XUID,
+		BotName:         info.Name,
+		BotUuid:         info.XUID,
+		Xuid:            info.XUID,
+		EntityUniqueId:  info.EntityUniqueID,
+		EntityRuntimeId: info.EntityRuntimeID,
+		SchemaVersion:   InfoSchemaVersion,
 	}, nil
 }
 
 func (s *ContextServer) GetServerInfo(ctx context.Context, req *Empty) (*ServerInfoResponse, error) {
 	info := s.ctx.ServerInfo()
-	passcodeSet := "false"
-	if info.PasscodeSet {
-		passcodeSet = "true"
-	}
 	return &ServerInfoResponse{
 		ServerCode:     info.Code,
-		ServerPassword: passcodeSet,
-		ServerAddress:  "",
+		ServerPassword: strconv.FormatBool(info.PasscodeSet),
+		PasscodeSet:    info.PasscodeSet,
+		SchemaVersion:  InfoSchemaVersion,
 	}, nil
 }
 
 func (s *ContextServer) GetQQInfo(ctx context.Context, req *Empty) (*QQInfoResponse, error) {
-	// QQInfo 实际字段: Adapter, WSURL, HasAccessToken
-	// proto 定义: BotQq, BotNick, AdminQq
-	// 暂时返回空值，后续可扩展
+	info := s.ctx.QQInfo()
 	return &QQInfoResponse{
-		BotQq:   0,
-		BotNick: "",
-		AdminQq: []uint64{},
+		Adapter:        info.Adapter,
+		WsUrl:          info.WSURL,
+		HasAccessToken: info.HasAccessToken,
+		SchemaVersion:  InfoSchemaVersion,
 	}, nil
 }
 
 func (s *ContextServer) GetInterworkInfo(ctx context.Context, req *Empty) (*InterworkInfoResponse, error) {
 	info := s.ctx.InterworkInfo()
 	return &InterworkInfoResponse{
-		LinkedGroups: info.LinkedGroups,
+		LinkedGroups:  info.LinkedGroups,
+		SchemaVersion: InfoSchemaVersion,
 	}, nil
 }
 
@@ -153,10 +338,362 @@ func (s *ContextServer) SayTo(ctx context.Context, req *SayToRequest) (*BoolResp
 	if gu == nil {
 		return &BoolResponse{Success: false, Error: "GameUtils not available"}, nil
 	}
-	gu.SayTo(req.Player, req.Message)
+	if err := gu.SayTo(req.Player, req.Message); err != nil {
+		return &BoolResponse{Success: false, Error: err.Error()}, nil
+	}
+	return &BoolResponse{Success: true}, nil
+}
+
+func (s *ContextServer) SendCommand(ctx context.Context, req *SendCommandRequest) (*BoolResponse, error) {
+	gu := s.ctx.GameUtils()
+	if gu == nil {
+		return &BoolResponse{Success: false, Error: "GameUtils not available"}, nil
+	}
+	if err := gu.SendCommand(req.Command); err != nil {
+		return &BoolResponse{Success: false, Error: err.Error()}, nil
+	}
+	return &BoolResponse{Success: true}, nil
+}
+
+func (s *ContextServer) SendWOCommand(ctx context.Context, req *SendCommandRequest) (*BoolResponse, error) {
+	gu := s.ctx.GameUtils()
+	if gu == nil {
+		return &BoolResponse{Success: false, Error: "GameUtils not available"}, nil
+	}
+	if err := gu.SendWOCommand(req.Command); err != nil {
+		return &BoolResponse{Success: false, Error: err.Error()}, nil
+	}
+	return &BoolResponse{Success: true}, nil
+}
+
+func (s *ContextServer) SendChat(ctx context.Context, req *SendChatRequest) (*BoolResponse, error) {
+	gu := s.ctx.GameUtils()
+	if gu == nil {
+		return &BoolResponse{Success: false, Error: "GameUtils not available"}, nil
+	}
+	if err := gu.SendChat(req.Message); err != nil {
+		return &BoolResponse{Success: false, Error: err.Error()}, nil
+	}
+	return &BoolResponse{Success: true}, nil
+}
+
+func (s *ContextServer) Title(ctx context.Context, req *SendChatRequest) (*BoolResponse, error) {
+	gu := s.ctx.GameUtils()
+	if gu == nil {
+		return &BoolResponse{Success: false, Error: "GameUtils not available"}, nil
+	}
+	if err := gu.Title(req.Message); err != nil {
+		return &BoolResponse{Success: false, Error: err.Error()}, nil
+	}
+	return &BoolResponse{Success: true}, nil
+}
+
+func (s *ContextServer) SendCommandWithResponse(ctx context.Context, req *SendCommandWithResponseRequest) (*SendCommandWithResponseResponse, error) {
+	gu := s.ctx.GameUtils()
+	if gu == nil {
+		return &SendCommandWithResponseResponse{Success: false, Error: "GameUtils not available"}, nil
+	}
+
+	type commandResponse struct {
+		output   *CommandResult
+		timedOut bool
+		err      error
+	}
+	timeout := boundTimeout(ctx, req.Timeout)
+	result, err := awaitCall(ctx, func() commandResponse {
+		output, timedOut, err := gu.SendCommandWithResponse(req.Command, timeout)
+		return commandResponse{output, timedOut, err}
+	})
+	if err != nil {
+		return &SendCommandWithResponseResponse{Success: false, Error: err.Error()}, nil
+	}
+
+	return commandResultResponse(result.output, result.timedOut, result.err)
+}
+
+func (s *ContextServer) SendCommandBatch(ctx context.Context, req *SendCommandBatchRequest) (*SendCommandBatchResponse, error) {
+	gu := s.ctx.GameUtils()
+	if gu == nil {
+		return &SendCommandBatchResponse{Success: false, Error: "GameUtils not available"}, nil
+	}
+
+	timeout := boundTimeout(ctx, req.Timeout)
+	results, err := awaitCall(ctx, func() []CommandBatchResult {
+		return gu.SendCommandBatch(req.Commands, timeout)
+	})
+	if err != nil {
+		return &SendCommandBatchResponse{Success: false, Error: err.Error()}, nil
+	}
+
+	resp := &SendCommandBatchResponse{Success: true, Results: make([]*SendCommandWithResponseResponse, len(results))}
+	for i, r := range results {
+		item, err := commandResultResponse(r.Result, r.TimedOut, r.Err)
+		if err != nil {
+			return nil, err
+		}
+		resp.Results[i] = item
+	}
+	return resp, nil
+}
+
+// commandResultResponse 把一条命令的执行结果转换为 SendCommandWithResponseResponse
+func commandResultResponse(output *CommandResult, timedOut bool, err error) (*SendCommandWithResponseResponse, error) {
+	resp := &SendCommandWithResponseResponse{Success: err == nil, TimedOut: timedOut}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+	if output != nil {
+		outputBytes, marshalErr := json.Marshal(output)
+		if marshalErr != nil {
+			return nil, fmt.Errorf("failed to serialize command output: %w", marshalErr)
+		}
+		resp.Output = outputBytes
+	}
+	return resp, nil
+}
+
+func (s *ContextServer) GetScore(ctx context.Context, req *GetScoreRequest) (*GetScoreResponse, error) {
+	gu := s.ctx.GameUtils()
+	if gu == nil {
+		return &GetScoreResponse{Success: false, Error: "GameUtils not available"}, nil
+	}
+	timeout := boundTimeout(ctx, req.Timeout)
+	score, err := awaitValue(ctx, func() (int, error) { return gu.GetScore(req.Scoreboard, req.Target, timeout) })
+	if err != nil {
+		return &GetScoreResponse{Success: false, Error: err.Error()}, nil
+	}
+	return &GetScoreResponse{Success: true, Score: int64(score)}, nil
+}
+
+func (s *ContextServer) GetPos(ctx context.Context, req *GetPosRequest) (*GetPosResponse, error) {
+	gu := s.ctx.GameUtils()
+	if gu == nil {
+		return &GetPosResponse{Success: false, Error: "GameUtils not available"}, nil
+	}
+	pos, err := awaitValue(ctx, func() (*Position, error) { return gu.GetPos(req.Target) })
+	if err != nil {
+		return &GetPosResponse{Success: false, Error: err.Error()}, nil
+	}
+	return &GetPosResponse{
+		Success:   true,
+		X:         pos.X,
+		Y:         pos.Y,
+		Z:         pos.Z,
+		Dimension: uint32(pos.Dimension),
+		YRot:      pos.YRot,
+	}, nil
+}
+
+func (s *ContextServer) GetTarget(ctx context.Context, req *GetTargetRequest) (*GetTargetResponse, error) {
+	gu := s.ctx.GameUtils()
+	if gu == nil {
+		return &GetTargetResponse{Success: false, Error: "GameUtils not available"}, nil
+	}
+	timeout := boundTimeout(ctx, req.Timeout)
+	names, err := awaitValue(ctx, func() ([]string, error) { return gu.GetTarget(req.Target, timeout) })
+	if err != nil {
+		return &GetTargetResponse{Success: false, Error: err.Error()}, nil
+	}
+	return &GetTargetResponse{Success: true, Names: names}, nil
+}
+
+func (s *ContextServer) GetItem(ctx context.Context, req *GetItemRequest) (*GetItemResponse, error) {
+	gu := s.ctx.GameUtils()
+	if gu == nil {
+		return &GetItemResponse{Success: false, Error: "GameUtils not available"}, nil
+	}
+	count, err := awaitValue(ctx, func() (int, error) { return gu.GetItem(req.Target, req.ItemName, int(req.ItemSpecialId)) })
+	if err != nil {
+		return &GetItemResponse{Success: false, Error: err.Error()}, nil
+	}
+	return &GetItemResponse{Success: true, Count: int32(count)}, nil
+}
+
+func (s *ContextServer) IsOp(ctx context.Context, req *IsOpRequest) (*IsOpResponse, error) {
+	gu := s.ctx.GameUtils()
+	if gu == nil {
+		return &IsOpResponse{Success: false, Error: "GameUtils not available"}, nil
+	}
+	isOp, err := awaitValue(ctx, func() (bool, error) { return gu.IsOp(req.PlayerName) })
+	if err != nil {
+		return &IsOpResponse{Success: false, Error: err.Error()}, nil
+	}
+	return &IsOpResponse{Success: true, IsOp: isOp}, nil
+}
+
+func (s *ContextServer) Tellraw(ctx context.Context, req *TellrawRequest) (*BoolResponse, error) {
+	gu := s.ctx.GameUtils()
+	if gu == nil {
+		return &BoolResponse{Success: false, Error: "GameUtils not available"}, nil
+	}
+	if err := gu.Tellraw(req.Selector, req.Message); err != nil {
+		return &BoolResponse{Success: false, Error: err.Error()}, nil
+	}
+	return &BoolResponse{Success: true}, nil
+}
+
+func (s *ContextServer) SetEffect(ctx context.Context, req *SetEffectRequest) (*BoolResponse, error) {
+	gu := s.ctx.GameUtils()
+	if gu == nil {
+		return &BoolResponse{Success: false, Error: "GameUtils not available"}, nil
+	}
+	opts := EffectOptions{
+		Duration:      int(req.Duration),
+		Level:         int(req.Level),
+		HideParticles: req.HideParticles,
+	}
+	if err := gu.SetEffect(req.Target, int(req.EffectId), opts); err != nil {
+		return &BoolResponse{Success: false, Error: err.Error()}, nil
+	}
+	return &BoolResponse{Success: true}, nil
+}
+
+// SendPacket 发送插件提交的数据包
+// 数据包以 JSON 形式跨进程传输，主进程侧还原为 map 后交给 GameUtils
+func (s *ContextServer) SendPacket(ctx context.Context, req *SendPacketRequest) (*BoolResponse, error) {
+	gu := s.ctx.GameUtils()
+	if gu == nil {
+		return &BoolResponse{Success: false, Error: "GameUtils not available"}, nil
+	}
+	var packet map[string]interface{}
+	if err := json.Unmarshal(req.PacketData, &packet); err != nil {
+		return &BoolResponse{Success: false, Error: fmt.Sprintf("failed to deserialize packet: %v", err)}, nil
+	}
+	if err := gu.SendPacket(req.PacketId, packet); err != nil {
+		return &BoolResponse{Success: false, Error: err.Error()}, nil
+	}
 	return &BoolResponse{Success: true}, nil
 }
 
+// ListPlayers 返回主进程当前的在线玩家列表和机器人信息
+func (s *ContextServer) ListPlayers(ctx context.Context, req *Empty) (*ListPlayersResponse, error) {
+	pm := s.ctx.PlayerManager()
+	if pm == nil {
+		return &ListPlayersResponse{Success: false, Error: "PlayerManager not available"}, nil
+	}
+	players := pm.GetAllPlayers()
+	infos := make([]*PlayerInfo, 0, len(players))
+	for _, player := range players {
+		infos = append(infos, playerToInfo(player))
+	}
+	return &ListPlayersResponse{
+		Success: true,
+		Players: infos,
+		Bot:     playerToInfo(pm.GetBotInfo()),
+	}, nil
+}
+
+// GetPlayer 按名称、UUID 或 EntityUniqueID 查询单个玩家
+func (s *ContextServer) GetPlayer(ctx context.Context, req *GetPlayerRequest) (*GetPlayerResponse, error) {
+	pm := s.ctx.PlayerManager()
+	if pm == nil {
+		return &GetPlayerResponse{Success: false, Error: "PlayerManager not available"}, nil
+	}
+	var player *Player
+	switch {
+	case req.Name != "":
+		player = pm.GetPlayerByName(req.Name)
+	case req.Uuid != "":
+		player = pm.GetPlayerByUUID(req.Uuid)
+	case req.EntityUniqueId != 0:
+		player = pm.GetPlayerByUniqueID(req.EntityUniqueId)
+	default:
+		return &GetPlayerResponse{Success: false, Error: "no lookup key provided"}, nil
+	}
+	if player == nil {
+		return &GetPlayerResponse{Success: true, Found: false}, nil
+	}
+	return &GetPlayerResponse{Success: true, Found: true, Player: playerToInfo(player)}, nil
+}
+
+// ExportPluginAPI 将插件导出的方法注册到主进程的 API 注册表
+// 每个方法调用都会通过 CallbackService 转发回导出方插件
+func (s *ContextServer) ExportPluginAPI(ctx context.Context, req *ExportPluginAPIRequest) (*BoolResponse, error) {
+	callbackID := req.CallbackId
+	methods := make(PluginAPIMethods, len(req.Methods))
+	for _, name := range req.Methods {
+		method := name
+		methods[method] = func(args []byte) ([]byte, error) {
+			if s.callbackClient == nil {
+				return nil, fmt.Errorf("plugin API %s is not ready yet", req.Name)
+			}
+			callCtx, done := s.calls.start("OnPluginAPICall")
+			resp, err := s.callbackClient.OnPluginAPICall(callCtx, &PluginAPICallRequest{
+				CallbackId: callbackID,
+				Method:     method,
+				Args:       args,
+			})
+			done(err)
+			if err != nil {
+				return nil, err
+			}
+			if !resp.Success {
+				return nil, errors.New(resp.Error)
+			}
+			return resp.Result, nil
+		}
+	}
+
+	if err := s.ctx.ExportPluginAPI(req.Name, apiVersionFromInfo(req.Version), methods); err != nil {
+		return &BoolResponse{Success: false, Error: err.Error()}, nil
+	}
+
+	s.trackCallback(callbackID, "plugin_api", func() {
+		if registry, err := s.ctx.apiRegistry(); err == nil {
+			registry.Unregister(req.Name)
+		}
+	})
+	return &BoolResponse{Success: true}, nil
+}
+
+// GetPluginAPIInfo 查询 API 信息，设置 required_version 时检查版本兼容性
+func (s *ContextServer) GetPluginAPIInfo(ctx context.Context, req *GetPluginAPIInfoRequest) (*GetPluginAPIInfoResponse, error) {
+	registry, err := s.ctx.apiRegistry()
+	if err != nil {
+		return &GetPluginAPIInfoResponse{Success: false, Error: err.Error()}, nil
+	}
+
+	var required *PluginAPIVersion
+	if req.RequiredVersion != nil {
+		version := apiVersionFromInfo(req.RequiredVersion)
+		required = &version
+	}
+	info, err := registry.lookup(req.Name, required)
+	if err != nil {
+		return &GetPluginAPIInfoResponse{Success: false, Error: err.Error()}, nil
+	}
+	return &GetPluginAPIInfoResponse{Success: true, Api: apiInfoToDescriptor(info)}, nil
+}
+
+func (s *ContextServer) ListPluginAPIs(ctx context.Context, req *Empty) (*ListPluginAPIsResponse, error) {
+	apis := s.ctx.ListPluginAPIs()
+	descriptors := make([]*PluginAPIDescriptor, 0, len(apis))
+	for _, api := range apis {
+		descriptors = append(descriptors, apiInfoToDescriptor(api))
+	}
+	return &ListPluginAPIsResponse{Apis: descriptors}, nil
+}
+
+// CallPluginAPI 通过主进程的注册表调用 API 方法
+// API 提供方可以是同进程插件，也可以是另一个 gRPC 插件
+func (s *ContextServer) CallPluginAPI(ctx context.Context, req *CallPluginAPIRequest) (*CallPluginAPIResponse, error) {
+	registry, err := s.ctx.apiRegistry()
+	if err != nil {
+		return &CallPluginAPIResponse{Success: false, Error: err.Error()}, nil
+	}
+
+	var required *PluginAPIVersion
+	if req.RequiredVersion != nil {
+		version := apiVersionFromInfo(req.RequiredVersion)
+		required = &version
+	}
+	result, err := awaitValue(ctx, func() ([]byte, error) { return registry.call(req.Name, required, req.Method, req.Args) })
+	if err != nil {
+		return &CallPluginAPIResponse{Success: false, Error: err.Error()}, nil
+	}
+	return &CallPluginAPIResponse{Success: true, Result: result}, nil
+}
+
 // 控制台命令注册
 func (s *ContextServer) RegisterConsoleCommand(ctx context.Context, req *RegisterConsoleCommandRequest) (*BoolResponse, error) {
 	callbackID := req.CallbackId
@@ -171,23 +708,27 @@ func (s *ContextServer) RegisterConsoleCommand(ctx context.Context, req *Registe
 			Usage:    usage,
 			Handler: func(args []string) error {
 				// 通过 gRPC 回调插件
-				resp, err := s.callbackClient.OnConsoleCommand(context.Background(), &ConsoleCommandRequest{
+				callCtx, done := s.calls.start("OnConsoleCommand")
+				resp, err := s.callbackClient.OnConsoleCommand(callCtx, &ConsoleCommandRequest{
 					CallbackId: callbackID,
 					Args:       args,
 				})
+				done(err)
 				if err != nil {
 					return err
 				}
 				if !resp.Success {
-					return fmt.Errorf(resp.Error)
+					return errors.New(resp.Error)
 				}
 				return nil
 			},
 		}
 
-		if err := s.ctx.RegisterConsoleCommand(cmd); err != nil {
+		unregister, err := s.ctx.registerConsoleCommand(cmd)
+		if err != nil {
 			return err
 		}
+		s.trackCallback(callbackID, "console", unregister)
 		return nil
 	})
 
@@ -204,30 +745,46 @@ func (s *ContextServer) RegisterChatHandler(ctx context.Context, req *RegisterHa
 
 	err := s.deferOrExecute(func() error {
 		handler := func(event *ChatEvent) {
-			resp, err := s.callbackClient.OnChatEvent(context.Background(), &ChatEventRequest{
+			req := &ChatEventRequest{
 				CallbackId: callbackID,
 				Sender:     event.Sender,
 				Message:    event.Message,
-			})
+				TextType:   uint32(event.TextType),
+				Parameters: event.Parameters,
+				Cancelled:  event.Cancelled,
+			}
+			if event.Raw != nil {
+				rawData, err := json.Marshal(event.Raw)
+				if err != nil {
+					s.ctx.LogError("Failed to serialize ChatEvent: %v", err)
+					return
+				}
+				req.RawData = rawData
+			}
+
+			callCtx, done := s.calls.start("OnChatEvent")
+			resp, err := s.callbackClient.OnChatEvent(callCtx, req)
+			done(err)
 			if err != nil {
 				s.ctx.LogError("Chat handler gRPC call failed: %v", err)
 				return
 			}
-			if resp.Cancel {
+
+			// 旧版插件不会设置 modified，只能追加取消
+			if resp.Modified {
+				event.Message = resp.Message
+				event.Parameters = resp.Parameters
+				event.Cancelled = resp.Cancel
+			} else if resp.Cancel {
 				event.Cancelled = true
 			}
 		}
 
-		if err := s.ctx.ListenChatWithPriority(handler, priority); err != nil {
+		handle, err := s.ctx.ListenChatWithPriority(handler, priority)
+		if err != nil {
 			return err
 		}
-
-		s.callbacksMu.Lock()
-		s.callbacks[callbackID] = &callbackInfo{
-			callbackID:  callbackID,
-			handlerType: "chat",
-		}
-		s.callbacksMu.Unlock()
+		s.trackCallback(callbackID, "chat", handle.Unregister)
 		return nil
 	})
 
@@ -237,36 +794,53 @@ func (s *ContextServer) RegisterChatHandler(ctx context.Context, req *RegisterHa
 	return &RegisterHandlerResponse{Success: true, HandlerId: callbackID}, nil
 }
 
+// newPlayerEventRequest 将主进程的 PlayerEvent 转换为回调请求
+// 除 Raw 外的字段都以类型化字段传输，保证插件侧收到的事件与同进程插件一致
+func newPlayerEventRequest(callbackID uint32, event PlayerEvent) (*PlayerEventRequest, error) {
+	req := &PlayerEventRequest{
+		CallbackId:      callbackID,
+		Name:            event.Name,
+		Xuid:            event.XUID,
+		Uuid:            event.UUID,
+		EntityUniqueId:  event.EntityUniqueID,
+		EntityRuntimeId: event.EntityRuntimeID,
+		BuildPlatform:   event.BuildPlatform,
+		EntryIndex:      int32(event.EntryIndex),
+	}
+	if event.Raw != nil {
+		rawData, err := json.Marshal(event.Raw)
+		if err != nil {
+			return nil, err
+		}
+		req.RawData = rawData
+	}
+	return req, nil
+}
+
 func (s *ContextServer) RegisterPlayerJoinHandler(ctx context.Context, req *RegisterHandlerRequest) (*RegisterHandlerResponse, error) {
 	callbackID := req.CallbackId
 	priority := int(req.Priority)
 
 	err := s.deferOrExecute(func() error {
 		handler := func(event PlayerEvent) {
-			rawData, err := json.Marshal(event.Raw)
+			req, err := newPlayerEventRequest(callbackID, event)
 			if err != nil {
 				s.ctx.LogError("Failed to serialize PlayerEvent: %v", err)
 				return
 			}
-			_, err = s.callbackClient.OnPlayerJoinEvent(context.Background(), &PlayerEventRequest{
-				CallbackId: callbackID,
-				RawData:    rawData,
-			})
+			callCtx, done := s.calls.start("OnPlayerJoinEvent")
+			_, err = s.callbackClient.OnPlayerJoinEvent(callCtx, req)
+			done(err)
 			if err != nil {
 				s.ctx.LogError("PlayerJoin handler gRPC call failed: %v", err)
 			}
 		}
 
-		if err := s.ctx.ListenPlayerJoinWithPriority(handler, priority); err != nil {
+		handle, err := s.ctx.ListenPlayerJoinWithPriority(handler, priority)
+		if err != nil {
 			return err
 		}
-
-		s.callbacksMu.Lock()
-		s.callbacks[callbackID] = &callbackInfo{
-			callbackID:  callbackID,
-			handlerType: "player_join",
-		}
-		s.callbacksMu.Unlock()
+		s.trackCallback(callbackID, "player_join", handle.Unregister)
 		return nil
 	})
 
@@ -282,30 +856,24 @@ func (s *ContextServer) RegisterPlayerLeaveHandler(ctx context.Context, req *Reg
 
 	err := s.deferOrExecute(func() error {
 		handler := func(event PlayerEvent) {
-			rawData, err := json.Marshal(event.Raw)
+			req, err := newPlayerEventRequest(callbackID, event)
 			if err != nil {
 				s.ctx.LogError("Failed to serialize PlayerEvent: %v", err)
 				return
 			}
-			_, err = s.callbackClient.OnPlayerLeaveEvent(context.Background(), &PlayerEventRequest{
-				CallbackId: callbackID,
-				RawData:    rawData,
-			})
+			callCtx, done := s.calls.start("OnPlayerLeaveEvent")
+			_, err = s.callbackClient.OnPlayerLeaveEvent(callCtx, req)
+			done(err)
 			if err != nil {
 				s.ctx.LogError("PlayerLeave handler gRPC call failed: %v", err)
 			}
 		}
 
-		if err := s.ctx.ListenPlayerLeaveWithPriority(handler, priority); err != nil {
+		handle, err := s.ctx.ListenPlayerLeaveWithPriority(handler, priority)
+		if err != nil {
 			return err
 		}
-
-		s.callbacksMu.Lock()
-		s.callbacks[callbackID] = &callbackInfo{
-			callbackID:  callbackID,
-			handlerType: "player_leave",
-		}
-		s.callbacksMu.Unlock()
+		s.trackCallback(callbackID, "player_leave", handle.Unregister)
 		return nil
 	})
 
@@ -315,39 +883,63 @@ func (s *ContextServer) RegisterPlayerLeaveHandler(ctx context.Context, req *Reg
 	return &RegisterHandlerResponse{Success: true, HandlerId: callbackID}, nil
 }
 
+// newPacketEventRequest 将主进程的 PacketEvent 转换为回调请求
+// 插件请求了原始字节且主进程提供了 Data 时直接转发字节，否则回退为 JSON
+func newPacketEventRequest(callbackID uint32, event PacketEvent, rawIDs map[uint32]bool) (*PacketEventRequest, error) {
+	req := &PacketEventRequest{
+		CallbackId: callbackID,
+		PacketId:   event.ID,
+	}
+	if rawIDs[event.ID] && event.Data != nil {
+		req.Raw = true
+		req.PacketData = event.Data
+		return req, nil
+	}
+
+	packetData, err := json.Marshal(event.Raw)
+	if err != nil {
+		return nil, err
+	}
+	req.PacketData = packetData
+	return req, nil
+}
+
+// packetIDSet 将数据包 ID 列表转换为集合
+func packetIDSet(ids []uint32) map[uint32]bool {
+	set := make(map[uint32]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
+
 func (s *ContextServer) RegisterPacketHandler(ctx context.Context, req *RegisterPacketHandlerRequest) (*RegisterHandlerResponse, error) {
 	callbackID := req.CallbackId
 	priority := int(req.Priority)
 	packetIDs := req.PacketIds
+	rawIDs := packetIDSet(req.RawPacketIds)
 
 	err := s.deferOrExecute(func() error {
 		handler := func(event PacketEvent) {
-			packetData, err := json.Marshal(event.Raw)
+			req, err := newPacketEventRequest(callbackID, event, rawIDs)
 			if err != nil {
 				s.ctx.LogError("Failed to serialize packet: %v", err)
 				return
 			}
 
-			_, err = s.callbackClient.OnPacketEvent(context.Background(), &PacketEventRequest{
-				CallbackId: callbackID,
-				PacketId:   event.ID,
-				PacketData: packetData,
-			})
+			callCtx, done := s.calls.start("OnPacketEvent")
+			_, err = s.callbackClient.OnPacketEvent(callCtx, req)
+			done(err)
 			if err != nil {
 				s.ctx.LogError("Packet handler gRPC call failed: %v", err)
 			}
 		}
 
-		if err := s.ctx.ListenPacketWithPriority(handler, priority, packetIDs...); err != nil {
+		handle, err := s.ctx.ListenPacketWithPriority(handler, priority, packetIDs...)
+		if err != nil {
 			return err
 		}
-
-		s.callbacksMu.Lock()
-		s.callbacks[callbackID] = &callbackInfo{
-			callbackID:  callbackID,
-			handlerType: "packet",
-		}
-		s.callbacksMu.Unlock()
+		s.trackCallback(callbackID, "packet", handle.Unregister)
 		return nil
 	})
 
@@ -360,35 +952,66 @@ func (s *ContextServer) RegisterPacketHandler(ctx context.Context, req *Register
 func (s *ContextServer) RegisterPacketAllHandler(ctx context.Context, req *RegisterHandlerRequest) (*RegisterHandlerResponse, error) {
 	callbackID := req.CallbackId
 	priority := int(req.Priority)
+	rawIDs := packetIDSet(req.RawPacketIds)
 
 	err := s.deferOrExecute(func() error {
 		handler := func(event PacketEvent) {
-			packetData, err := json.Marshal(event.Raw)
+			req, err := newPacketEventRequest(callbackID, event, rawIDs)
 			if err != nil {
 				s.ctx.LogError("Failed to serialize packet: %v", err)
 				return
 			}
 
-			_, err = s.callbackClient.OnPacketEvent(context.Background(), &PacketEventRequest{
-				CallbackId: callbackID,
-				PacketId:   event.ID,
-				PacketData: packetData,
-			})
+			callCtx, done := s.calls.start("OnPacketEvent")
+			_, err = s.callbackClient.OnPacketEvent(callCtx, req)
+			done(err)
 			if err != nil {
 				s.ctx.LogError("PacketAll handler gRPC call failed: %v", err)
 			}
 		}
 
-		if err := s.ctx.ListenPacketAllWithPriority(handler, priority); err != nil {
+		handle, err := s.ctx.ListenPacketAllWithPriority(handler, priority)
+		if err != nil {
 			return err
 		}
+		s.trackCallback(callbackID, "packet_all", handle.Unregister)
+		return nil
+	})
+
+	if err != nil {
+		return &RegisterHandlerResponse{Success: false, Error: err.Error()}, nil
+	}
+	return &RegisterHandlerResponse{Success: true, HandlerId: callbackID}, nil
+}
+
+// RegisterBytesPacketHandler 注册二进制数据包监听
+// 插件返回的拦截结果会传回主进程；回调失败时不拦截，避免插件异常阻断数据包
+func (s *ContextServer) RegisterBytesPacketHandler(ctx context.Context, req *RegisterPacketHandlerRequest) (*RegisterHandlerResponse, error) {
+	callbackID := req.CallbackId
+	priority := int(req.Priority)
+	packetIDs := req.PacketIds
 
-		s.callbacksMu.Lock()
-		s.callbacks[callbackID] = &callbackInfo{
-			callbackID:  callbackID,
-			handlerType: "packet_all",
+	err := s.deferOrExecute(func() error {
+		handler := func(packetID uint32, data []byte) bool {
+			callCtx, done := s.calls.start("OnBytesPacketEvent")
+			resp, err := s.callbackClient.OnBytesPacketEvent(callCtx, &BytesPacketEventRequest{
+				CallbackId: callbackID,
+				PacketId:   packetID,
+				Data:       data,
+			})
+			done(err)
+			if err != nil {
+				s.ctx.LogError("BytesPacket handler gRPC call failed: %v", err)
+				return false
+			}
+			return resp.Intercept
 		}
-		s.callbacksMu.Unlock()
+
+		handle, err := s.ctx.ListenBytesPacketWithPriority(handler, priority, packetIDs...)
+		if err != nil {
+			return err
+		}
+		s.trackCallback(callbackID, "bytes_packet", handle.Unregister)
 		return nil
 	})
 
@@ -404,24 +1027,21 @@ func (s *ContextServer) RegisterPreloadHandler(ctx context.Context, req *Registe
 
 	err := s.deferOrExecute(func() error {
 		handler := func() {
-			_, err := s.callbackClient.OnPreloadEvent(context.Background(), &PreloadEventRequest{
+			callCtx, done := s.calls.start("OnPreloadEvent")
+			_, err := s.callbackClient.OnPreloadEvent(callCtx, &PreloadEventRequest{
 				CallbackId: callbackID,
 			})
+			done(err)
 			if err != nil {
 				s.ctx.LogError("Preload handler gRPC call failed: %v", err)
 			}
 		}
 
-		if err := s.ctx.ListenPreloadWithPriority(handler, priority); err != nil {
+		handle, err := s.ctx.ListenPreloadWithPriority(handler, priority)
+		if err != nil {
 			return err
 		}
-
-		s.callbacksMu.Lock()
-		s.callbacks[callbackID] = &callbackInfo{
-			callbackID:  callbackID,
-			handlerType: "preload",
-		}
-		s.callbacksMu.Unlock()
+		s.trackCallback(callbackID, "preload", handle.Unregister)
 		return nil
 	})
 
@@ -437,24 +1057,21 @@ func (s *ContextServer) RegisterActiveHandler(ctx context.Context, req *Register
 
 	err := s.deferOrExecute(func() error {
 		handler := func() {
-			_, err := s.callbackClient.OnActiveEvent(context.Background(), &ActiveEventRequest{
+			callCtx, done := s.calls.start("OnActiveEvent")
+			_, err := s.callbackClient.OnActiveEvent(callCtx, &ActiveEventRequest{
 				CallbackId: callbackID,
 			})
+			done(err)
 			if err != nil {
 				s.ctx.LogError("Active handler gRPC call failed: %v", err)
 			}
 		}
 
-		if err := s.ctx.ListenActiveWithPriority(handler, priority); err != nil {
+		handle, err := s.ctx.ListenActiveWithPriority(handler, priority)
+		if err != nil {
 			return err
 		}
-
-		s.callbacksMu.Lock()
-		s.callbacks[callbackID] = &callbackInfo{
-			callbackID:  callbackID,
-			handlerType: "active",
-		}
-		s.callbacksMu.Unlock()
+		s.trackCallback(callbackID, "active", handle.Unregister)
 		return nil
 	})
 
@@ -470,24 +1087,21 @@ func (s *ContextServer) RegisterFrameExitHandler(ctx context.Context, req *Regis
 
 	err := s.deferOrExecute(func() error {
 		handler := func(event FrameExitEvent) {
-			_, err := s.callbackClient.OnFrameExitEvent(context.Background(), &FrameExitEventRequest{
+			callCtx, done := s.calls.start("OnFrameExitEvent")
+			_, err := s.callbackClient.OnFrameExitEvent(callCtx, &FrameExitEventRequest{
 				CallbackId: callbackID,
 			})
+			done(err)
 			if err != nil {
 				s.ctx.LogError("FrameExit handler gRPC call failed: %v", err)
 			}
 		}
 
-		if err := s.ctx.ListenFrameExitWithPriority(handler, priority); err != nil {
+		handle, err := s.ctx.ListenFrameExitWithPriority(handler, priority)
+		if err != nil {
 			return err
 		}
-
-		s.callbacksMu.Lock()
-		s.callbacks[callbackID] = &callbackInfo{
-			callbackID:  callbackID,
-			handlerType: "frame_exit",
-		}
-		s.callbacksMu.Unlock()
+		s.trackCallback(callbackID, "frame_exit", handle.Unregister)
 		return nil
 	})
 
@@ -510,11 +1124,13 @@ func (s *ContextServer) RegisterBroadcastHandler(ctx context.Context, req *Regis
 				return nil
 			}
 
-			resp, err := s.callbackClient.OnBroadcastEvent(context.Background(), &BroadcastEventRequest{
+			callCtx, done := s.calls.start("OnBroadcastEvent")
+			resp, err := s.callbackClient.OnBroadcastEvent(callCtx, &BroadcastEventRequest{
 				CallbackId: callbackID,
 				Name:       broadcast.Name,
 				Data:       dataBytes,
 			})
+			done(err)
 			if err != nil {
 				s.ctx.LogError("Broadcast handler gRPC call failed: %v", err)
 				return nil
@@ -528,16 +1144,11 @@ func (s *ContextServer) RegisterBroadcastHandler(ctx context.Context, req *Regis
 			return result
 		}
 
-		if err := s.ctx.ListenBroadcastWithPriority(eventName, handler, priority); err != nil {
+		handle, err := s.ctx.ListenBroadcastWithPriority(eventName, handler, priority)
+		if err != nil {
 			return err
 		}
-
-		s.callbacksMu.Lock()
-		s.callbacks[callbackID] = &callbackInfo{
-			callbackID:  callbackID,
-			handlerType: "broadcast",
-		}
-		s.callbacksMu.Unlock()
+		s.trackCallback(callbackID, "broadcast", handle.Unregister)
 		return nil
 	})
 
@@ -555,7 +1166,7 @@ func (s *ContextServer) CancelMessage(ctx context.Context, req *CancelMessageReq
 
 func (s *ContextServer) WaitMessage(ctx context.Context, req *WaitMessageRequest) (*WaitMessageResponse, error) {
 	timeout := time.Duration(req.TimeoutMs) * time.Millisecond
-	message, err := s.ctx.WaitMessage(req.PlayerName, timeout)
+	message, err := s.ctx.WaitMessageContext(ctx, req.PlayerName, timeout)
 	if err != nil {
 		return &WaitMessageResponse{
 			Success: false,
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.1
+// 	protoc-gen-go v1.36.11
 // 	protoc        v5.28.3
 // source: context_service.proto
 
@@ -11,6 +11,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -21,18 +22,16 @@ const (
 )
 
 type Empty struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Empty) Reset() {
 	*x = Empty{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Empty) String() string {
@@ -43,7 +42,7 @@ func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
 	mi := &file_context_service_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -59,20 +58,17 @@ func (*Empty) Descriptor() ([]byte, []int) {
 }
 
 type StringResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StringResponse) Reset() {
 	*x = StringResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *StringResponse) String() string {
@@ -83,7 +79,7 @@ func (*StringResponse) ProtoMessage() {}
 
 func (x *StringResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_context_service_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -106,21 +102,18 @@ func (x *StringResponse) GetValue() string {
 }
 
 type BoolResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BoolResponse) Reset() {
 	*x = BoolResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *BoolResponse) String() string {
@@ -131,7 +124,7 @@ func (*BoolResponse) ProtoMessage() {}
 
 func (x *BoolResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_context_service_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -161,20 +154,17 @@ func (x *BoolResponse) GetError() string {
 }
 
 type LogRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LogRequest) Reset() {
 	*x = LogRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *LogRequest) String() string {
@@ -185,7 +175,7 @@ func (*LogRequest) ProtoMessage() {}
 
 func (x *LogRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_context_service_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -208,20 +198,17 @@ func (x *LogRequest) GetMessage() string {
 }
 
 type LogResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LogResponse) Reset() {
 	*x = LogResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *LogResponse) String() string {
@@ -232,7 +219,7 @@ func (*LogResponse) ProtoMessage() {}
 
 func (x *LogResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_context_service_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -254,22 +241,25 @@ func (x *LogResponse) GetSuccess() bool {
 	return false
 }
 
+// 信息查询响应
+// schema_version 为 0 表示主进程使用旧版结构，只能读取标注为旧版的字段
 type BotInfoResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	BotName string `protobuf:"bytes,1,opt,name=bot_name,json=botName,proto3" json:"bot_name,omitempty"`
-	BotUuid string `protobuf:"bytes,2,opt,name=bot_uuid,json=botUuid,proto3" json:"bot_uuid,omitempty"`
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BotName         string                 `protobuf:"bytes,1,opt,name=bot_name,json=botName,proto3" json:"bot_name,omitempty"`
+	BotUuid         string                 `protobuf:"bytes,2,opt,name=bot_uuid,json=botUuid,proto3" json:"bot_uuid,omitempty"` // 旧版：实际存放 XUID，新版本请读取 xuid
+	Xuid            string                 `protobuf:"bytes,3,opt,name=xuid,proto3" json:"xuid,omitempty"`
+	EntityUniqueId  int64                  `protobuf:"varint,4,opt,name=entity_unique_id,json=entityUniqueId,proto3" json:"entity_unique_id,omitempty"`
+	EntityRuntimeId uint64                 `protobuf:"varint,5,opt,name=entity_runtime_id,json=entityRuntimeId,proto3" json:"entity_runtime_id,omitempty"`
+	SchemaVersion   uint32                 `protobuf:"varint,6,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *BotInfoResponse) Reset() {
 	*x = BotInfoResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *BotInfoResponse) String() string {
@@ -280,7 +270,7 @@ func (*BotInfoResponse) ProtoMessage() {}
 
 func (x *BotInfoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_context_service_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -309,23 +299,50 @@ func (x *BotInfoResponse) GetBotUuid() string {
 	return ""
 }
 
-type ServerInfoResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *BotInfoResponse) GetXuid() string {
+	if x != nil {
+		return x.Xuid
+	}
+	return ""
+}
 
-	ServerCode     string `protobuf:"bytes,1,opt,name=server_code,json=serverCode,proto3" json:"server_code,omitempty"`
-	ServerPassword string `protobuf:"bytes,2,opt,name=server_password,json=serverPassword,proto3" json:"server_password,omitempty"`
-	ServerAddress  string `protobuf:"bytes,3,opt,name=server_address,json=serverAddress,proto3" json:"server_address,omitempty"`
+func (x *BotInfoResponse) GetEntityUniqueId() int64 {
+	if x != nil {
+		return x.EntityUniqueId
+	}
+	return 0
+}
+
+func (x *BotInfoResponse) GetEntityRuntimeId() uint64 {
+	if x != nil {
+		return x.EntityRuntimeId
+	}
+	return 0
+}
+
+func (x *BotInfoResponse) GetSchemaVersion() uint32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+type ServerInfoResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ServerCode     string                 `protobuf:"bytes,1,opt,name=server_code,json=serverCode,proto3" json:"server_code,omitempty"`
+	ServerPassword string                 `protobuf:"bytes,2,opt,name=server_password,json=serverPassword,proto3" json:"server_password,omitempty"` // 旧版：以字符串 "true"/"false" 表示是否设置了密码
+	ServerAddress  string                 `protobuf:"bytes,3,opt,name=server_address,json=serverAddress,proto3" json:"server_address,omitempty"`    // 旧版：未使用
+	PasscodeSet    bool                   `protobuf:"varint,4,opt,name=passcode_set,json=passcodeSet,proto3" json:"passcode_set,omitempty"`
+	SchemaVersion  uint32                 `protobuf:"varint,5,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ServerInfoResponse) Reset() {
 	*x = ServerInfoResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ServerInfoResponse) String() string {
@@ -336,7 +353,7 @@ func (*ServerInfoResponse) ProtoMessage() {}
 
 func (x *ServerInfoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_context_service_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -372,23 +389,39 @@ func (x *ServerInfoResponse) GetServerAddress() string {
 	return ""
 }
 
-type QQInfoResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ServerInfoResponse) GetPasscodeSet() bool {
+	if x != nil {
+		return x.PasscodeSet
+	}
+	return false
+}
+
+func (x *ServerInfoResponse) GetSchemaVersion() uint32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
 
-	BotQq   uint64   `protobuf:"varint,1,opt,name=bot_qq,json=botQq,proto3" json:"bot_qq,omitempty"`
-	BotNick string   `protobuf:"bytes,2,opt,name=bot_nick,json=botNick,proto3" json:"bot_nick,omitempty"`
-	AdminQq []uint64 `protobuf:"varint,3,rep,packed,name=admin_qq,json=adminQq,proto3" json:"admin_qq,omitempty"`
+type QQInfoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 旧版字段与 sdk.QQInfo 不对应，始终为空，仅保留字段号
+	BotQq          uint64   `protobuf:"varint,1,opt,name=bot_qq,json=botQq,proto3" json:"bot_qq,omitempty"`
+	BotNick        string   `protobuf:"bytes,2,opt,name=bot_nick,json=botNick,proto3" json:"bot_nick,omitempty"`
+	AdminQq        []uint64 `protobuf:"varint,3,rep,packed,name=admin_qq,json=adminQq,proto3" json:"admin_qq,omitempty"`
+	Adapter        string   `protobuf:"bytes,4,opt,name=adapter,proto3" json:"adapter,omitempty"`
+	WsUrl          string   `protobuf:"bytes,5,opt,name=ws_url,json=wsUrl,proto3" json:"ws_url,omitempty"`
+	HasAccessToken bool     `protobuf:"varint,6,opt,name=has_access_token,json=hasAccessToken,proto3" json:"has_access_token,omitempty"`
+	SchemaVersion  uint32   `protobuf:"varint,7,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *QQInfoResponse) Reset() {
 	*x = QQInfoResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *QQInfoResponse) String() string {
@@ -399,7 +432,7 @@ func (*QQInfoResponse) ProtoMessage() {}
 
 func (x *QQInfoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_context_service_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -435,21 +468,47 @@ func (x *QQInfoResponse) GetAdminQq() []uint64 {
 	return nil
 }
 
+func (x *QQInfoResponse) GetAdapter() string {
+	if x != nil {
+		return x.Adapter
+	}
+	return ""
+}
+
+func (x *QQInfoResponse) GetWsUrl() string {
+	if x != nil {
+		return x.WsUrl
+	}
+	return ""
+}
+
+func (x *QQInfoResponse) GetHasAccessToken() bool {
+	if x != nil {
+		return x.HasAccessToken
+	}
+	return false
+}
+
+func (x *QQInfoResponse) GetSchemaVersion() uint32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
 type InterworkInfoResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkedGroups  map[string]int64       `protobuf:"bytes,1,rep,name=linked_groups,json=linkedGroups,proto3" json:"linked_groups,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	SchemaVersion uint32                 `protobuf:"varint,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	LinkedGroups map[string]int64 `protobuf:"bytes,1,rep,name=linked_groups,json=linkedGroups,proto3" json:"linked_groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InterworkInfoResponse) Reset() {
 	*x = InterworkInfoResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *InterworkInfoResponse) String() string {
@@ -460,7 +519,7 @@ func (*InterworkInfoResponse) ProtoMessage() {}
 
 func (x *InterworkInfoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_context_service_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -482,21 +541,25 @@ func (x *InterworkInfoResponse) GetLinkedGroups() map[string]int64 {
 	return nil
 }
 
+func (x *InterworkInfoResponse) GetSchemaVersion() uint32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
 type FormatDataPathRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PathParts     []string               `protobuf:"bytes,1,rep,name=path_parts,json=pathParts,proto3" json:"path_parts,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	PathParts []string `protobuf:"bytes,1,rep,name=path_parts,json=pathParts,proto3" json:"path_parts,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FormatDataPathRequest) Reset() {
 	*x = FormatDataPathRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *FormatDataPathRequest) String() string {
@@ -507,7 +570,7 @@ func (*FormatDataPathRequest) ProtoMessage() {}
 
 func (x *FormatDataPathRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_context_service_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -530,23 +593,20 @@ func (x *FormatDataPathRequest) GetPathParts() []string {
 }
 
 type RegisterConsoleCommandRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Triggers      []string               `protobuf:"bytes,2,rep,name=triggers,proto3" json:"triggers,omitempty"`
+	Usage         string                 `protobuf:"bytes,3,opt,name=usage,proto3" json:"usage,omitempty"`
+	CallbackId    uint32                 `protobuf:"varint,4,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"` // 插件提供的回调 ID
 	unknownFields protoimpl.UnknownFields
-
-	Name       string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Triggers   []string `protobuf:"bytes,2,rep,name=triggers,proto3" json:"triggers,omitempty"`
-	Usage      string   `protobuf:"bytes,3,opt,name=usage,proto3" json:"usage,omitempty"`
-	CallbackId uint32   `protobuf:"varint,4,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"` // 插件提供的回调 ID
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RegisterConsoleCommandRequest) Reset() {
 	*x = RegisterConsoleCommandRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RegisterConsoleCommandRequest) String() string {
@@ -557,7 +617,7 @@ func (*RegisterConsoleCommandRequest) ProtoMessage() {}
 
 func (x *RegisterConsoleCommandRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_context_service_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -601,21 +661,19 @@ func (x *RegisterConsoleCommandRequest) GetCallbackId() uint32 {
 }
 
 type RegisterHandlerRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallbackId    uint32                 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	Priority      int32                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	RawPacketIds  []uint32               `protobuf:"varint,3,rep,packed,name=raw_packet_ids,json=rawPacketIds,proto3" json:"raw_packet_ids,omitempty"` // 仅 RegisterPacketAllHandler 使用，见 RegisterPacketHandlerRequest
 	unknownFields protoimpl.UnknownFields
-
-	CallbackId uint32 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
-	Priority   int32  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RegisterHandlerRequest) Reset() {
 	*x = RegisterHandlerRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RegisterHandlerRequest) String() string {
@@ -626,7 +684,7 @@ func (*RegisterHandlerRequest) ProtoMessage() {}
 
 func (x *RegisterHandlerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_context_service_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -655,23 +713,29 @@ func (x *RegisterHandlerRequest) GetPriority() int32 {
 	return 0
 }
 
+func (x *RegisterHandlerRequest) GetRawPacketIds() []uint32 {
+	if x != nil {
+		return x.RawPacketIds
+	}
+	return nil
+}
+
 type RegisterPacketHandlerRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CallbackId uint32                 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	PacketIds  []uint32               `protobuf:"varint,2,rep,packed,name=packet_ids,json=packetIds,proto3" json:"packet_ids,omitempty"`
+	Priority   int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	// 插件希望以原始协议字节接收的数据包 ID，其余数据包（或主进程无法提供原始字节时）使用 JSON
+	RawPacketIds  []uint32 `protobuf:"varint,4,rep,packed,name=raw_packet_ids,json=rawPacketIds,proto3" json:"raw_packet_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	CallbackId uint32   `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
-	PacketIds  []uint32 `protobuf:"varint,2,rep,packed,name=packet_ids,json=packetIds,proto3" json:"packet_ids,omitempty"`
-	Priority   int32    `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RegisterPacketHandlerRequest) Reset() {
 	*x = RegisterPacketHandlerRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RegisterPacketHandlerRequest) String() string {
@@ -682,7 +746,7 @@ func (*RegisterPacketHandlerRequest) ProtoMessage() {}
 
 func (x *RegisterPacketHandlerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_context_service_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -718,23 +782,27 @@ func (x *RegisterPacketHandlerRequest) GetPriority() int32 {
 	return 0
 }
 
+func (x *RegisterPacketHandlerRequest) GetRawPacketIds() []uint32 {
+	if x != nil {
+		return x.RawPacketIds
+	}
+	return nil
+}
+
 type RegisterBroadcastHandlerRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventName     string                 `protobuf:"bytes,1,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	CallbackId    uint32                 `protobuf:"varint,2,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	Priority      int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	EventName  string `protobuf:"bytes,1,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
-	CallbackId uint32 `protobuf:"varint,2,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
-	Priority   int32  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RegisterBroadcastHandlerRequest) Reset() {
 	*x = RegisterBroadcastHandlerRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RegisterBroadcastHandlerRequest) String() string {
@@ -745,7 +813,7 @@ func (*RegisterBroadcastHandlerRequest) ProtoMessage() {}
 
 func (x *RegisterBroadcastHandlerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_context_service_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -782,22 +850,19 @@ func (x *RegisterBroadcastHandlerRequest) GetPriority() int32 {
 }
 
 type RegisterHandlerResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	HandlerId     uint32                 `protobuf:"varint,3,opt,name=handler_id,json=handlerId,proto3" json:"handler_id,omitempty"` // 主进程分配的 handler ID
 	unknownFields protoimpl.UnknownFields
-
-	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error     string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	HandlerId uint32 `protobuf:"varint,3,opt,name=handler_id,json=handlerId,proto3" json:"handler_id,omitempty"` // 主进程分配的 handler ID
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RegisterHandlerResponse) Reset() {
 	*x = RegisterHandlerResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RegisterHandlerResponse) String() string {
@@ -808,7 +873,7 @@ func (*RegisterHandlerResponse) ProtoMessage() {}
 
 func (x *RegisterHandlerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_context_service_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -844,22 +909,63 @@ func (x *RegisterHandlerResponse) GetHandlerId() uint32 {
 	return 0
 }
 
-type CancelMessageRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+type UnregisterHandlerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HandlerId     uint32                 `protobuf:"varint,1,opt,name=handler_id,json=handlerId,proto3" json:"handler_id,omitempty"` // Register*Handler 返回的 handler_id
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+func (x *UnregisterHandlerRequest) Reset() {
+	*x = UnregisterHandlerRequest{}
+	mi := &file_context_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelMessageRequest) Reset() {
-	*x = CancelMessageRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[15]
+func (x *UnregisterHandlerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterHandlerRequest) ProtoMessage() {}
+
+func (x *UnregisterHandlerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[15]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterHandlerRequest.ProtoReflect.Descriptor instead.
+func (*UnregisterHandlerRequest) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UnregisterHandlerRequest) GetHandlerId() uint32 {
+	if x != nil {
+		return x.HandlerId
 	}
+	return 0
+}
+
+type CancelMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sender        string                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelMessageRequest) Reset() {
+	*x = CancelMessageRequest{}
+	mi := &file_context_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CancelMessageRequest) String() string {
@@ -869,8 +975,8 @@ func (x *CancelMessageRequest) String() string {
 func (*CancelMessageRequest) ProtoMessage() {}
 
 func (x *CancelMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_context_service_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_context_service_proto_msgTypes[16]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -882,7 +988,7 @@ func (x *CancelMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelMessageRequest.ProtoReflect.Descriptor instead.
 func (*CancelMessageRequest) Descriptor() ([]byte, []int) {
-	return file_context_service_proto_rawDescGZIP(), []int{15}
+	return file_context_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CancelMessageRequest) GetSender() string {
@@ -900,21 +1006,18 @@ func (x *CancelMessageRequest) GetMessage() string {
 }
 
 type WaitMessageRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerName    string                 `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	TimeoutMs     int64                  `protobuf:"varint,2,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	PlayerName string `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
-	TimeoutMs  int64  `protobuf:"varint,2,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WaitMessageRequest) Reset() {
 	*x = WaitMessageRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *WaitMessageRequest) String() string {
@@ -924,8 +1027,8 @@ func (x *WaitMessageRequest) String() string {
 func (*WaitMessageRequest) ProtoMessage() {}
 
 func (x *WaitMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_context_service_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_context_service_proto_msgTypes[17]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -937,7 +1040,7 @@ func (x *WaitMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WaitMessageRequest.ProtoReflect.Descriptor instead.
 func (*WaitMessageRequest) Descriptor() ([]byte, []int) {
-	return file_context_service_proto_rawDescGZIP(), []int{16}
+	return file_context_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *WaitMessageRequest) GetPlayerName() string {
@@ -955,22 +1058,19 @@ func (x *WaitMessageRequest) GetTimeoutMs() int64 {
 }
 
 type WaitMessageResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Error   string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WaitMessageResponse) Reset() {
 	*x = WaitMessageResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *WaitMessageResponse) String() string {
@@ -980,8 +1080,8 @@ func (x *WaitMessageResponse) String() string {
 func (*WaitMessageResponse) ProtoMessage() {}
 
 func (x *WaitMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_context_service_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_context_service_proto_msgTypes[18]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -993,7 +1093,7 @@ func (x *WaitMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WaitMessageResponse.ProtoReflect.Descriptor instead.
 func (*WaitMessageResponse) Descriptor() ([]byte, []int) {
-	return file_context_service_proto_rawDescGZIP(), []int{17}
+	return file_context_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *WaitMessageResponse) GetSuccess() bool {
@@ -1018,21 +1118,18 @@ func (x *WaitMessageResponse) GetError() string {
 }
 
 type TriggerBroadcastRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"` // JSON-encoded map[string]interface{}
 	unknownFields protoimpl.UnknownFields
-
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"` // JSON-encoded map[string]interface{}
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TriggerBroadcastRequest) Reset() {
 	*x = TriggerBroadcastRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *TriggerBroadcastRequest) String() string {
@@ -1042,8 +1139,8 @@ func (x *TriggerBroadcastRequest) String() string {
 func (*TriggerBroadcastRequest) ProtoMessage() {}
 
 func (x *TriggerBroadcastRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_context_service_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_context_service_proto_msgTypes[19]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1055,7 +1152,7 @@ func (x *TriggerBroadcastRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerBroadcastRequest.ProtoReflect.Descriptor instead.
 func (*TriggerBroadcastRequest) Descriptor() ([]byte, []int) {
-	return file_context_service_proto_rawDescGZIP(), []int{18}
+	return file_context_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TriggerBroadcastRequest) GetName() string {
@@ -1073,20 +1170,17 @@ func (x *TriggerBroadcastRequest) GetData() []byte {
 }
 
 type TriggerBroadcastResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []byte                 `protobuf:"bytes,1,opt,name=results,proto3" json:"results,omitempty"` // JSON-encoded []interface{}
 	unknownFields protoimpl.UnknownFields
-
-	Results []byte `protobuf:"bytes,1,opt,name=results,proto3" json:"results,omitempty"` // JSON-encoded []interface{}
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TriggerBroadcastResponse) Reset() {
 	*x = TriggerBroadcastResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_context_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *TriggerBroadcastResponse) String() string {
@@ -1096,8 +1190,8 @@ func (x *TriggerBroadcastResponse) String() string {
 func (*TriggerBroadcastResponse) ProtoMessage() {}
 
 func (x *TriggerBroadcastResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_context_service_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_context_service_proto_msgTypes[20]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1109,7 +1203,7 @@ func (x *TriggerBroadcastResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerBroadcastResponse.ProtoReflect.Descriptor instead.
 func (*TriggerBroadcastResponse) Descriptor() ([]byte, []int) {
-	return file_context_service_proto_rawDescGZIP(), []int{19}
+	return file_context_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TriggerBroadcastResponse) GetResults() []byte {
@@ -1120,32 +1214,1642 @@ func (x *TriggerBroadcastResponse) GetResults() []byte {
 }
 
 type SayToRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Player        string                 `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SayToRequest) Reset() {
+	*x = SayToRequest{}
+	mi := &file_context_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SayToRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SayToRequest) ProtoMessage() {}
+
+func (x *SayToRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SayToRequest.ProtoReflect.Descriptor instead.
+func (*SayToRequest) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SayToRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *SayToRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SendCommandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Command       string                 `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendCommandRequest) Reset() {
+	*x = SendCommandRequest{}
+	mi := &file_context_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCommandRequest) ProtoMessage() {}
+
+func (x *SendCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCommandRequest.ProtoReflect.Descriptor instead.
+func (*SendCommandRequest) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SendCommandRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+type SendChatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendChatRequest) Reset() {
+	*x = SendChatRequest{}
+	mi := &file_context_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatRequest) ProtoMessage() {}
+
+func (x *SendChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatRequest.ProtoReflect.Descriptor instead.
+func (*SendChatRequest) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SendChatRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SendCommandWithResponseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Command       string                 `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	Timeout       float64                `protobuf:"fixed64,2,opt,name=timeout,proto3" json:"timeout,omitempty"` // 秒，<= 0 时使用默认值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendCommandWithResponseRequest) Reset() {
+	*x = SendCommandWithResponseRequest{}
+	mi := &file_context_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCommandWithResponseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCommandWithResponseRequest) ProtoMessage() {}
+
+func (x *SendCommandWithResponseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCommandWithResponseRequest.ProtoReflect.Descriptor instead.
+func (*SendCommandWithResponseRequest) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SendCommandWithResponseRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *SendCommandWithResponseRequest) GetTimeout() float64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type SendCommandWithResponseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	TimedOut      bool                   `protobuf:"varint,3,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	Output        []byte                 `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"` // JSON-encoded CommandResult (CommandOrigin, SuccessCount, OutputMessages, DataSet)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendCommandWithResponseResponse) Reset() {
+	*x = SendCommandWithResponseResponse{}
+	mi := &file_context_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCommandWithResponseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCommandWithResponseResponse) ProtoMessage() {}
+
+func (x *SendCommandWithResponseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCommandWithResponseResponse.ProtoReflect.Descriptor instead.
+func (*SendCommandWithResponseResponse) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SendCommandWithResponseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SendCommandWithResponseResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SendCommandWithResponseResponse) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+func (x *SendCommandWithResponseResponse) GetOutput() []byte {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+type SendCommandBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Commands      []string               `protobuf:"bytes,1,rep,name=commands,proto3" json:"commands,omitempty"`
+	Timeout       float64                `protobuf:"fixed64,2,opt,name=timeout,proto3" json:"timeout,omitempty"` // 每条命令的超时（秒），<= 0 时使用默认值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendCommandBatchRequest) Reset() {
+	*x = SendCommandBatchRequest{}
+	mi := &file_context_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCommandBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCommandBatchRequest) ProtoMessage() {}
+
+func (x *SendCommandBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCommandBatchRequest.ProtoReflect.Descriptor instead.
+func (*SendCommandBatchRequest) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SendCommandBatchRequest) GetCommands() []string {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *SendCommandBatchRequest) GetTimeout() float64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type SendCommandBatchResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Success       bool                               `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                             `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Results       []*SendCommandWithResponseResponse `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"` // 与 commands 顺序一致
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendCommandBatchResponse) Reset() {
+	*x = SendCommandBatchResponse{}
+	mi := &file_context_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCommandBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCommandBatchResponse) ProtoMessage() {}
+
+func (x *SendCommandBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCommandBatchResponse.ProtoReflect.Descriptor instead.
+func (*SendCommandBatchResponse) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SendCommandBatchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SendCommandBatchResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SendCommandBatchResponse) GetResults() []*SendCommandWithResponseResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetScoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scoreboard    string                 `protobuf:"bytes,1,opt,name=scoreboard,proto3" json:"scoreboard,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Timeout       float64                `protobuf:"fixed64,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScoreRequest) Reset() {
+	*x = GetScoreRequest{}
+	mi := &file_context_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScoreRequest) ProtoMessage() {}
+
+func (x *GetScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScoreRequest.ProtoReflect.Descriptor instead.
+func (*GetScoreRequest) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetScoreRequest) GetScoreboard() string {
+	if x != nil {
+		return x.Scoreboard
+	}
+	return ""
+}
+
+func (x *GetScoreRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *GetScoreRequest) GetTimeout() float64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type GetScoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Score         int64                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScoreResponse) Reset() {
+	*x = GetScoreResponse{}
+	mi := &file_context_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScoreResponse) ProtoMessage() {}
+
+func (x *GetScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScoreResponse.ProtoReflect.Descriptor instead.
+func (*GetScoreResponse) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetScoreResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetScoreResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetScoreResponse) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetPosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPosRequest) Reset() {
+	*x = GetPosRequest{}
+	mi := &file_context_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPosRequest) ProtoMessage() {}
+
+func (x *GetPosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPosRequest.ProtoReflect.Descriptor instead.
+func (*GetPosRequest) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetPosRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type GetPosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	X             float32                `protobuf:"fixed32,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float32                `protobuf:"fixed32,4,opt,name=y,proto3" json:"y,omitempty"`
+	Z             float32                `protobuf:"fixed32,5,opt,name=z,proto3" json:"z,omitempty"`
+	Dimension     uint32                 `protobuf:"varint,6,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	YRot          float32                `protobuf:"fixed32,7,opt,name=y_rot,json=yRot,proto3" json:"y_rot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPosResponse) Reset() {
+	*x = GetPosResponse{}
+	mi := &file_context_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPosResponse) ProtoMessage() {}
+
+func (x *GetPosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPosResponse.ProtoReflect.Descriptor instead.
+func (*GetPosResponse) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetPosResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetPosResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetPosResponse) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *GetPosResponse) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *GetPosResponse) GetZ() float32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *GetPosResponse) GetDimension() uint32 {
+	if x != nil {
+		return x.Dimension
+	}
+	return 0
+}
+
+func (x *GetPosResponse) GetYRot() float32 {
+	if x != nil {
+		return x.YRot
+	}
+	return 0
+}
+
+type GetTargetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Timeout       float64                `protobuf:"fixed64,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTargetRequest) Reset() {
+	*x = GetTargetRequest{}
+	mi := &file_context_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTargetRequest) ProtoMessage() {}
+
+func (x *GetTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTargetRequest.ProtoReflect.Descriptor instead.
+func (*GetTargetRequest) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetTargetRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *GetTargetRequest) GetTimeout() float64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type GetTargetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Names         []string               `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTargetResponse) Reset() {
+	*x = GetTargetResponse{}
+	mi := &file_context_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTargetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTargetResponse) ProtoMessage() {}
+
+func (x *GetTargetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTargetResponse.ProtoReflect.Descriptor instead.
+func (*GetTargetResponse) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetTargetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetTargetResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetTargetResponse) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type GetItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	ItemName      string                 `protobuf:"bytes,2,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	ItemSpecialId int32                  `protobuf:"varint,3,opt,name=item_special_id,json=itemSpecialId,proto3" json:"item_special_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemRequest) Reset() {
+	*x = GetItemRequest{}
+	mi := &file_context_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemRequest) ProtoMessage() {}
+
+func (x *GetItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemRequest.ProtoReflect.Descriptor instead.
+func (*GetItemRequest) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetItemRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *GetItemRequest) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *GetItemRequest) GetItemSpecialId() int32 {
+	if x != nil {
+		return x.ItemSpecialId
+	}
+	return 0
+}
+
+type GetItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemResponse) Reset() {
+	*x = GetItemResponse{}
+	mi := &file_context_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemResponse) ProtoMessage() {}
+
+func (x *GetItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemResponse.ProtoReflect.Descriptor instead.
+func (*GetItemResponse) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetItemResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetItemResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetItemResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type IsOpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerName    string                 `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsOpRequest) Reset() {
+	*x = IsOpRequest{}
+	mi := &file_context_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsOpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsOpRequest) ProtoMessage() {}
+
+func (x *IsOpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsOpRequest.ProtoReflect.Descriptor instead.
+func (*IsOpRequest) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *IsOpRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+type IsOpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	IsOp          bool                   `protobuf:"varint,3,opt,name=is_op,json=isOp,proto3" json:"is_op,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsOpResponse) Reset() {
+	*x = IsOpResponse{}
+	mi := &file_context_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsOpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsOpResponse) ProtoMessage() {}
+
+func (x *IsOpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsOpResponse.ProtoReflect.Descriptor instead.
+func (*IsOpResponse) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *IsOpResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *IsOpResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *IsOpResponse) GetIsOp() bool {
+	if x != nil {
+		return x.IsOp
+	}
+	return false
+}
+
+type TellrawRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Selector      string                 `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TellrawRequest) Reset() {
+	*x = TellrawRequest{}
+	mi := &file_context_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TellrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TellrawRequest) ProtoMessage() {}
+
+func (x *TellrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TellrawRequest.ProtoReflect.Descriptor instead.
+func (*TellrawRequest) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TellrawRequest) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *TellrawRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SetEffectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	EffectId      int32                  `protobuf:"varint,2,opt,name=effect_id,json=effectId,proto3" json:"effect_id,omitempty"`
+	Duration      int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Level         int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	HideParticles bool                   `protobuf:"varint,5,opt,name=hide_particles,json=hideParticles,proto3" json:"hide_particles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEffectRequest) Reset() {
+	*x = SetEffectRequest{}
+	mi := &file_context_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEffectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEffectRequest) ProtoMessage() {}
+
+func (x *SetEffectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEffectRequest.ProtoReflect.Descriptor instead.
+func (*SetEffectRequest) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetEffectRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *SetEffectRequest) GetEffectId() int32 {
+	if x != nil {
+		return x.EffectId
+	}
+	return 0
+}
+
+func (x *SetEffectRequest) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *SetEffectRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *SetEffectRequest) GetHideParticles() bool {
+	if x != nil {
+		return x.HideParticles
+	}
+	return false
+}
+
+type SendPacketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PacketId      uint32                 `protobuf:"varint,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id,omitempty"`
+	PacketData    []byte                 `protobuf:"bytes,2,opt,name=packet_data,json=packetData,proto3" json:"packet_data,omitempty"` // JSON-encoded packet
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendPacketRequest) Reset() {
+	*x = SendPacketRequest{}
+	mi := &file_context_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendPacketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPacketRequest) ProtoMessage() {}
+
+func (x *SendPacketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPacketRequest.ProtoReflect.Descriptor instead.
+func (*SendPacketRequest) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SendPacketRequest) GetPacketId() uint32 {
+	if x != nil {
+		return x.PacketId
+	}
+	return 0
+}
+
+func (x *SendPacketRequest) GetPacketData() []byte {
+	if x != nil {
+		return x.PacketData
+	}
+	return nil
+}
+
+type PlayerInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uuid            string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Xuid            string                 `protobuf:"bytes,3,opt,name=xuid,proto3" json:"xuid,omitempty"`
+	EntityUniqueId  int64                  `protobuf:"varint,4,opt,name=entity_unique_id,json=entityUniqueId,proto3" json:"entity_unique_id,omitempty"`
+	EntityRuntimeId uint64                 `protobuf:"varint,5,opt,name=entity_runtime_id,json=entityRuntimeId,proto3" json:"entity_runtime_id,omitempty"`
+	Online          bool                   `protobuf:"varint,6,opt,name=online,proto3" json:"online,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PlayerInfo) Reset() {
+	*x = PlayerInfo{}
+	mi := &file_context_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerInfo) ProtoMessage() {}
+
+func (x *PlayerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerInfo.ProtoReflect.Descriptor instead.
+func (*PlayerInfo) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PlayerInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerInfo) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *PlayerInfo) GetXuid() string {
+	if x != nil {
+		return x.Xuid
+	}
+	return ""
+}
+
+func (x *PlayerInfo) GetEntityUniqueId() int64 {
+	if x != nil {
+		return x.EntityUniqueId
+	}
+	return 0
+}
+
+func (x *PlayerInfo) GetEntityRuntimeId() uint64 {
+	if x != nil {
+		return x.EntityRuntimeId
+	}
+	return 0
+}
+
+func (x *PlayerInfo) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+type ListPlayersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Players       []*PlayerInfo          `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	Bot           *PlayerInfo            `protobuf:"bytes,4,opt,name=bot,proto3" json:"bot,omitempty"` // 未设置机器人信息时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlayersResponse) Reset() {
+	*x = ListPlayersResponse{}
+	mi := &file_context_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlayersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayersResponse) ProtoMessage() {}
+
+func (x *ListPlayersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayersResponse.ProtoReflect.Descriptor instead.
+func (*ListPlayersResponse) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListPlayersResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListPlayersResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ListPlayersResponse) GetPlayers() []*PlayerInfo {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *ListPlayersResponse) GetBot() *PlayerInfo {
+	if x != nil {
+		return x.Bot
+	}
+	return nil
+}
+
+// 按 name、uuid、entity_unique_id 的顺序取第一个非空条件查询
+type GetPlayerRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uuid           string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	EntityUniqueId int64                  `protobuf:"varint,3,opt,name=entity_unique_id,json=entityUniqueId,proto3" json:"entity_unique_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetPlayerRequest) Reset() {
+	*x = GetPlayerRequest{}
+	mi := &file_context_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerRequest) ProtoMessage() {}
+
+func (x *GetPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetPlayerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetPlayerRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GetPlayerRequest) GetEntityUniqueId() int64 {
+	if x != nil {
+		return x.EntityUniqueId
+	}
+	return 0
+}
+
+type GetPlayerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Found         bool                   `protobuf:"varint,3,opt,name=found,proto3" json:"found,omitempty"`
+	Player        *PlayerInfo            `protobuf:"bytes,4,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerResponse) Reset() {
+	*x = GetPlayerResponse{}
+	mi := &file_context_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerResponse) ProtoMessage() {}
+
+func (x *GetPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetPlayerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetPlayerResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetPlayerResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *GetPlayerResponse) GetPlayer() *PlayerInfo {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+type PluginAPIVersionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Major         int32                  `protobuf:"varint,1,opt,name=major,proto3" json:"major,omitempty"`
+	Minor         int32                  `protobuf:"varint,2,opt,name=minor,proto3" json:"minor,omitempty"`
+	Patch         int32                  `protobuf:"varint,3,opt,name=patch,proto3" json:"patch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PluginAPIVersionInfo) Reset() {
+	*x = PluginAPIVersionInfo{}
+	mi := &file_context_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginAPIVersionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginAPIVersionInfo) ProtoMessage() {}
+
+func (x *PluginAPIVersionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginAPIVersionInfo.ProtoReflect.Descriptor instead.
+func (*PluginAPIVersionInfo) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PluginAPIVersionInfo) GetMajor() int32 {
+	if x != nil {
+		return x.Major
+	}
+	return 0
+}
+
+func (x *PluginAPIVersionInfo) GetMinor() int32 {
+	if x != nil {
+		return x.Minor
+	}
+	return 0
+}
+
+func (x *PluginAPIVersionInfo) GetPatch() int32 {
+	if x != nil {
+		return x.Patch
+	}
+	return 0
+}
+
+type PluginAPIDescriptor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       *PluginAPIVersionInfo  `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Methods       []string               `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PluginAPIDescriptor) Reset() {
+	*x = PluginAPIDescriptor{}
+	mi := &file_context_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginAPIDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginAPIDescriptor) ProtoMessage() {}
+
+func (x *PluginAPIDescriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-	Player  string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+// Deprecated: Use PluginAPIDescriptor.ProtoReflect.Descriptor instead.
+func (*PluginAPIDescriptor) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *SayToRequest) Reset() {
-	*x = SayToRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_context_service_proto_msgTypes[20]
+func (x *PluginAPIDescriptor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PluginAPIDescriptor) GetVersion() *PluginAPIVersionInfo {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *PluginAPIDescriptor) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+type ExportPluginAPIRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallbackId    uint32                 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version       *PluginAPIVersionInfo  `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Methods       []string               `protobuf:"bytes,4,rep,name=methods,proto3" json:"methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPluginAPIRequest) Reset() {
+	*x = ExportPluginAPIRequest{}
+	mi := &file_context_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPluginAPIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPluginAPIRequest) ProtoMessage() {}
+
+func (x *ExportPluginAPIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[47]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *SayToRequest) String() string {
+// Deprecated: Use ExportPluginAPIRequest.ProtoReflect.Descriptor instead.
+func (*ExportPluginAPIRequest) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ExportPluginAPIRequest) GetCallbackId() uint32 {
+	if x != nil {
+		return x.CallbackId
+	}
+	return 0
+}
+
+func (x *ExportPluginAPIRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExportPluginAPIRequest) GetVersion() *PluginAPIVersionInfo {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *ExportPluginAPIRequest) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+type GetPluginAPIInfoRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RequiredVersion *PluginAPIVersionInfo  `protobuf:"bytes,2,opt,name=required_version,json=requiredVersion,proto3" json:"required_version,omitempty"` // 为空时不检查版本
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPluginAPIInfoRequest) Reset() {
+	*x = GetPluginAPIInfoRequest{}
+	mi := &file_context_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPluginAPIInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SayToRequest) ProtoMessage() {}
+func (*GetPluginAPIInfoRequest) ProtoMessage() {}
 
-func (x *SayToRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_context_service_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *GetPluginAPIInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[48]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1155,268 +2859,560 @@ func (x *SayToRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SayToRequest.ProtoReflect.Descriptor instead.
-func (*SayToRequest) Descriptor() ([]byte, []int) {
-	return file_context_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetPluginAPIInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetPluginAPIInfoRequest) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *SayToRequest) GetPlayer() string {
+func (x *GetPluginAPIInfoRequest) GetName() string {
 	if x != nil {
-		return x.Player
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SayToRequest) GetMessage() string {
+func (x *GetPluginAPIInfoRequest) GetRequiredVersion() *PluginAPIVersionInfo {
 	if x != nil {
-		return x.Message
+		return x.RequiredVersion
+	}
+	return nil
+}
+
+type GetPluginAPIInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Api           *PluginAPIDescriptor   `protobuf:"bytes,3,opt,name=api,proto3" json:"api,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPluginAPIInfoResponse) Reset() {
+	*x = GetPluginAPIInfoResponse{}
+	mi := &file_context_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPluginAPIInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPluginAPIInfoResponse) ProtoMessage() {}
+
+func (x *GetPluginAPIInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPluginAPIInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetPluginAPIInfoResponse) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetPluginAPIInfoResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetPluginAPIInfoResponse) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-var File_context_service_proto protoreflect.FileDescriptor
+func (x *GetPluginAPIInfoResponse) GetApi() *PluginAPIDescriptor {
+	if x != nil {
+		return x.Api
+	}
+	return nil
+}
+
+type ListPluginAPIsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Apis          []*PluginAPIDescriptor `protobuf:"bytes,1,rep,name=apis,proto3" json:"apis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPluginAPIsResponse) Reset() {
+	*x = ListPluginAPIsResponse{}
+	mi := &file_context_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPluginAPIsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPluginAPIsResponse) ProtoMessage() {}
+
+func (x *ListPluginAPIsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPluginAPIsResponse.ProtoReflect.Descriptor instead.
+func (*ListPluginAPIsResponse) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListPluginAPIsResponse) GetApis() []*PluginAPIDescriptor {
+	if x != nil {
+		return x.Apis
+	}
+	return nil
+}
+
+type CallPluginAPIRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RequiredVersion *PluginAPIVersionInfo  `protobuf:"bytes,2,opt,name=required_version,json=requiredVersion,proto3" json:"required_version,omitempty"` // 为空时不检查版本
+	Method          string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Args            []byte                 `protobuf:"bytes,4,opt,name=args,proto3" json:"args,omitempty"` // 由 API 提供方约定的编码（JSON 或 protobuf）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CallPluginAPIRequest) Reset() {
+	*x = CallPluginAPIRequest{}
+	mi := &file_context_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallPluginAPIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallPluginAPIRequest) ProtoMessage() {}
+
+func (x *CallPluginAPIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallPluginAPIRequest.ProtoReflect.Descriptor instead.
+func (*CallPluginAPIRequest) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CallPluginAPIRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CallPluginAPIRequest) GetRequiredVersion() *PluginAPIVersionInfo {
+	if x != nil {
+		return x.RequiredVersion
+	}
+	return nil
+}
+
+func (x *CallPluginAPIRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *CallPluginAPIRequest) GetArgs() []byte {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+type CallPluginAPIResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result        []byte                 `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallPluginAPIResponse) Reset() {
+	*x = CallPluginAPIResponse{}
+	mi := &file_context_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallPluginAPIResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallPluginAPIResponse) ProtoMessage() {}
+
+func (x *CallPluginAPIResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_context_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallPluginAPIResponse.ProtoReflect.Descriptor instead.
+func (*CallPluginAPIResponse) Descriptor() ([]byte, []int) {
+	return file_context_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CallPluginAPIResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CallPluginAPIResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
 
-var file_context_service_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x73, 0x64, 0x6b, 0x22, 0x07, 0x0a, 0x05,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x26, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3e, 0x0a,
-	0x0c, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x26, 0x0a,
-	0x0a, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x27, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x47,
-	0x0a, 0x0f, 0x42, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6f, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6f, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08,
-	0x62, 0x6f, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x62, 0x6f, 0x74, 0x55, 0x75, 0x69, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x27, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0x5d, 0x0a, 0x0e, 0x51, 0x51, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x6f, 0x74, 0x5f, 0x71, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x62, 0x6f, 0x74, 0x51, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6f, 0x74, 0x5f,
-	0x6e, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6f, 0x74, 0x4e,
-	0x69, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x71, 0x71, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x51, 0x71, 0x22, 0xab,
-	0x01, 0x0a, 0x15, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b,
-	0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2c, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x77, 0x6f, 0x72, 0x6b, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b,
-	0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x6c,
-	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x4c,
-	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x36, 0x0a, 0x15,
-	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x61,
-	0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x74, 0x68, 0x50,
-	0x61, 0x72, 0x74, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x22, 0x55, 0x0a,
-	0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x61,
-	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x22, 0x7a, 0x0a, 0x1c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x22, 0x7d, 0x0a, 0x1f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x42, 0x72, 0x6f, 0x61,
-	0x64, 0x63, 0x61, 0x73, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
-	0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22,
-	0x68, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61,
-	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
-	0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x14, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x54, 0x0a, 0x12, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0x5f, 0x0a, 0x13, 0x57, 0x61, 0x69,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x41, 0x0a, 0x17, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x34, 0x0a,
-	0x18, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x73, 0x22, 0x40, 0x0a, 0x0c, 0x53, 0x61, 0x79, 0x54, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0xd4, 0x0d, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12,
-	0x0f, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x10, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0f, 0x2e,
-	0x73, 0x64, 0x6b, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
-	0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2f, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x0f,
-	0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x10, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12,
-	0x0f, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x10, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0f,
-	0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x10, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x30, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x0a, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13,
-	0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x0a, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e,
-	0x73, 0x64, 0x6b, 0x2e, 0x42, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0a, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x17, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x51, 0x51, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0a, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x13, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x51, 0x51, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0a, 0x2e, 0x73, 0x64,
-	0x6b, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x61,
-	0x74, 0x68, 0x12, 0x0a, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13,
-	0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x46, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x13, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x53, 0x61, 0x79, 0x54, 0x6f, 0x12,
-	0x11, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x53, 0x61, 0x79, 0x54, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
-	0x22, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x1b, 0x2e,
-	0x73, 0x64, 0x6b, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64,
-	0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x64, 0x6b,
-	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x19, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x48, 0x61,
-	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x57, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x1b,
-	0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x48, 0x61, 0x6e,
-	0x64, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x64,
-	0x6b, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x15, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c,
-	0x65, 0x72, 0x12, 0x21, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x18, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12,
-	0x1b, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x48, 0x61,
-	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73,
-	0x64, 0x6b, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x16, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x61, 0x6e,
-	0x64, 0x6c, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x52, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x18, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46,
-	0x72, 0x61, 0x6d, 0x65, 0x45, 0x78, 0x69, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12,
-	0x1b, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x48, 0x61,
-	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73,
-	0x64, 0x6b, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x18, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x48,
-	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x48, 0x61,
-	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73,
-	0x64, 0x6b, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x64,
-	0x6b, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x42, 0x6f, 0x6f,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x57, 0x61, 0x69,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x17, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x57,
-	0x61, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x18, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x10, 0x54,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12,
-	0x1c, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x42, 0x72, 0x6f,
-	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x73, 0x64, 0x6b, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x42, 0x72, 0x6f, 0x61, 0x64,
-	0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x24, 0x5a, 0x22,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6f, 0x71, 0x69,
-	0x6a, 0x69, 0x65, 0x2f, 0x46, 0x49, 0x4e, 0x2d, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x73,
-	0x64, 0x6b, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *CallPluginAPIResponse) GetResult() []byte {
+	if x != nil {
+		return x.Result
+	}
+	return nil
 }
 
+var File_context_service_proto protoreflect.FileDescriptor
+
+const file_context_service_proto_rawDesc = "" +
+	"\n" +
+	"\x15context_service.proto\x12\x03sdk\"\a\n" +
+	"\x05Empty\"&\n" +
+	"\x0eStringResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\">\n" +
+	"\fBoolResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"&\n" +
+	"\n" +
+	"LogRequest\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"'\n" +
+	"\vLogResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd8\x01\n" +
+	"\x0fBotInfoResponse\x12\x19\n" +
+	"\bbot_name\x18\x01 \x01(\tR\abotName\x12\x19\n" +
+	"\bbot_uuid\x18\x02 \x01(\tR\abotUuid\x12\x12\n" +
+	"\x04xuid\x18\x03 \x01(\tR\x04xuid\x12(\n" +
+	"\x10entity_unique_id\x18\x04 \x01(\x03R\x0eentityUniqueId\x12*\n" +
+	"\x11entity_runtime_id\x18\x05 \x01(\x04R\x0fentityRuntimeId\x12%\n" +
+	"\x0eschema_version\x18\x06 \x01(\rR\rschemaVersion\"\xcf\x01\n" +
+	"\x12ServerInfoResponse\x12\x1f\n" +
+	"\vserver_code\x18\x01 \x01(\tR\n" +
+	"serverCode\x12'\n" +
+	"\x0fserver_password\x18\x02 \x01(\tR\x0eserverPassword\x12%\n" +
+	"\x0eserver_address\x18\x03 \x01(\tR\rserverAddress\x12!\n" +
+	"\fpasscode_set\x18\x04 \x01(\bR\vpasscodeSet\x12%\n" +
+	"\x0eschema_version\x18\x05 \x01(\rR\rschemaVersion\"\xdf\x01\n" +
+	"\x0eQQInfoResponse\x12\x15\n" +
+	"\x06bot_qq\x18\x01 \x01(\x04R\x05botQq\x12\x19\n" +
+	"\bbot_nick\x18\x02 \x01(\tR\abotNick\x12\x19\n" +
+	"\badmin_qq\x18\x03 \x03(\x04R\aadminQq\x12\x18\n" +
+	"\aadapter\x18\x04 \x01(\tR\aadapter\x12\x15\n" +
+	"\x06ws_url\x18\x05 \x01(\tR\x05wsUrl\x12(\n" +
+	"\x10has_access_token\x18\x06 \x01(\bR\x0ehasAccessToken\x12%\n" +
+	"\x0eschema_version\x18\a \x01(\rR\rschemaVersion\"\xd2\x01\n" +
+	"\x15InterworkInfoResponse\x12Q\n" +
+	"\rlinked_groups\x18\x01 \x03(\v2,.sdk.InterworkInfoResponse.LinkedGroupsEntryR\flinkedGroups\x12%\n" +
+	"\x0eschema_version\x18\x02 \x01(\rR\rschemaVersion\x1a?\n" +
+	"\x11LinkedGroupsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"6\n" +
+	"\x15FormatDataPathRequest\x12\x1d\n" +
+	"\n" +
+	"path_parts\x18\x01 \x03(\tR\tpathParts\"\x86\x01\n" +
+	"\x1dRegisterConsoleCommandRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\btriggers\x18\x02 \x03(\tR\btriggers\x12\x14\n" +
+	"\x05usage\x18\x03 \x01(\tR\x05usage\x12\x1f\n" +
+	"\vcallback_id\x18\x04 \x01(\rR\n" +
+	"callbackId\"{\n" +
+	"\x16RegisterHandlerRequest\x12\x1f\n" +
+	"\vcallback_id\x18\x01 \x01(\rR\n" +
+	"callbackId\x12\x1a\n" +
+	"\bpriority\x18\x02 \x01(\x05R\bpriority\x12$\n" +
+	"\x0eraw_packet_ids\x18\x03 \x03(\rR\frawPacketIds\"\xa0\x01\n" +
+	"\x1cRegisterPacketHandlerRequest\x12\x1f\n" +
+	"\vcallback_id\x18\x01 \x01(\rR\n" +
+	"callbackId\x12\x1d\n" +
+	"\n" +
+	"packet_ids\x18\x02 \x03(\rR\tpacketIds\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\x12$\n" +
+	"\x0eraw_packet_ids\x18\x04 \x03(\rR\frawPacketIds\"}\n" +
+	"\x1fRegisterBroadcastHandlerRequest\x12\x1d\n" +
+	"\n" +
+	"event_name\x18\x01 \x01(\tR\teventName\x12\x1f\n" +
+	"\vcallback_id\x18\x02 \x01(\rR\n" +
+	"callbackId\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\"h\n" +
+	"\x17RegisterHandlerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"handler_id\x18\x03 \x01(\rR\thandlerId\"9\n" +
+	"\x18UnregisterHandlerRequest\x12\x1d\n" +
+	"\n" +
+	"handler_id\x18\x01 \x01(\rR\thandlerId\"H\n" +
+	"\x14CancelMessageRequest\x12\x16\n" +
+	"\x06sender\x18\x01 \x01(\tR\x06sender\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"T\n" +
+	"\x12WaitMessageRequest\x12\x1f\n" +
+	"\vplayer_name\x18\x01 \x01(\tR\n" +
+	"playerName\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x02 \x01(\x03R\ttimeoutMs\"_\n" +
+	"\x13WaitMessageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"A\n" +
+	"\x17TriggerBroadcastRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"4\n" +
+	"\x18TriggerBroadcastResponse\x12\x18\n" +
+	"\aresults\x18\x01 \x01(\fR\aresults\"@\n" +
+	"\fSayToRequest\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\".\n" +
+	"\x12SendCommandRequest\x12\x18\n" +
+	"\acommand\x18\x01 \x01(\tR\acommand\"+\n" +
+	"\x0fSendChatRequest\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"T\n" +
+	"\x1eSendCommandWithResponseRequest\x12\x18\n" +
+	"\acommand\x18\x01 \x01(\tR\acommand\x12\x18\n" +
+	"\atimeout\x18\x02 \x01(\x01R\atimeout\"\x86\x01\n" +
+	"\x1fSendCommandWithResponseResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1b\n" +
+	"\ttimed_out\x18\x03 \x01(\bR\btimedOut\x12\x16\n" +
+	"\x06output\x18\x04 \x01(\fR\x06output\"O\n" +
+	"\x17SendCommandBatchRequest\x12\x1a\n" +
+	"\bcommands\x18\x01 \x03(\tR\bcommands\x12\x18\n" +
+	"\atimeout\x18\x02 \x01(\x01R\atimeout\"\x8a\x01\n" +
+	"\x18SendCommandBatchResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12>\n" +
+	"\aresults\x18\x03 \x03(\v2$.sdk.SendCommandWithResponseResponseR\aresults\"c\n" +
+	"\x0fGetScoreRequest\x12\x1e\n" +
+	"\n" +
+	"scoreboard\x18\x01 \x01(\tR\n" +
+	"scoreboard\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x18\n" +
+	"\atimeout\x18\x03 \x01(\x01R\atimeout\"X\n" +
+	"\x10GetScoreResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x03R\x05score\"'\n" +
+	"\rGetPosRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\"\x9d\x01\n" +
+	"\x0eGetPosResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\f\n" +
+	"\x01x\x18\x03 \x01(\x02R\x01x\x12\f\n" +
+	"\x01y\x18\x04 \x01(\x02R\x01y\x12\f\n" +
+	"\x01z\x18\x05 \x01(\x02R\x01z\x12\x1c\n" +
+	"\tdimension\x18\x06 \x01(\rR\tdimension\x12\x13\n" +
+	"\x05y_rot\x18\a \x01(\x02R\x04yRot\"D\n" +
+	"\x10GetTargetRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\x18\n" +
+	"\atimeout\x18\x02 \x01(\x01R\atimeout\"Y\n" +
+	"\x11GetTargetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x14\n" +
+	"\x05names\x18\x03 \x03(\tR\x05names\"m\n" +
+	"\x0eGetItemRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\x1b\n" +
+	"\titem_name\x18\x02 \x01(\tR\bitemName\x12&\n" +
+	"\x0fitem_special_id\x18\x03 \x01(\x05R\ritemSpecialId\"W\n" +
+	"\x0fGetItemResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\".\n" +
+	"\vIsOpRequest\x12\x1f\n" +
+	"\vplayer_name\x18\x01 \x01(\tR\n" +
+	"playerName\"S\n" +
+	"\fIsOpResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x13\n" +
+	"\x05is_op\x18\x03 \x01(\bR\x04isOp\"F\n" +
+	"\x0eTellrawRequest\x12\x1a\n" +
+	"\bselector\x18\x01 \x01(\tR\bselector\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa0\x01\n" +
+	"\x10SetEffectRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\x1b\n" +
+	"\teffect_id\x18\x02 \x01(\x05R\beffectId\x12\x1a\n" +
+	"\bduration\x18\x03 \x01(\x05R\bduration\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level\x12%\n" +
+	"\x0ehide_particles\x18\x05 \x01(\bR\rhideParticles\"Q\n" +
+	"\x11SendPacketRequest\x12\x1b\n" +
+	"\tpacket_id\x18\x01 \x01(\rR\bpacketId\x12\x1f\n" +
+	"\vpacket_data\x18\x02 \x01(\fR\n" +
+	"packetData\"\xb6\x01\n" +
+	"\n" +
+	"PlayerInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04xuid\x18\x03 \x01(\tR\x04xuid\x12(\n" +
+	"\x10entity_unique_id\x18\x04 \x01(\x03R\x0eentityUniqueId\x12*\n" +
+	"\x11entity_runtime_id\x18\x05 \x01(\x04R\x0fentityRuntimeId\x12\x16\n" +
+	"\x06online\x18\x06 \x01(\bR\x06online\"\x93\x01\n" +
+	"\x13ListPlayersResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12)\n" +
+	"\aplayers\x18\x03 \x03(\v2\x0f.sdk.PlayerInfoR\aplayers\x12!\n" +
+	"\x03bot\x18\x04 \x01(\v2\x0f.sdk.PlayerInfoR\x03bot\"d\n" +
+	"\x10GetPlayerRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12(\n" +
+	"\x10entity_unique_id\x18\x03 \x01(\x03R\x0eentityUniqueId\"\x82\x01\n" +
+	"\x11GetPlayerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x14\n" +
+	"\x05found\x18\x03 \x01(\bR\x05found\x12'\n" +
+	"\x06player\x18\x04 \x01(\v2\x0f.sdk.PlayerInfoR\x06player\"X\n" +
+	"\x14PluginAPIVersionInfo\x12\x14\n" +
+	"\x05major\x18\x01 \x01(\x05R\x05major\x12\x14\n" +
+	"\x05minor\x18\x02 \x01(\x05R\x05minor\x12\x14\n" +
+	"\x05patch\x18\x03 \x01(\x05R\x05patch\"x\n" +
+	"\x13PluginAPIDescriptor\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x123\n" +
+	"\aversion\x18\x02 \x01(\v2\x19.sdk.PluginAPIVersionInfoR\aversion\x12\x18\n" +
+	"\amethods\x18\x03 \x03(\tR\amethods\"\x9c\x01\n" +
+	"\x16ExportPluginAPIRequest\x12\x1f\n" +
+	"\vcallback_id\x18\x01 \x01(\rR\n" +
+	"callbackId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x123\n" +
+	"\aversion\x18\x03 \x01(\v2\x19.sdk.PluginAPIVersionInfoR\aversion\x12\x18\n" +
+	"\amethods\x18\x04 \x03(\tR\amethods\"s\n" +
+	"\x17GetPluginAPIInfoRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12D\n" +
+	"\x10required_version\x18\x02 \x01(\v2\x19.sdk.PluginAPIVersionInfoR\x0frequiredVersion\"v\n" +
+	"\x18GetPluginAPIInfoResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12*\n" +
+	"\x03api\x18\x03 \x01(\v2\x18.sdk.PluginAPIDescriptorR\x03api\"F\n" +
+	"\x16ListPluginAPIsResponse\x12,\n" +
+	"\x04apis\x18\x01 \x03(\v2\x18.sdk.PluginAPIDescriptorR\x04apis\"\x9c\x01\n" +
+	"\x14CallPluginAPIRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12D\n" +
+	"\x10required_version\x18\x02 \x01(\v2\x19.sdk.PluginAPIVersionInfoR\x0frequiredVersion\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x12\n" +
+	"\x04args\x18\x04 \x01(\fR\x04args\"_\n" +
+	"\x15CallPluginAPIResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x16\n" +
+	"\x06result\x18\x03 \x01(\fR\x06result2\xc6\x18\n" +
+	"\x0eContextService\x12(\n" +
+	"\x03Log\x12\x0f.sdk.LogRequest\x1a\x10.sdk.LogResponse\x12,\n" +
+	"\aLogInfo\x12\x0f.sdk.LogRequest\x1a\x10.sdk.LogResponse\x12/\n" +
+	"\n" +
+	"LogSuccess\x12\x0f.sdk.LogRequest\x1a\x10.sdk.LogResponse\x12/\n" +
+	"\n" +
+	"LogWarning\x12\x0f.sdk.LogRequest\x1a\x10.sdk.LogResponse\x12-\n" +
+	"\bLogError\x12\x0f.sdk.LogRequest\x1a\x10.sdk.LogResponse\x120\n" +
+	"\rGetPluginName\x12\n" +
+	".sdk.Empty\x1a\x13.sdk.StringResponse\x12.\n" +
+	"\n" +
+	"GetBotInfo\x12\n" +
+	".sdk.Empty\x1a\x14.sdk.BotInfoResponse\x124\n" +
+	"\rGetServerInfo\x12\n" +
+	".sdk.Empty\x1a\x17.sdk.ServerInfoResponse\x12,\n" +
+	"\tGetQQInfo\x12\n" +
+	".sdk.Empty\x1a\x13.sdk.QQInfoResponse\x12:\n" +
+	"\x10GetInterworkInfo\x12\n" +
+	".sdk.Empty\x1a\x1a.sdk.InterworkInfoResponse\x12.\n" +
+	"\vGetDataPath\x12\n" +
+	".sdk.Empty\x1a\x13.sdk.StringResponse\x12A\n" +
+	"\x0eFormatDataPath\x12\x1a.sdk.FormatDataPathRequest\x1a\x13.sdk.StringResponse\x12-\n" +
+	"\x05SayTo\x12\x11.sdk.SayToRequest\x1a\x11.sdk.BoolResponse\x129\n" +
+	"\vSendCommand\x12\x17.sdk.SendCommandRequest\x1a\x11.sdk.BoolResponse\x12;\n" +
+	"\rSendWOCommand\x12\x17.sdk.SendCommandRequest\x1a\x11.sdk.BoolResponse\x123\n" +
+	"\bSendChat\x12\x14.sdk.SendChatRequest\x1a\x11.sdk.BoolResponse\x120\n" +
+	"\x05Title\x12\x14.sdk.SendChatRequest\x1a\x11.sdk.BoolResponse\x12d\n" +
+	"\x17SendCommandWithResponse\x12#.sdk.SendCommandWithResponseRequest\x1a$.sdk.SendCommandWithResponseResponse\x12O\n" +
+	"\x10SendCommandBatch\x12\x1c.sdk.SendCommandBatchRequest\x1a\x1d.sdk.SendCommandBatchResponse\x127\n" +
+	"\bGetScore\x12\x14.sdk.GetScoreRequest\x1a\x15.sdk.GetScoreResponse\x121\n" +
+	"\x06GetPos\x12\x12.sdk.GetPosRequest\x1a\x13.sdk.GetPosResponse\x12:\n" +
+	"\tGetTarget\x12\x15.sdk.GetTargetRequest\x1a\x16.sdk.GetTargetResponse\x124\n" +
+	"\aGetItem\x12\x13.sdk.GetItemRequest\x1a\x14.sdk.GetItemResponse\x12+\n" +
+	"\x04IsOp\x12\x10.sdk.IsOpRequest\x1a\x11.sdk.IsOpResponse\x121\n" +
+	"\aTellraw\x12\x13.sdk.TellrawRequest\x1a\x11.sdk.BoolResponse\x125\n" +
+	"\tSetEffect\x12\x15.sdk.SetEffectRequest\x1a\x11.sdk.BoolResponse\x127\n" +
+	"\n" +
+	"SendPacket\x12\x16.sdk.SendPacketRequest\x1a\x11.sdk.BoolResponse\x123\n" +
+	"\vListPlayers\x12\n" +
+	".sdk.Empty\x1a\x18.sdk.ListPlayersResponse\x12:\n" +
+	"\tGetPlayer\x12\x15.sdk.GetPlayerRequest\x1a\x16.sdk.GetPlayerResponse\x12A\n" +
+	"\x0fExportPluginAPI\x12\x1b.sdk.ExportPluginAPIRequest\x1a\x11.sdk.BoolResponse\x12O\n" +
+	"\x10GetPluginAPIInfo\x12\x1c.sdk.GetPluginAPIInfoRequest\x1a\x1d.sdk.GetPluginAPIInfoResponse\x129\n" +
+	"\x0eListPluginAPIs\x12\n" +
+	".sdk.Empty\x1a\x1b.sdk.ListPluginAPIsResponse\x12F\n" +
+	"\rCallPluginAPI\x12\x19.sdk.CallPluginAPIRequest\x1a\x1a.sdk.CallPluginAPIResponse\x12O\n" +
+	"\x16RegisterConsoleCommand\x12\".sdk.RegisterConsoleCommandRequest\x1a\x11.sdk.BoolResponse\x12P\n" +
+	"\x13RegisterChatHandler\x12\x1b.sdk.RegisterHandlerRequest\x1a\x1c.sdk.RegisterHandlerResponse\x12V\n" +
+	"\x19RegisterPlayerJoinHandler\x12\x1b.sdk.RegisterHandlerRequest\x1a\x1c.sdk.RegisterHandlerResponse\x12W\n" +
+	"\x1aRegisterPlayerLeaveHandler\x12\x1b.sdk.RegisterHandlerRequest\x1a\x1c.sdk.RegisterHandlerResponse\x12X\n" +
+	"\x15RegisterPacketHandler\x12!.sdk.RegisterPacketHandlerRequest\x1a\x1c.sdk.RegisterHandlerResponse\x12U\n" +
+	"\x18RegisterPacketAllHandler\x12\x1b.sdk.RegisterHandlerRequest\x1a\x1c.sdk.RegisterHandlerResponse\x12]\n" +
+	"\x1aRegisterBytesPacketHandler\x12!.sdk.RegisterPacketHandlerRequest\x1a\x1c.sdk.RegisterHandlerResponse\x12S\n" +
+	"\x16RegisterPreloadHandler\x12\x1b.sdk.RegisterHandlerRequest\x1a\x1c.sdk.RegisterHandlerResponse\x12R\n" +
+	"\x15RegisterActiveHandler\x12\x1b.sdk.RegisterHandlerRequest\x1a\x1c.sdk.RegisterHandlerResponse\x12U\n" +
+	"\x18RegisterFrameExitHandler\x12\x1b.sdk.RegisterHandlerRequest\x1a\x1c.sdk.RegisterHandlerResponse\x12^\n" +
+	"\x18RegisterBroadcastHandler\x12$.sdk.RegisterBroadcastHandlerRequest\x1a\x1c.sdk.RegisterHandlerResponse\x12E\n" +
+	"\x11UnregisterHandler\x12\x1d.sdk.UnregisterHandlerRequest\x1a\x11.sdk.BoolResponse\x12=\n" +
+	"\rCancelMessage\x12\x19.sdk.CancelMessageRequest\x1a\x11.sdk.BoolResponse\x12@\n" +
+	"\vWaitMessage\x12\x17.sdk.WaitMessageRequest\x1a\x18.sdk.WaitMessageResponse\x12O\n" +
+	"\x10TriggerBroadcast\x12\x1c.sdk.TriggerBroadcastRequest\x1a\x1d.sdk.TriggerBroadcastResponseB$Z\"github.com/maoqijie/FIN-plugin/sdkb\x06proto3"
+
 var (
 	file_context_service_proto_rawDescOnce sync.Once
-	file_context_service_proto_rawDescData = file_context_service_proto_rawDesc
+	file_context_service_proto_rawDescData []byte
 )
 
 func file_context_service_proto_rawDescGZIP() []byte {
 	file_context_service_proto_rawDescOnce.Do(func() {
-		file_context_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_context_service_proto_rawDescData)
+		file_context_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_context_service_proto_rawDesc), len(file_context_service_proto_rawDesc)))
 	})
 	return file_context_service_proto_rawDescData
 }
 
-var file_context_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
-var file_context_service_proto_goTypes = []interface{}{
+var file_context_service_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
+var file_context_service_proto_goTypes = []any{
 	(*Empty)(nil),                           // 0: sdk.Empty
 	(*StringResponse)(nil),                  // 1: sdk.StringResponse
 	(*BoolResponse)(nil),                    // 2: sdk.BoolResponse
@@ -1432,73 +3428,159 @@ var file_context_service_proto_goTypes = []interface{}{
 	(*RegisterPacketHandlerRequest)(nil),    // 12: sdk.RegisterPacketHandlerRequest
 	(*RegisterBroadcastHandlerRequest)(nil), // 13: sdk.RegisterBroadcastHandlerRequest
 	(*RegisterHandlerResponse)(nil),         // 14: sdk.RegisterHandlerResponse
-	(*CancelMessageRequest)(nil),            // 15: sdk.CancelMessageRequest
-	(*WaitMessageRequest)(nil),              // 16: sdk.WaitMessageRequest
-	(*WaitMessageResponse)(nil),             // 17: sdk.WaitMessageResponse
-	(*TriggerBroadcastRequest)(nil),         // 18: sdk.TriggerBroadcastRequest
-	(*TriggerBroadcastResponse)(nil),        // 19: sdk.TriggerBroadcastResponse
-	(*SayToRequest)(nil),                    // 20: sdk.SayToRequest
-	nil,                                     // 21: sdk.InterworkInfoResponse.LinkedGroupsEntry
+	(*UnregisterHandlerRequest)(nil),        // 15: sdk.UnregisterHandlerRequest
+	(*CancelMessageRequest)(nil),            // 16: sdk.CancelMessageRequest
+	(*WaitMessageRequest)(nil),              // 17: sdk.WaitMessageRequest
+	(*WaitMessageResponse)(nil),             // 18: sdk.WaitMessageResponse
+	(*TriggerBroadcastRequest)(nil),         // 19: sdk.TriggerBroadcastRequest
+	(*TriggerBroadcastResponse)(nil),        // 20: sdk.TriggerBroadcastResponse
+	(*SayToRequest)(nil),                    // 21: sdk.SayToRequest
+	(*SendCommandRequest)(nil),              // 22: sdk.SendCommandRequest
+	(*SendChatRequest)(nil),                 // 23: sdk.SendChatRequest
+	(*SendCommandWithResponseRequest)(nil),  // 24: sdk.SendCommandWithResponseRequest
+	(*SendCommandWithResponseResponse)(nil), // 25: sdk.SendCommandWithResponseResponse
+	(*SendCommandBatchRequest)(nil),         // 26: sdk.SendCommandBatchRequest
+	(*SendCommandBatchResponse)(nil),        // 27: sdk.SendCommandBatchResponse
+	(*GetScoreRequest)(nil),                 // 28: sdk.GetScoreRequest
+	(*GetScoreResponse)(nil),                // 29: sdk.GetScoreResponse
+	(*GetPosRequest)(nil),                   // 30: sdk.GetPosRequest
+	(*GetPosResponse)(nil),                  // 31: sdk.GetPosResponse
+	(*GetTargetRequest)(nil),                // 32: sdk.GetTargetRequest
+	(*GetTargetResponse)(nil),               // 33: sdk.GetTargetResponse
+	(*GetItemRequest)(nil),                  // 34: sdk.GetItemRequest
+	(*GetItemResponse)(nil),                 // 35: sdk.GetItemResponse
+	(*IsOpRequest)(nil),                     // 36: sdk.IsOpRequest
+	(*IsOpResponse)(nil),                    // 37: sdk.IsOpResponse
+	(*TellrawRequest)(nil),                  // 38: sdk.TellrawRequest
+	(*SetEffectRequest)(nil),                // 39: sdk.SetEffectRequest
+	(*SendPacketRequest)(nil),               // 40: sdk.SendPacketRequest
+	(*PlayerInfo)(nil),                      // 41: sdk.PlayerInfo
+	(*ListPlayersResponse)(nil),             // 42: sdk.ListPlayersResponse
+	(*GetPlayerRequest)(nil),                // 43: sdk.GetPlayerRequest
+	(*GetPlayerResponse)(nil),               // 44: sdk.GetPlayerResponse
+	(*PluginAPIVersionInfo)(nil),            // 45: sdk.PluginAPIVersionInfo
+	(*PluginAPIDescriptor)(nil),             // 46: sdk.PluginAPIDescriptor
+	(*ExportPluginAPIRequest)(nil),          // 47: sdk.ExportPluginAPIRequest
+	(*GetPluginAPIInfoRequest)(nil),         // 48: sdk.GetPluginAPIInfoRequest
+	(*GetPluginAPIInfoResponse)(nil),        // 49: sdk.GetPluginAPIInfoResponse
+	(*ListPluginAPIsResponse)(nil),          // 50: sdk.ListPluginAPIsResponse
+	(*CallPluginAPIRequest)(nil),            // 51: sdk.CallPluginAPIRequest
+	(*CallPluginAPIResponse)(nil),           // 52: sdk.CallPluginAPIResponse
+	nil,                                     // 53: sdk.InterworkInfoResponse.LinkedGroupsEntry
 }
 var file_context_service_proto_depIdxs = []int32{
-	21, // 0: sdk.InterworkInfoResponse.linked_groups:type_name -> sdk.InterworkInfoResponse.LinkedGroupsEntry
-	3,  // 1: sdk.ContextService.Log:input_type -> sdk.LogRequest
-	3,  // 2: sdk.ContextService.LogInfo:input_type -> sdk.LogRequest
-	3,  // 3: sdk.ContextService.LogSuccess:input_type -> sdk.LogRequest
-	3,  // 4: sdk.ContextService.LogWarning:input_type -> sdk.LogRequest
-	3,  // 5: sdk.ContextService.LogError:input_type -> sdk.LogRequest
-	0,  // 6: sdk.ContextService.GetPluginName:input_type -> sdk.Empty
-	0,  // 7: sdk.ContextService.GetBotInfo:input_type -> sdk.Empty
-	0,  // 8: sdk.ContextService.GetServerInfo:input_type -> sdk.Empty
-	0,  // 9: sdk.ContextService.GetQQInfo:input_type -> sdk.Empty
-	0,  // 10: sdk.ContextService.GetInterworkInfo:input_type -> sdk.Empty
-	0,  // 11: sdk.ContextService.GetDataPath:input_type -> sdk.Empty
-	9,  // 12: sdk.ContextService.FormatDataPath:input_type -> sdk.FormatDataPathRequest
-	20, // 13: sdk.ContextService.SayTo:input_type -> sdk.SayToRequest
-	10, // 14: sdk.ContextService.RegisterConsoleCommand:input_type -> sdk.RegisterConsoleCommandRequest
-	11, // 15: sdk.ContextService.RegisterChatHandler:input_type -> sdk.RegisterHandlerRequest
-	11, // 16: sdk.ContextService.RegisterPlayerJoinHandler:input_type -> sdk.RegisterHandlerRequest
-	11, // 17: sdk.ContextService.RegisterPlayerLeaveHandler:input_type -> sdk.RegisterHandlerRequest
-	12, // 18: sdk.ContextService.RegisterPacketHandler:input_type -> sdk.RegisterPacketHandlerRequest
-	11, // 19: sdk.ContextService.RegisterPacketAllHandler:input_type -> sdk.RegisterHandlerRequest
-	11, // 20: sdk.ContextService.RegisterPreloadHandler:input_type -> sdk.RegisterHandlerRequest
-	11, // 21: sdk.ContextService.RegisterActiveHandler:input_type -> sdk.RegisterHandlerRequest
-	11, // 22: sdk.ContextService.RegisterFrameExitHandler:input_type -> sdk.RegisterHandlerRequest
-	13, // 23: sdk.ContextService.RegisterBroadcastHandler:input_type -> sdk.RegisterBroadcastHandlerRequest
-	15, // 24: sdk.ContextService.CancelMessage:input_type -> sdk.CancelMessageRequest
-	16, // 25: sdk.ContextService.WaitMessage:input_type -> sdk.WaitMessageRequest
-	18, // 26: sdk.ContextService.TriggerBroadcast:input_type -> sdk.TriggerBroadcastRequest
-	4,  // 27: sdk.ContextService.Log:output_type -> sdk.LogResponse
-	4,  // 28: sdk.ContextService.LogInfo:output_type -> sdk.LogResponse
-	4,  // 29: sdk.ContextService.LogSuccess:output_type -> sdk.LogResponse
-	4,  // 30: sdk.ContextService.LogWarning:output_type -> sdk.LogResponse
-	4,  // 31: sdk.ContextService.LogError:output_type -> sdk.LogResponse
-	1,  // 32: sdk.ContextService.GetPluginName:output_type -> sdk.StringResponse
-	5,  // 33: sdk.ContextService.GetBotInfo:output_type -> sdk.BotInfoResponse
-	6,  // 34: sdk.ContextService.GetServerInfo:output_type -> sdk.ServerInfoResponse
-	7,  // 35: sdk.ContextService.GetQQInfo:output_type -> sdk.QQInfoResponse
-	8,  // 36: sdk.ContextService.GetInterworkInfo:output_type -> sdk.InterworkInfoResponse
-	1,  // 37: sdk.ContextService.GetDataPath:output_type -> sdk.StringResponse
-	1,  // 38: sdk.ContextService.FormatDataPath:output_type -> sdk.StringResponse
-	2,  // 39: sdk.ContextService.SayTo:output_type -> sdk.BoolResponse
-	2,  // 40: sdk.ContextService.RegisterConsoleCommand:output_type -> sdk.BoolResponse
-	14, // 41: sdk.ContextService.RegisterChatHandler:output_type -> sdk.RegisterHandlerResponse
-	14, // 42: sdk.ContextService.RegisterPlayerJoinHandler:output_type -> sdk.RegisterHandlerResponse
-	14, // 43: sdk.ContextService.RegisterPlayerLeaveHandler:output_type -> sdk.RegisterHandlerResponse
-	14, // 44: sdk.ContextService.RegisterPacketHandler:output_type -> sdk.RegisterHandlerResponse
-	14, // 45: sdk.ContextService.RegisterPacketAllHandler:output_type -> sdk.RegisterHandlerResponse
-	14, // 46: sdk.ContextService.RegisterPreloadHandler:output_type -> sdk.RegisterHandlerResponse
-	14, // 47: sdk.ContextService.RegisterActiveHandler:output_type -> sdk.RegisterHandlerResponse
-	14, // 48: sdk.ContextService.RegisterFrameExitHandler:output_type -> sdk.RegisterHandlerResponse
-	14, // 49: sdk.ContextService.RegisterBroadcastHandler:output_type -> sdk.RegisterHandlerResponse
-	2,  // 50: sdk.ContextService.CancelMessage:output_type -> sdk.BoolResponse
-	17, // 51: sdk.ContextService.WaitMessage:output_type -> sdk.WaitMessageResponse
-	19, // 52: sdk.ContextService.TriggerBroadcast:output_type -> sdk.TriggerBroadcastResponse
-	27, // [27:53] is the sub-list for method output_type
-	1,  // [1:27] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	53, // 0: sdk.InterworkInfoResponse.linked_groups:type_name -> sdk.InterworkInfoResponse.LinkedGroupsEntry
+	25, // 1: sdk.SendCommandBatchResponse.results:type_name -> sdk.SendCommandWithResponseResponse
+	41, // 2: sdk.ListPlayersResponse.players:type_name -> sdk.PlayerInfo
+	41, // 3: sdk.ListPlayersResponse.bot:type_name -> sdk.PlayerInfo
+	41, // 4: sdk.GetPlayerResponse.player:type_name -> sdk.PlayerInfo
+	45, // 5: sdk.PluginAPIDescriptor.version:type_name -> sdk.PluginAPIVersionInfo
+	45, // 6: sdk.ExportPluginAPIRequest.version:type_name -> sdk.PluginAPIVersionInfo
+	45, // 7: sdk.GetPluginAPIInfoRequest.required_version:type_name -> sdk.PluginAPIVersionInfo
+	46, // 8: sdk.GetPluginAPIInfoResponse.api:type_name -> sdk.PluginAPIDescriptor
+	46, // 9: sdk.ListPluginAPIsResponse.apis:type_name -> sdk.PluginAPIDescriptor
+	45, // 10: sdk.CallPluginAPIRequest.required_version:type_name -> sdk.PluginAPIVersionInfo
+	3,  // 11: sdk.ContextService.Log:input_type -> sdk.LogRequest
+	3,  // 12: sdk.ContextService.LogInfo:input_type -> sdk.LogRequest
+	3,  // 13: sdk.ContextService.LogSuccess:input_type -> sdk.LogRequest
+	3,  // 14: sdk.ContextService.LogWarning:input_type -> sdk.LogRequest
+	3,  // 15: sdk.ContextService.LogError:input_type -> sdk.LogRequest
+	0,  // 16: sdk.ContextService.GetPluginName:input_type -> sdk.Empty
+	0,  // 17: sdk.ContextService.GetBotInfo:input_type -> sdk.Empty
+	0,  // 18: sdk.ContextService.GetServerInfo:input_type -> sdk.Empty
+	0,  // 19: sdk.ContextService.GetQQInfo:input_type -> sdk.Empty
+	0,  // 20: sdk.ContextService.GetInterworkInfo:input_type -> sdk.Empty
+	0,  // 21: sdk.ContextService.GetDataPath:input_type -> sdk.Empty
+	9,  // 22: sdk.ContextService.FormatDataPath:input_type -> sdk.FormatDataPathRequest
+	21, // 23: sdk.ContextService.SayTo:input_type -> sdk.SayToRequest
+	22, // 24: sdk.ContextService.SendCommand:input_type -> sdk.SendCommandRequest
+	22, // 25: sdk.ContextService.SendWOCommand:input_type -> sdk.SendCommandRequest
+	23, // 26: sdk.ContextService.SendChat:input_type -> sdk.SendChatRequest
+	23, // 27: sdk.ContextService.Title:input_type -> sdk.SendChatRequest
+	24, // 28: sdk.ContextService.SendCommandWithResponse:input_type -> sdk.SendCommandWithResponseRequest
+	26, // 29: sdk.ContextService.SendCommandBatch:input_type -> sdk.SendCommandBatchRequest
+	28, // 30: sdk.ContextService.GetScore:input_type -> sdk.GetScoreRequest
+	30, // 31: sdk.ContextService.GetPos:input_type -> sdk.GetPosRequest
+	32, // 32: sdk.ContextService.GetTarget:input_type -> sdk.GetTargetRequest
+	34, // 33: sdk.ContextService.GetItem:input_type -> sdk.GetItemRequest
+	36, // 34: sdk.ContextService.IsOp:input_type -> sdk.IsOpRequest
+	38, // 35: sdk.ContextService.Tellraw:input_type -> sdk.TellrawRequest
+	39, // 36: sdk.ContextService.SetEffect:input_type -> sdk.SetEffectRequest
+	40, // 37: sdk.ContextService.SendPacket:input_type -> sdk.SendPacketRequest
+	0,  // 38: sdk.ContextService.ListPlayers:input_type -> sdk.Empty
+	43, // 39: sdk.ContextService.GetPlayer:input_type -> sdk.GetPlayerRequest
+	47, // 40: sdk.ContextService.ExportPluginAPI:input_type -> sdk.ExportPluginAPIRequest
+	48, // 41: sdk.ContextService.GetPluginAPIInfo:input_type -> sdk.GetPluginAPIInfoRequest
+	0,  // 42: sdk.ContextService.ListPluginAPIs:input_type -> sdk.Empty
+	51, // 43: sdk.ContextService.CallPluginAPI:input_type -> sdk.CallPluginAPIRequest
+	10, // 44: sdk.ContextService.RegisterConsoleCommand:input_type -> sdk.RegisterConsoleCommandRequest
+	11, // 45: sdk.ContextService.RegisterChatHandler:input_type -> sdk.RegisterHandlerRequest
+	11, // 46: sdk.ContextService.RegisterPlayerJoinHandler:input_type -> sdk.RegisterHandlerRequest
+	11, // 47: sdk.ContextService.RegisterPlayerLeaveHandler:input_type -> sdk.RegisterHandlerRequest
+	12, // 48: sdk.ContextService.RegisterPacketHandler:input_type -> sdk.RegisterPacketHandlerRequest
+	11, // 49: sdk.ContextService.RegisterPacketAllHandler:input_type -> sdk.RegisterHandlerRequest
+	12, // 50: sdk.ContextService.RegisterBytesPacketHandler:input_type -> sdk.RegisterPacketHandlerRequest
+	11, // 51: sdk.ContextService.RegisterPreloadHandler:input_type -> sdk.RegisterHandlerRequest
+	11, // 52: sdk.ContextService.RegisterActiveHandler:input_type -> sdk.RegisterHandlerRequest
+	11, // 53: sdk.ContextService.RegisterFrameExitHandler:input_type -> sdk.RegisterHandlerRequest
+	13, // 54: sdk.ContextService.RegisterBroadcastHandler:input_type -> sdk.RegisterBroadcastHandlerRequest
+	15, // 55: sdk.ContextService.UnregisterHandler:input_type -> sdk.UnregisterHandlerRequest
+	16, // 56: sdk.ContextService.CancelMessage:input_type -> sdk.CancelMessageRequest
+	17, // 57: sdk.ContextService.WaitMessage:input_type -> sdk.WaitMessageRequest
+	19, // 58: sdk.ContextService.TriggerBroadcast:input_type -> sdk.TriggerBroadcastRequest
+	4,  // 59: sdk.ContextService.Log:output_type -> sdk.LogResponse
+	4,  // 60: sdk.ContextService.LogInfo:output_type -> sdk.LogResponse
+	4,  // 61: sdk.ContextService.LogSuccess:output_type -> sdk.LogResponse
+	4,  // 62: sdk.ContextService.LogWarning:output_type -> sdk.LogResponse
+	4,  // 63: sdk.ContextService.LogError:output_type -> sdk.LogResponse
+	1,  // 64: sdk.ContextService.GetPluginName:output_type -> sdk.StringResponse
+	5,  // 65: sdk.ContextService.GetBotInfo:output_type -> sdk.BotInfoResponse
+	6,  // 66: sdk.ContextService.GetServerInfo:output_type -> sdk.ServerInfoResponse
+	7,  // 67: sdk.ContextService.GetQQInfo:output_type -> sdk.QQInfoResponse
+	8,  // 68: sdk.ContextService.GetInterworkInfo:output_type -> sdk.InterworkInfoResponse
+	1,  // 69: sdk.ContextService.GetDataPath:output_type -> sdk.StringResponse
+	1,  // 70: sdk.ContextService.FormatDataPath:output_type -> sdk.StringResponse
+	2,  // 71: sdk.ContextService.SayTo:output_type -> sdk.BoolResponse
+	2,  // 72: sdk.ContextService.SendCommand:output_type -> sdk.BoolResponse
+	2,  // 73: sdk.ContextService.SendWOCommand:output_type -> sdk.BoolResponse
+	2,  // 74: sdk.ContextService.SendChat:output_type -> sdk.BoolResponse
+	2,  // 75: sdk.ContextService.Title:output_type -> sdk.BoolResponse
+	25, // 76: sdk.ContextService.SendCommandWithResponse:output_type -> sdk.SendCommandWithResponseResponse
+	27, // 77: sdk.ContextService.SendCommandBatch:output_type -> sdk.SendCommandBatchResponse
+	29, // 78: sdk.ContextService.GetScore:output_type -> sdk.GetScoreResponse
+	31, // 79: sdk.ContextService.GetPos:output_type -> sdk.GetPosResponse
+	33, // 80: sdk.ContextService.GetTarget:output_type -> sdk.GetTargetResponse
+	35, // 81: sdk.ContextService.GetItem:output_type -> sdk.GetItemResponse
+	37, // 82: sdk.ContextService.IsOp:output_type -> sdk.IsOpResponse
+	2,  // 83: sdk.ContextService.Tellraw:output_type -> sdk.BoolResponse
+	2,  // 84: sdk.ContextService.SetEffect:output_type -> sdk.BoolResponse
+	2,  // 85: sdk.ContextService.SendPacket:output_type -> sdk.BoolResponse
+	42, // 86: sdk.ContextService.ListPlayers:output_type -> sdk.ListPlayersResponse
+	44, // 87: sdk.ContextService.GetPlayer:output_type -> sdk.GetPlayerResponse
+	2,  // 88: sdk.ContextService.ExportPluginAPI:output_type -> sdk.BoolResponse
+	49, // 89: sdk.ContextService.GetPluginAPIInfo:output_type -> sdk.GetPluginAPIInfoResponse
+	50, // 90: sdk.ContextService.ListPluginAPIs:output_type -> sdk.ListPluginAPIsResponse
+	52, // 91: sdk.ContextService.CallPluginAPI:output_type -> sdk.CallPluginAPIResponse
+	2,  // 92: sdk.ContextService.RegisterConsoleCommand:output_type -> sdk.BoolResponse
+	14, // 93: sdk.ContextService.RegisterChatHandler:output_type -> sdk.RegisterHandlerResponse
+	14, // 94: sdk.ContextService.RegisterPlayerJoinHandler:output_type -> sdk.RegisterHandlerResponse
+	14, // 95: sdk.ContextService.RegisterPlayerLeaveHandler:output_type -> sdk.RegisterHandlerResponse
+	14, // 96: sdk.ContextService.RegisterPacketHandler:output_type -> sdk.RegisterHandlerResponse
+	14, // 97: sdk.ContextService.RegisterPacketAllHandler:output_type -> sdk.RegisterHandlerResponse
+	14, // 98: sdk.ContextService.RegisterBytesPacketHandler:output_type -> sdk.RegisterHandlerResponse
+	14, // 99: sdk.ContextService.RegisterPreloadHandler:output_type -> sdk.RegisterHandlerResponse
+	14, // 100: sdk.ContextService.RegisterActiveHandler:output_type -> sdk.RegisterHandlerResponse
+	14, // 101: sdk.ContextService.RegisterFrameExitHandler:output_type -> sdk.RegisterHandlerResponse
+	14, // 102: sdk.ContextService.RegisterBroadcastHandler:output_type -> sdk.RegisterHandlerResponse
+	2,  // 103: sdk.ContextService.UnregisterHandler:output_type -> sdk.BoolResponse
+	2,  // 104: sdk.ContextService.CancelMessage:output_type -> sdk.BoolResponse
+	18, // 105: sdk.ContextService.WaitMessage:output_type -> sdk.WaitMessageResponse
+	20, // 106: sdk.ContextService.TriggerBroadcast:output_type -> sdk.TriggerBroadcastResponse
+	59, // [59:107] is the sub-list for method output_type
+	11, // [11:59] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_context_service_proto_init() }
@@ -1506,267 +3588,13 @@ func file_context_service_proto_init() {
 	if File_context_service_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_context_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StringResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoolResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BotInfoResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerInfoResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QQInfoResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InterworkInfoResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FormatDataPathRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterConsoleCommandRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterHandlerRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterPacketHandlerRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterBroadcastHandlerRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterHandlerResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelMessageRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WaitMessageRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WaitMessageResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerBroadcastRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerBroadcastResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_context_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SayToRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_context_service_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_context_service_proto_rawDesc), len(file_context_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1775,7 +3603,6 @@ func file_context_service_proto_init() {
 		MessageInfos:      file_context_service_proto_msgTypes,
 	}.Build()
 	File_context_service_proto = out.File
-	file_context_service_proto_rawDesc = nil
 	file_context_service_proto_goTypes = nil
 	file_context_service_proto_depIdxs = nil
 }
@@ -32,16 +32,38 @@ const (
 	ContextService_GetDataPath_FullMethodName                = "/sdk.ContextService/GetDataPath"
 	ContextService_FormatDataPath_FullMethodName             = "/sdk.ContextService/FormatDataPath"
 	ContextService_SayTo_FullMethodName                      = "/sdk.ContextService/SayTo"
+	ContextService_SendCommand_FullMethodName                = "/sdk.ContextService/SendCommand"
+	ContextService_SendWOCommand_FullMethodName              = "/sdk.ContextService/SendWOCommand"
+	ContextService_SendChat_FullMethodName                   = "/sdk.ContextService/SendChat"
+	ContextService_Title_FullMethodName                      = "/sdk.ContextService/Title"
+	ContextService_SendCommandWithResponse_FullMethodName    = "/sdk.ContextService/SendCommandWithResponse"
+	ContextService_SendCommandBatch_FullMethodName           = "/sdk.ContextService/SendCommandBatch"
+	ContextService_GetScore_FullMethodName                   = "/sdk.ContextService/GetScore"
+	ContextService_GetPos_FullMethodName                     = "/sdk.ContextService/GetPos"
+	ContextService_GetTarget_FullMethodName                  = "/sdk.ContextService/GetTarget"
+	ContextService_GetItem_FullMethodName                    = "/sdk.ContextService/GetItem"
+	ContextService_IsOp_FullMethodName                       = "/sdk.ContextService/IsOp"
+	ContextService_Tellraw_FullMethodName                    = "/sdk.ContextService/Tellraw"
+	ContextService_SetEffect_FullMethodName                  = "/sdk.ContextService/SetEffect"
+	ContextService_SendPacket_FullMethodName                 = "/sdk.ContextService/SendPacket"
+	ContextService_ListPlayers_FullMethodName                = "/sdk.ContextService/ListPlayers"
+	ContextService_GetPlayer_FullMethodName                  = "/sdk.ContextService/GetPlayer"
+	ContextService_ExportPluginAPI_FullMethodName            = "/sdk.ContextService/ExportPluginAPI"
+	ContextService_GetPluginAPIInfo_FullMethodName           = "/sdk.ContextService/GetPluginAPIInfo"
+	ContextService_ListPluginAPIs_FullMethodName             = "/sdk.ContextService/ListPluginAPIs"
+	ContextService_CallPluginAPI_FullMethodName              = "/sdk.ContextService/CallPluginAPI"
 	ContextService_RegisterConsoleCommand_FullMethodName     = "/sdk.ContextService/RegisterConsoleCommand"
 	ContextService_RegisterChatHandler_FullMethodName        = "/sdk.ContextService/RegisterChatHandler"
 	ContextService_RegisterPlayerJoinHandler_FullMethodName  = "/sdk.ContextService/RegisterPlayerJoinHandler"
 	ContextService_RegisterPlayerLeaveHandler_FullMethodName = "/sdk.ContextService/RegisterPlayerLeaveHandler"
 	ContextService_RegisterPacketHandler_FullMethodName      = "/sdk.ContextService/RegisterPacketHandler"
 	ContextService_RegisterPacketAllHandler_FullMethodName   = "/sdk.ContextService/RegisterPacketAllHandler"
+	ContextService_RegisterBytesPacketHandler_FullMethodName = "/sdk.ContextService/RegisterBytesPacketHandler"
 	ContextService_RegisterPreloadHandler_FullMethodName     = "/sdk.ContextService/RegisterPreloadHandler"
 	ContextService_RegisterActiveHandler_FullMethodName      = "/sdk.ContextService/RegisterActiveHandler"
 	ContextService_RegisterFrameExitHandler_FullMethodName   = "/sdk.ContextService/RegisterFrameExitHandler"
 	ContextService_RegisterBroadcastHandler_FullMethodName   = "/sdk.ContextService/RegisterBroadcastHandler"
+	ContextService_UnregisterHandler_FullMethodName          = "/sdk.ContextService/UnregisterHandler"
 	ContextService_CancelMessage_FullMethodName              = "/sdk.ContextService/CancelMessage"
 	ContextService_WaitMessage_FullMethodName                = "/sdk.ContextService/WaitMessage"
 	ContextService_TriggerBroadcast_FullMethodName           = "/sdk.ContextService/TriggerBroadcast"
@@ -68,6 +90,28 @@ type ContextServiceClient interface {
 	FormatDataPath(ctx context.Context, in *FormatDataPathRequest, opts ...grpc.CallOption) (*StringResponse, error)
 	// 游戏工具方法
 	SayTo(ctx context.Context, in *SayToRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	SendCommand(ctx context.Context, in *SendCommandRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	SendWOCommand(ctx context.Context, in *SendCommandRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	SendChat(ctx context.Context, in *SendChatRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	Title(ctx context.Context, in *SendChatRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	SendCommandWithResponse(ctx context.Context, in *SendCommandWithResponseRequest, opts ...grpc.CallOption) (*SendCommandWithResponseResponse, error)
+	SendCommandBatch(ctx context.Context, in *SendCommandBatchRequest, opts ...grpc.CallOption) (*SendCommandBatchResponse, error)
+	GetScore(ctx context.Context, in *GetScoreRequest, opts ...grpc.CallOption) (*GetScoreResponse, error)
+	GetPos(ctx context.Context, in *GetPosRequest, opts ...grpc.CallOption) (*GetPosResponse, error)
+	GetTarget(ctx context.Context, in *GetTargetRequest, opts ...grpc.CallOption) (*GetTargetResponse, error)
+	GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*GetItemResponse, error)
+	IsOp(ctx context.Context, in *IsOpRequest, opts ...grpc.CallOption) (*IsOpResponse, error)
+	Tellraw(ctx context.Context, in *TellrawRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	SetEffect(ctx context.Context, in *SetEffectRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	SendPacket(ctx context.Context, in *SendPacketRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	// 玩家管理
+	ListPlayers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListPlayersResponse, error)
+	GetPlayer(ctx context.Context, in *GetPlayerRequest, opts ...grpc.CallOption) (*GetPlayerResponse, error)
+	// 插件 API（跨进程方法调用）
+	ExportPluginAPI(ctx context.Context, in *ExportPluginAPIRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	GetPluginAPIInfo(ctx context.Context, in *GetPluginAPIInfoRequest, opts ...grpc.CallOption) (*GetPluginAPIInfoResponse, error)
+	ListPluginAPIs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListPluginAPIsResponse, error)
+	CallPluginAPI(ctx context.Context, in *CallPluginAPIRequest, opts ...grpc.CallOption) (*CallPluginAPIResponse, error)
 	// 控制台命令注册
 	RegisterConsoleCommand(ctx context.Context, in *RegisterConsoleCommandRequest, opts ...grpc.CallOption) (*BoolResponse, error)
 	// 事件注册（返回 callback_id）
@@ -76,10 +120,12 @@ type ContextServiceClient interface {
 	RegisterPlayerLeaveHandler(ctx context.Context, in *RegisterHandlerRequest, opts ...grpc.CallOption) (*RegisterHandlerResponse, error)
 	RegisterPacketHandler(ctx context.Context, in *RegisterPacketHandlerRequest, opts ...grpc.CallOption) (*RegisterHandlerResponse, error)
 	RegisterPacketAllHandler(ctx context.Context, in *RegisterHandlerRequest, opts ...grpc.CallOption) (*RegisterHandlerResponse, error)
+	RegisterBytesPacketHandler(ctx context.Context, in *RegisterPacketHandlerRequest, opts ...grpc.CallOption) (*RegisterHandlerResponse, error)
 	RegisterPreloadHandler(ctx context.Context, in *RegisterHandlerRequest, opts ...grpc.CallOption) (*RegisterHandlerResponse, error)
 	RegisterActiveHandler(ctx context.Context, in *RegisterHandlerRequest, opts ...grpc.CallOption) (*RegisterHandlerResponse, error)
 	RegisterFrameExitHandler(ctx context.Context, in *RegisterHandlerRequest, opts ...grpc.CallOption) (*RegisterHandlerResponse, error)
 	RegisterBroadcastHandler(ctx context.Context, in *RegisterBroadcastHandlerRequest, opts ...grpc.CallOption) (*RegisterHandlerResponse, error)
+	UnregisterHandler(ctx context.Context, in *UnregisterHandlerRequest, opts ...grpc.CallOption) (*BoolResponse, error)
 	// 消息控制
 	CancelMessage(ctx context.Context, in *CancelMessageRequest, opts ...grpc.CallOption) (*BoolResponse, error)
 	WaitMessage(ctx context.Context, in *WaitMessageRequest, opts ...grpc.CallOption) (*WaitMessageResponse, error)
@@ -212,6 +258,186 @@ func (c *contextServiceClient) SayTo(ctx context.Context, in *SayToRequest, opts
 	return out, nil
 }
 
+func (c *contextServiceClient) SendCommand(ctx context.Context, in *SendCommandRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, ContextService_SendCommand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) SendWOCommand(ctx context.Context, in *SendCommandRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, ContextService_SendWOCommand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) SendChat(ctx context.Context, in *SendChatRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, ContextService_SendChat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) Title(ctx context.Context, in *SendChatRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, ContextService_Title_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) SendCommandWithResponse(ctx context.Context, in *SendCommandWithResponseRequest, opts ...grpc.CallOption) (*SendCommandWithResponseResponse, error) {
+	out := new(SendCommandWithResponseResponse)
+	err := c.cc.Invoke(ctx, ContextService_SendCommandWithResponse_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) SendCommandBatch(ctx context.Context, in *SendCommandBatchRequest, opts ...grpc.CallOption) (*SendCommandBatchResponse, error) {
+	out := new(SendCommandBatchResponse)
+	err := c.cc.Invoke(ctx, ContextService_SendCommandBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) GetScore(ctx context.Context, in *GetScoreRequest, opts ...grpc.CallOption) (*GetScoreResponse, error) {
+	out := new(GetScoreResponse)
+	err := c.cc.Invoke(ctx, ContextService_GetScore_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) GetPos(ctx context.Context, in *GetPosRequest, opts ...grpc.CallOption) (*GetPosResponse, error) {
+	out := new(GetPosResponse)
+	err := c.cc.Invoke(ctx, ContextService_GetPos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) GetTarget(ctx context.Context, in *GetTargetRequest, opts ...grpc.CallOption) (*GetTargetResponse, error) {
+	out := new(GetTargetResponse)
+	err := c.cc.Invoke(ctx, ContextService_GetTarget_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*GetItemResponse, error) {
+	out := new(GetItemResponse)
+	err := c.cc.Invoke(ctx, ContextService_GetItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) IsOp(ctx context.Context, in *IsOpRequest, opts ...grpc.CallOption) (*IsOpResponse, error) {
+	out := new(IsOpResponse)
+	err := c.cc.Invoke(ctx, ContextService_IsOp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) Tellraw(ctx context.Context, in *TellrawRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, ContextService_Tellraw_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) SetEffect(ctx context.Context, in *SetEffectRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, ContextService_SetEffect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) SendPacket(ctx context.Context, in *SendPacketRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, ContextService_SendPacket_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) ListPlayers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListPlayersResponse, error) {
+	out := new(ListPlayersResponse)
+	err := c.cc.Invoke(ctx, ContextService_ListPlayers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) GetPlayer(ctx context.Context, in *GetPlayerRequest, opts ...grpc.CallOption) (*GetPlayerResponse, error) {
+	out := new(GetPlayerResponse)
+	err := c.cc.Invoke(ctx, ContextService_GetPlayer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) ExportPluginAPI(ctx context.Context, in *ExportPluginAPIRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, ContextService_ExportPluginAPI_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) GetPluginAPIInfo(ctx context.Context, in *GetPluginAPIInfoRequest, opts ...grpc.CallOption) (*GetPluginAPIInfoResponse, error) {
+	out := new(GetPluginAPIInfoResponse)
+	err := c.cc.Invoke(ctx, ContextService_GetPluginAPIInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) ListPluginAPIs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListPluginAPIsResponse, error) {
+	out := new(ListPluginAPIsResponse)
+	err := c.cc.Invoke(ctx, ContextService_ListPluginAPIs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contextServiceClient) CallPluginAPI(ctx context.Context, in *CallPluginAPIRequest, opts ...grpc.CallOption) (*CallPluginAPIResponse, error) {
+	out := new(CallPluginAPIResponse)
+	err := c.cc.Invoke(ctx, ContextService_CallPluginAPI_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *contextServiceClient) RegisterConsoleCommand(ctx context.Context, in *RegisterConsoleCommandRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
 	out := new(BoolResponse)
 	err := c.cc.Invoke(ctx, ContextService_RegisterConsoleCommand_FullMethodName, in, out, opts...)
@@ -266,6 +492,15 @@ func (c *contextServiceClient) RegisterPacketAllHandler(ctx context.Context, in
 	return out, nil
 }
 
+func (c *contextServiceClient) RegisterBytesPacketHandler(ctx context.Context, in *RegisterPacketHandlerRequest, opts ...grpc.CallOption) (*RegisterHandlerResponse, error) {
+	out := new(RegisterHandlerResponse)
+	err := c.cc.Invoke(ctx, ContextService_RegisterBytesPacketHandler_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *contextServiceClient) RegisterPreloadHandler(ctx context.Context, in *RegisterHandlerRequest, opts ...grpc.CallOption) (*RegisterHandlerResponse, error) {
 	out := new(RegisterHandlerResponse)
 	err := c.cc.Invoke(ctx, ContextService_RegisterPreloadHandler_FullMethodName, in, out, opts...)
@@ -302,6 +537,15 @@ func (c *contextServiceClient) RegisterBroadcastHandler(ctx context.Context, in
 	return out, nil
 }
 
+func (c *contextServiceClient) UnregisterHandler(ctx context.Context, in *UnregisterHandlerRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, ContextService_UnregisterHandler_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *contextServiceClient) CancelMessage(ctx context.Context, in *CancelMessageRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
 	out := new(BoolResponse)
 	err := c.cc.Invoke(ctx, ContextService_CancelMessage_FullMethodName, in, out, opts...)
@@ -350,6 +594,28 @@ type ContextServiceServer interface {
 	FormatDataPath(context.Context, *FormatDataPathRequest) (*StringResponse, error)
 	// 游戏工具方法
 	SayTo(context.Context, *SayToRequest) (*BoolResponse, error)
+	SendCommand(context.Context, *SendCommandRequest) (*BoolResponse, error)
+	SendWOCommand(context.Context, *SendCommandRequest) (*BoolResponse, error)
+	SendChat(context.Context, *SendChatRequest) (*BoolResponse, error)
+	Title(context.Context, *SendChatRequest) (*BoolResponse, error)
+	SendCommandWithResponse(context.Context, *SendCommandWithResponseRequest) (*SendCommandWithResponseResponse, error)
+	SendCommandBatch(context.Context, *SendCommandBatchRequest) (*SendCommandBatchResponse, error)
+	GetScore(context.Context, *GetScoreRequest) (*GetScoreResponse, error)
+	GetPos(context.Context, *GetPosRequest) (*GetPosResponse, error)
+	GetTarget(context.Context, *GetTargetRequest) (*GetTargetResponse, error)
+	GetItem(context.Context, *GetItemRequest) (*GetItemResponse, error)
+	IsOp(context.Context, *IsOpRequest) (*IsOpResponse, error)
+	Tellraw(context.Context, *TellrawRequest) (*BoolResponse, error)
+	SetEffect(context.Context, *SetEffectRequest) (*BoolResponse, error)
+	SendPacket(context.Context, *SendPacketRequest) (*BoolResponse, error)
+	// 玩家管理
+	ListPlayers(context.Context, *Empty) (*ListPlayersResponse, error)
+	GetPlayer(context.Context, *GetPlayerRequest) (*GetPlayerResponse, error)
+	// 插件 API（跨进程方法调用）
+	ExportPluginAPI(context.Context, *ExportPluginAPIRequest) (*BoolResponse, error)
+	GetPluginAPIInfo(context.Context, *GetPluginAPIInfoRequest) (*GetPluginAPIInfoResponse, error)
+	ListPluginAPIs(context.Context, *Empty) (*ListPluginAPIsResponse, error)
+	CallPluginAPI(context.Context, *CallPluginAPIRequest) (*CallPluginAPIResponse, error)
 	// 控制台命令注册
 	RegisterConsoleCommand(context.Context, *RegisterConsoleCommandRequest) (*BoolResponse, error)
 	// 事件注册（返回 callback_id）
@@ -358,10 +624,12 @@ type ContextServiceServer interface {
 	RegisterPlayerLeaveHandler(context.Context, *RegisterHandlerRequest) (*RegisterHandlerResponse, error)
 	RegisterPacketHandler(context.Context, *RegisterPacketHandlerRequest) (*RegisterHandlerResponse, error)
 	RegisterPacketAllHandler(context.Context, *RegisterHandlerRequest) (*RegisterHandlerResponse, error)
+	RegisterBytesPacketHandler(context.Context, *RegisterPacketHandlerRequest) (*RegisterHandlerResponse, error)
 	RegisterPreloadHandler(context.Context, *RegisterHandlerRequest) (*RegisterHandlerResponse, error)
 	RegisterActiveHandler(context.Context, *RegisterHandlerRequest) (*RegisterHandlerResponse, error)
 	RegisterFrameExitHandler(context.Context, *RegisterHandlerRequest) (*RegisterHandlerResponse, error)
 	RegisterBroadcastHandler(context.Context, *RegisterBroadcastHandlerRequest) (*RegisterHandlerResponse, error)
+	UnregisterHandler(context.Context, *UnregisterHandlerRequest) (*BoolResponse, error)
 	// 消息控制
 	CancelMessage(context.Context, *CancelMessageRequest) (*BoolResponse, error)
 	WaitMessage(context.Context, *WaitMessageRequest) (*WaitMessageResponse, error)
@@ -413,6 +681,66 @@ func (UnimplementedContextServiceServer) FormatDataPath(context.Context, *Format
 func (UnimplementedContextServiceServer) SayTo(context.Context, *SayToRequest) (*BoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SayTo not implemented")
 }
+func (UnimplementedContextServiceServer) SendCommand(context.Context, *SendCommandRequest) (*BoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCommand not implemented")
+}
+func (UnimplementedContextServiceServer) SendWOCommand(context.Context, *SendCommandRequest) (*BoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendWOCommand not implemented")
+}
+func (UnimplementedContextServiceServer) SendChat(context.Context, *SendChatRequest) (*BoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendChat not implemented")
+}
+func (UnimplementedContextServiceServer) Title(context.Context, *SendChatRequest) (*BoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Title not implemented")
+}
+func (UnimplementedContextServiceServer) SendCommandWithResponse(context.Context, *SendCommandWithResponseRequest) (*SendCommandWithResponseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCommandWithResponse not implemented")
+}
+func (UnimplementedContextServiceServer) SendCommandBatch(context.Context, *SendCommandBatchRequest) (*SendCommandBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCommandBatch not implemented")
+}
+func (UnimplementedContextServiceServer) GetScore(context.Context, *GetScoreRequest) (*GetScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScore not implemented")
+}
+func (UnimplementedContextServiceServer) GetPos(context.Context, *GetPosRequest) (*GetPosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPos not implemented")
+}
+func (UnimplementedContextServiceServer) GetTarget(context.Context, *GetTargetRequest) (*GetTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTarget not implemented")
+}
+func (UnimplementedContextServiceServer) GetItem(context.Context, *GetItemRequest) (*GetItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItem not implemented")
+}
+func (UnimplementedContextServiceServer) IsOp(context.Context, *IsOpRequest) (*IsOpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsOp not implemented")
+}
+func (UnimplementedContextServiceServer) Tellraw(context.Context, *TellrawRequest) (*BoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tellraw not implemented")
+}
+func (UnimplementedContextServiceServer) SetEffect(context.Context, *SetEffectRequest) (*BoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEffect not implemented")
+}
+func (UnimplementedContextServiceServer) SendPacket(context.Context, *SendPacketRequest) (*BoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPacket not implemented")
+}
+func (UnimplementedContextServiceServer) ListPlayers(context.Context, *Empty) (*ListPlayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlayers not implemented")
+}
+func (UnimplementedContextServiceServer) GetPlayer(context.Context, *GetPlayerRequest) (*GetPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayer not implemented")
+}
+func (UnimplementedContextServiceServer) ExportPluginAPI(context.Context, *ExportPluginAPIRequest) (*BoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPluginAPI not implemented")
+}
+func (UnimplementedContextServiceServer) GetPluginAPIInfo(context.Context, *GetPluginAPIInfoRequest) (*GetPluginAPIInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPluginAPIInfo not implemented")
+}
+func (UnimplementedContextServiceServer) ListPluginAPIs(context.Context, *Empty) (*ListPluginAPIsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPluginAPIs not implemented")
+}
+func (UnimplementedContextServiceServer) CallPluginAPI(context.Context, *CallPluginAPIRequest) (*CallPluginAPIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallPluginAPI not implemented")
+}
 func (UnimplementedContextServiceServer) RegisterConsoleCommand(context.Context, *RegisterConsoleCommandRequest) (*BoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterConsoleCommand not implemented")
 }
@@ -431,6 +759,9 @@ func (UnimplementedContextServiceServer) RegisterPacketHandler(context.Context,
 func (UnimplementedContextServiceServer) RegisterPacketAllHandler(context.Context, *RegisterHandlerRequest) (*RegisterHandlerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterPacketAllHandler not implemented")
 }
+func (UnimplementedContextServiceServer) RegisterBytesPacketHandler(context.Context, *RegisterPacketHandlerRequest) (*RegisterHandlerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterBytesPacketHandler not implemented")
+}
 func (UnimplementedContextServiceServer) RegisterPreloadHandler(context.Context, *RegisterHandlerRequest) (*RegisterHandlerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterPreloadHandler not implemented")
 }
@@ -443,6 +774,9 @@ func (UnimplementedContextServiceServer) RegisterFrameExitHandler(context.Contex
 func (UnimplementedContextServiceServer) RegisterBroadcastHandler(context.Context, *RegisterBroadcastHandlerRequest) (*RegisterHandlerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterBroadcastHandler not implemented")
 }
+func (UnimplementedContextServiceServer) UnregisterHandler(context.Context, *UnregisterHandlerRequest) (*BoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterHandler not implemented")
+}
 func (UnimplementedContextServiceServer) CancelMessage(context.Context, *CancelMessageRequest) (*BoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelMessage not implemented")
 }
@@ -699,6 +1033,366 @@ func _ContextService_SayTo_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ContextService_SendCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).SendCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_SendCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).SendCommand(ctx, req.(*SendCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_SendWOCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).SendWOCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_SendWOCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).SendWOCommand(ctx, req.(*SendCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_SendChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).SendChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_SendChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).SendChat(ctx, req.(*SendChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_Title_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).Title(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_Title_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).Title(ctx, req.(*SendChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_SendCommandWithResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCommandWithResponseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).SendCommandWithResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_SendCommandWithResponse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).SendCommandWithResponse(ctx, req.(*SendCommandWithResponseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_SendCommandBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCommandBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).SendCommandBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_SendCommandBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).SendCommandBatch(ctx, req.(*SendCommandBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_GetScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).GetScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_GetScore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).GetScore(ctx, req.(*GetScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_GetPos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).GetPos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_GetPos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).GetPos(ctx, req.(*GetPosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_GetTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).GetTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_GetTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).GetTarget(ctx, req.(*GetTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_GetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).GetItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_GetItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).GetItem(ctx, req.(*GetItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_IsOp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).IsOp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_IsOp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).IsOp(ctx, req.(*IsOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_Tellraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TellrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).Tellraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_Tellraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).Tellraw(ctx, req.(*TellrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_SetEffect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEffectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).SetEffect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_SetEffect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).SetEffect(ctx, req.(*SetEffectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_SendPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).SendPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_SendPacket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).SendPacket(ctx, req.(*SendPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_ListPlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).ListPlayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_ListPlayers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).ListPlayers(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_GetPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).GetPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_GetPlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).GetPlayer(ctx, req.(*GetPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_ExportPluginAPI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPluginAPIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).ExportPluginAPI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_ExportPluginAPI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).ExportPluginAPI(ctx, req.(*ExportPluginAPIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_GetPluginAPIInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPluginAPIInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).GetPluginAPIInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_GetPluginAPIInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).GetPluginAPIInfo(ctx, req.(*GetPluginAPIInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_ListPluginAPIs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).ListPluginAPIs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_ListPluginAPIs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).ListPluginAPIs(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContextService_CallPluginAPI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallPluginAPIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).CallPluginAPI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_CallPluginAPI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).CallPluginAPI(ctx, req.(*CallPluginAPIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ContextService_RegisterConsoleCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterConsoleCommandRequest)
 	if err := dec(in); err != nil {
@@ -807,6 +1501,24 @@ func _ContextService_RegisterPacketAllHandler_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ContextService_RegisterBytesPacketHandler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterPacketHandlerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).RegisterBytesPacketHandler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_RegisterBytesPacketHandler_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).RegisterBytesPacketHandler(ctx, req.(*RegisterPacketHandlerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ContextService_RegisterPreloadHandler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterHandlerRequest)
 	if err := dec(in); err != nil {
@@ -879,6 +1591,24 @@ func _ContextService_RegisterBroadcastHandler_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ContextService_UnregisterHandler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterHandlerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContextServiceServer).UnregisterHandler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContextService_UnregisterHandler_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContextServiceServer).UnregisterHandler(ctx, req.(*UnregisterHandlerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ContextService_CancelMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelMessageRequest)
 	if err := dec(in); err != nil {
@@ -992,6 +1722,86 @@ var ContextService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SayTo",
 			Handler:    _ContextService_SayTo_Handler,
 		},
+		{
+			MethodName: "SendCommand",
+			Handler:    _ContextService_SendCommand_Handler,
+		},
+		{
+			MethodName: "SendWOCommand",
+			Handler:    _ContextService_SendWOCommand_Handler,
+		},
+		{
+			MethodName: "SendChat",
+			Handler:    _ContextService_SendChat_Handler,
+		},
+		{
+			MethodName: "Title",
+			Handler:    _ContextService_Title_Handler,
+		},
+		{
+			MethodName: "SendCommandWithResponse",
+			Handler:    _ContextService_SendCommandWithResponse_Handler,
+		},
+		{
+			MethodName: "SendCommandBatch",
+			Handler:    _ContextService_SendCommandBatch_Handler,
+		},
+		{
+			MethodName: "GetScore",
+			Handler:    _ContextService_GetScore_Handler,
+		},
+		{
+			MethodName: "GetPos",
+			Handler:    _ContextService_GetPos_Handler,
+		},
+		{
+			MethodName: "GetTarget",
+			Handler:    _ContextService_GetTarget_Handler,
+		},
+		{
+			MethodName: "GetItem",
+			Handler:    _ContextService_GetItem_Handler,
+		},
+		{
+			MethodName: "IsOp",
+			Handler:    _ContextService_IsOp_Handler,
+		},
+		{
+			MethodName: "Tellraw",
+			Handler:    _ContextService_Tellraw_Handler,
+		},
+		{
+			MethodName: "SetEffect",
+			Handler:    _ContextService_SetEffect_Handler,
+		},
+		{
+			MethodName: "SendPacket",
+			Handler:    _ContextService_SendPacket_Handler,
+		},
+		{
+			MethodName: "ListPlayers",
+			Handler:    _ContextService_ListPlayers_Handler,
+		},
+		{
+			MethodName: "GetPlayer",
+			Handler:    _ContextService_GetPlayer_Handler,
+		},
+		{
+			MethodName: "ExportPluginAPI",
+			Handler:    _ContextService_ExportPluginAPI_Handler,
+		},
+		{
+			MethodName: "GetPluginAPIInfo",
+			Handler:    _ContextService_GetPluginAPIInfo_Handler,
+		},
+		{
+			MethodName: "ListPluginAPIs",
+			Handler:    _ContextService_ListPluginAPIs_Handler,
+		},
+		{
+			MethodName: "CallPluginAPI",
+			Handler:    _ContextService_CallPluginAPI_Handler,
+		},
 		{
 			MethodName: "RegisterConsoleCommand",
 			Handler:    _ContextService_RegisterConsoleCommand_Handler,
@@ -1016,6 +1826,10 @@ var ContextService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterPacketAllHandler",
 			Handler:    _ContextService_RegisterPacketAllHandler_Handler,
 		},
+		{
+			MethodName: "RegisterBytesPacketHandler",
+			Handler:    _ContextService_RegisterBytesPacketHandler_Handler,
+		},
 		{
 			MethodName: "RegisterPreloadHandler",
 			Handler:    _ContextService_RegisterPreloadHandler_Handler,
@@ -1032,6 +1846,10 @@ var ContextService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterBroadcastHandler",
 			Handler:    _ContextService_RegisterBroadcastHandler_Handler,
 		},
+		{
+			MethodName: "UnregisterHandler",
+			Handler:    _ContextService_UnregisterHandler_Handler,
+		},
 		{
 			MethodName: "CancelMessage",
 			Handler:    _ContextService_CancelMessage_Handler,
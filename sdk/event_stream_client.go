@@ -0,0 +1,352 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// eventStreamReadyTimeout 等待插件确认事件流就绪的最长时间
+const eventStreamReadyTimeout = 5 * time.Second
+
+// eventStreamRetryInterval 事件流中断后两次重新建立之间的最短间隔
+var eventStreamRetryInterval = time.Second
+
+// streamingCallbackClient 是主进程使用的回调客户端
+// 优先通过 EventStream 双向流投递事件，流不可用时回退到内嵌的单次调用客户端
+// 事件流中断后，之后的事件在重新建立事件流之前使用单次调用
+type streamingCallbackClient struct {
+	CallbackServiceClient
+
+	mu          sync.Mutex
+	conn        *eventStreamConn // 当前事件流，nil 表示不可用
+	nextSeq     uint64
+	resumable   bool      // 插件支持事件流，中断后可以重新建立
+	connecting  bool      // 正在后台重新建立事件流
+	lastAttempt time.Time // 最近一次尝试建立事件流的时间
+	closed      bool
+}
+
+// eventStreamConn 是一条已就绪的事件流
+type eventStreamConn struct {
+	stream  CallbackService_EventStreamClient
+	outbox  chan *EventEnvelope // 交给发送协程的事件
+	done    <-chan struct{}     // 事件流关闭时关闭
+	pending map[uint64]chan *EventResult
+	cancel  context.CancelFunc
+}
+
+// newStreamingCallbackClient 尝试与插件建立事件流
+// 旧版插件未实现 EventStream 时返回的客户端只使用单次调用
+func newStreamingCallbackClient(client CallbackServiceClient) *streamingCallbackClient {
+	c := &streamingCallbackClient{CallbackServiceClient: client}
+	c.lastAttempt = time.Now()
+	if conn := c.dial(); conn != nil {
+		c.conn = conn
+		c.resumable = true
+	}
+	return c
+}
+
+// dial 建立事件流并等待插件的就绪消息，失败时返回 nil
+func (c *streamingCallbackClient) dial() *eventStreamConn {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := c.CallbackServiceClient.EventStream(ctx)
+	if err != nil {
+		cancel()
+		return nil
+	}
+
+	// 插件首先回传 sequence 为 0 的就绪消息
+	timer := time.AfterFunc(eventStreamReadyTimeout, cancel)
+	ready, err := stream.Recv()
+	timer.Stop()
+	if err != nil || ready.Sequence != 0 {
+		cancel()
+		return nil
+	}
+
+	conn := &eventStreamConn{
+		stream:  stream,
+		outbox:  make(chan *EventEnvelope),
+		done:    ctx.Done(),
+		pending: make(map[uint64]chan *EventResult),
+		cancel:  cancel,
+	}
+	go c.receive(conn)
+	go c.write(ctx, conn)
+	return conn
+}
+
+// redial 在后台重新建立事件流
+func (c *streamingCallbackClient) redial() {
+	conn := c.dial()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.connecting = false
+	if conn == nil {
+		return
+	}
+	if c.closed || c.conn != nil {
+		conn.cancel()
+		return
+	}
+	c.conn = conn
+}
+
+// write 是事件流唯一的发送协程
+// 流量控制可能让 Send 长时间阻塞，调用方因此只等待到自己的超时，而不是直接调用 Send
+func (c *streamingCallbackClient) write(ctx context.Context, conn *eventStreamConn) {
+	for {
+		select {
+		case env := <-conn.outbox:
+			if err := conn.stream.Send(env); err != nil {
+				c.abort(conn)
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// receive 按 sequence 将插件回传的结果分发给等待中的调用
+func (c *streamingCallbackClient) receive(conn *eventStreamConn) {
+	for {
+		result, err := conn.stream.Recv()
+		if err != nil {
+			c.abort(conn)
+			c.mu.Lock()
+			for seq, ch := range conn.pending {
+				close(ch)
+				delete(conn.pending, seq)
+			}
+			c.mu.Unlock()
+			return
+		}
+
+		c.mu.Lock()
+		ch, ok := conn.pending[result.Sequence]
+		delete(conn.pending, result.Sequence)
+		c.mu.Unlock()
+		if ok {
+			ch <- result
+		}
+	}
+}
+
+// abort 关闭 conn，之后的事件回退到单次调用，直到事件流重新建立
+func (c *streamingCallbackClient) abort(conn *eventStreamConn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if conn != nil && c.conn == conn {
+		c.conn = nil
+		conn.cancel()
+	}
+}
+
+// forget 放弃等待 seq 的结果
+func (c *streamingCallbackClient) forget(conn *eventStreamConn, seq uint64) {
+	c.mu.Lock()
+	delete(conn.pending, seq)
+	c.mu.Unlock()
+}
+
+// current 返回当前事件流；事件流已中断时按间隔在后台重新建立，本次返回 nil
+// 调用时必须持有 c.mu
+func (c *streamingCallbackClient) current() *eventStreamConn {
+	if c.conn != nil || !c.resumable || c.closed || c.connecting {
+		return c.conn
+	}
+	if time.Since(c.lastAttempt) >= eventStreamRetryInterval {
+		c.connecting = true
+		c.lastAttempt = time.Now()
+		go c.redial()
+	}
+	return nil
+}
+
+// call 通过事件流发送事件并等待结果
+// handled 为 false 表示事件流不可用或插件的队列已满，调用方应回退到单次调用
+func (c *streamingCallbackClient) call(ctx context.Context, env *EventEnvelope) (result *EventResult, handled bool, err error) {
+	c.mu.Lock()
+	conn := c.current()
+	if conn == nil {
+		c.mu.Unlock()
+		return nil, false, nil
+	}
+	c.nextSeq++
+	env.Sequence = c.nextSeq
+	if deadline, ok := ctx.Deadline(); ok {
+		env.TimeoutMs = max(time.Until(deadline).Milliseconds(), 1)
+	}
+	ch := make(chan *EventResult, 1)
+	conn.pending[env.Sequence] = ch
+	c.mu.Unlock()
+
+	select {
+	case conn.outbox <- env:
+	case <-conn.done:
+		// 事件流在发送前已关闭
+		c.forget(conn, env.Sequence)
+		return nil, false, nil
+	case <-ctx.Done():
+		// 发送协程仍阻塞在之前的事件上，说明插件已不再读取事件流：
+		// 关闭事件流，让之后的事件回退到单次调用，而不是全部排队等待
+		c.forget(conn, env.Sequence)
+		c.abort(conn)
+		return nil, true, ctx.Err()
+	}
+
+	select {
+	case result, ok := <-ch:
+		if !ok {
+			return nil, true, errors.New("event stream closed")
+		}
+		if result.Overflow {
+			// 插件该类事件的队列已满，改用单次调用投递这个事件
+			return nil, false, nil
+		}
+		if result.Error != "" {
+			return nil, true, errors.New(result.Error)
+		}
+		return result, true, nil
+	case <-ctx.Done():
+		c.forget(conn, env.Sequence)
+		return nil, true, ctx.Err()
+	}
+}
+
+// Close 关闭事件流，之后的事件只使用单次调用
+func (c *streamingCallbackClient) Close() {
+	c.mu.Lock()
+	c.closed = true
+	conn := c.conn
+	c.mu.Unlock()
+	c.abort(conn)
+}
+
+func (c *streamingCallbackClient) OnChatEvent(ctx context.Context, in *ChatEventRequest, opts ...grpc.CallOption) (*ChatEventResponse, error) {
+	result, handled, err := c.call(ctx, &EventEnvelope{Event: &EventEnvelope_Chat{Chat: in}})
+	if !handled {
+		return c.CallbackServiceClient.OnChatEvent(ctx, in, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.GetChat(), nil
+}
+
+func (c *streamingCallbackClient) OnPlayerJoinEvent(ctx context.Context, in *PlayerEventRequest, opts ...grpc.CallOption) (*PlayerEventResponse, error) {
+	result, handled, err := c.call(ctx, &EventEnvelope{Event: &EventEnvelope_PlayerJoin{PlayerJoin: in}})
+	if !handled {
+		return c.CallbackServiceClient.OnPlayerJoinEvent(ctx, in, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.GetPlayer(), nil
+}
+
+func (c *streamingCallbackClient) OnPlayerLeaveEvent(ctx context.Context, in *PlayerEventRequest, opts ...grpc.CallOption) (*PlayerEventResponse, error) {
+	result, handled, err := c.call(ctx, &EventEnvelope{Event: &EventEnvelope_PlayerLeave{PlayerLeave: in}})
+	if !handled {
+		return c.CallbackServiceClient.OnPlayerLeaveEvent(ctx, in, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.GetPlayer(), nil
+}
+
+func (c *streamingCallbackClient) OnPacketEvent(ctx context.Context, in *PacketEventRequest, opts ...grpc.CallOption) (*PacketEventResponse, error) {
+	result, handled, err := c.call(ctx, &EventEnvelope{Event: &EventEnvelope_Packet{Packet: in}})
+	if !handled {
+		return c.CallbackServiceClient.OnPacketEvent(ctx, in, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.GetPacket(), nil
+}
+
+func (c *streamingCallbackClient) OnBytesPacketEvent(ctx context.Context, in *BytesPacketEventRequest, opts ...grpc.CallOption) (*BytesPacketEventResponse, error) {
+	result, handled, err := c.call(ctx, &EventEnvelope{Event: &EventEnvelope_BytesPacket{BytesPacket: in}})
+	if !handled {
+		return c.CallbackServiceClient.OnBytesPacketEvent(ctx, in, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.GetBytesPacket(), nil
+}
+
+func (c *streamingCallbackClient) OnPreloadEvent(ctx context.Context, in *PreloadEventRequest, opts ...grpc.CallOption) (*PreloadEventResponse, error) {
+	result, handled, err := c.call(ctx, &EventEnvelope{Event: &EventEnvelope_Preload{Preload: in}})
+	if !handled {
+		return c.CallbackServiceClient.OnPreloadEvent(ctx, in, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.GetPreload(), nil
+}
+
+func (c *streamingCallbackClient) OnActiveEvent(ctx context.Context, in *ActiveEventRequest, opts ...grpc.CallOption) (*ActiveEventResponse, error) {
+	result, handled, err := c.call(ctx, &EventEnvelope{Event: &EventEnvelope_Active{Active: in}})
+	if !handled {
+		return c.CallbackServiceClient.OnActiveEvent(ctx, in, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.GetActive(), nil
+}
+
+func (c *streamingCallbackClient) OnFrameExitEvent(ctx context.Context, in *FrameExitEventRequest, opts ...grpc.CallOption) (*FrameExitEventResponse, error) {
+	result, handled, err := c.call(ctx, &EventEnvelope{Event: &EventEnvelope_FrameExit{FrameExit: in}})
+	if !handled {
+		return c.CallbackServiceClient.OnFrameExitEvent(ctx, in, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.GetFrameExit(), nil
+}
+
+func (c *streamingCallbackClient) OnBroadcastEvent(ctx context.Context, in *BroadcastEventRequest, opts ...grpc.CallOption) (*BroadcastEventResponse, error) {
+	result, handled, err := c.call(ctx, &EventEnvelope{Event: &EventEnvelope_Broadcast{Broadcast: in}})
+	if !handled {
+		return c.CallbackServiceClient.OnBroadcastEvent(ctx, in, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.GetBroadcast(), nil
+}
+
+func (c *streamingCallbackClient) OnConsoleCommand(ctx context.Context, in *ConsoleCommandRequest, opts ...grpc.CallOption) (*ConsoleCommandResponse, error) {
+	result, handled, err := c.call(ctx, &EventEnvelope{Event: &EventEnvelope_ConsoleCommand{ConsoleCommand: in}})
+	if !handled {
+		return c.CallbackServiceClient.OnConsoleCommand(ctx, in, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.GetConsoleCommand(), nil
+}
+
+func (c *streamingCallbackClient) OnPluginAPICall(ctx context.Context, in *PluginAPICallRequest, opts ...grpc.CallOption) (*PluginAPICallResponse, error) {
+	result, handled, err := c.call(ctx, &EventEnvelope{Event: &EventEnvelope_PluginApiCall{PluginApiCall: in}})
+	if !handled {
+		return c.CallbackServiceClient.OnPluginAPICall(ctx, in, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.GetPluginApiCall(), nil
+}
@@ -0,0 +1,196 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// stalledStream 模拟不再读取事件流的插件：就绪后 Send 与 Recv 一直阻塞到流被取消
+type stalledStream struct {
+	grpc.ClientStream
+	ctx   context.Context
+	ready bool
+}
+
+func (s *stalledStream) Send(*EventEnvelope) error {
+	<-s.ctx.Done()
+	return s.ctx.Err()
+}
+
+func (s *stalledStream) Recv() (*EventResult, error) {
+	if !s.ready {
+		s.ready = true
+		return &EventResult{Sequence: 0}, nil
+	}
+	<-s.ctx.Done()
+	return nil, s.ctx.Err()
+}
+
+// stalledCallbackClient 的事件流会卡住，单次调用正常返回
+type stalledCallbackClient struct {
+	CallbackServiceClient
+}
+
+func (c stalledCallbackClient) EventStream(ctx context.Context, opts ...grpc.CallOption) (CallbackService_EventStreamClient, error) {
+	return &stalledStream{ctx: ctx}, nil
+}
+
+func (c stalledCallbackClient) OnChatEvent(ctx context.Context, in *ChatEventRequest, opts ...grpc.CallOption) (*ChatEventResponse, error) {
+	return &ChatEventResponse{Message: "unary"}, nil
+}
+
+func TestStreamingClientStalledSendFallsBack(t *testing.T) {
+	client := newStreamingCallbackClient(stalledCallbackClient{})
+	defer client.Close()
+
+	chat := func() (*ChatEventResponse, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		done := make(chan struct{})
+		var resp *ChatEventResponse
+		var err error
+		go func() {
+			resp, err = client.OnChatEvent(ctx, &ChatEventRequest{})
+			close(done)
+		}()
+		select {
+		case <-done:
+			return resp, err
+		case <-time.After(time.Second):
+			t.Fatalf("OnChatEvent blocked past its deadline")
+			return nil, nil
+		}
+	}
+
+	// 第一个事件卡在发送中，第二个事件无法交给发送协程，超时后关闭事件流
+	if _, err := chat(); err == nil {
+		t.Fatalf("first event: want timeout error")
+	}
+	if _, err := chat(); err == nil {
+		t.Fatalf("second event: want timeout error")
+	}
+	resp, err := chat()
+	if err != nil || resp.GetMessage() != "unary" {
+		t.Fatalf("after stall = %+v, %v; want unary fallback", resp, err)
+	}
+}
+
+// scriptedStream 就绪后对每个事件回传 reply 生成的结果
+type scriptedStream struct {
+	grpc.ClientStream
+	ctx     context.Context
+	reply   func(env *EventEnvelope) *EventResult
+	ready   bool
+	results chan *EventResult
+}
+
+func newScriptedStream(ctx context.Context, reply func(env *EventEnvelope) *EventResult) *scriptedStream {
+	return &scriptedStream{ctx: ctx, reply: reply, results: make(chan *EventResult, 16)}
+}
+
+func (s *scriptedStream) Send(env *EventEnvelope) error {
+	select {
+	case s.results <- s.reply(env):
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+func (s *scriptedStream) Recv() (*EventResult, error) {
+	if !s.ready {
+		s.ready = true
+		return &EventResult{Sequence: 0}, nil
+	}
+	select {
+	case result := <-s.results:
+		return result, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+// overflowCallbackClient 的事件流对所有事件回传队列已满
+type overflowCallbackClient struct {
+	stalledCallbackClient
+}
+
+func (c overflowCallbackClient) EventStream(ctx context.Context, opts ...grpc.CallOption) (CallbackService_EventStreamClient, error) {
+	return newScriptedStream(ctx, func(env *EventEnvelope) *EventResult {
+		return &EventResult{Sequence: env.Sequence, Error: "chat event queue full", Overflow: true}
+	}), nil
+}
+
+func TestStreamingClientOverflowFallsBackToUnary(t *testing.T) {
+	client := newStreamingCallbackClient(overflowCallbackClient{})
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	resp, err := client.OnChatEvent(ctx, &ChatEventRequest{})
+	if err != nil || resp.GetMessage() != "unary" {
+		t.Fatalf("overflowed event = %+v, %v; want unary delivery", resp, err)
+	}
+	client.mu.Lock()
+	streaming := client.conn != nil
+	client.mu.Unlock()
+	if !streaming {
+		t.Fatalf("overflow closed the event stream")
+	}
+}
+
+// recoveringCallbackClient 的第一条事件流会卡住，之后建立的事件流正常工作
+type recoveringCallbackClient struct {
+	stalledCallbackClient
+	streams *int
+}
+
+func (c recoveringCallbackClient) EventStream(ctx context.Context, opts ...grpc.CallOption) (CallbackService_EventStreamClient, error) {
+	*c.streams++
+	if *c.streams == 1 {
+		return &stalledStream{ctx: ctx}, nil
+	}
+	return newScriptedStream(ctx, func(env *EventEnvelope) *EventResult {
+		return &EventResult{Sequence: env.Sequence, Result: &EventResult_Chat{Chat: &ChatEventResponse{Message: "stream"}}}
+	}), nil
+}
+
+func TestStreamingClientReconnectsAfterAbort(t *testing.T) {
+	defer func(interval time.Duration) { eventStreamRetryInterval = interval }(eventStreamRetryInterval)
+	eventStreamRetryInterval = 10 * time.Millisecond
+
+	streams := 0
+	client := newStreamingCallbackClient(recoveringCallbackClient{streams: &streams})
+	defer client.Close()
+
+	chat := func(timeout time.Duration) (*ChatEventResponse, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		return client.OnChatEvent(ctx, &ChatEventRequest{})
+	}
+
+	// 第一个事件卡在发送中，第二个事件超时后关闭事件流
+	chat(20 * time.Millisecond)
+	chat(20 * time.Millisecond)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		resp, err := chat(time.Second)
+		if err != nil {
+			t.Fatalf("OnChatEvent: %v", err)
+		}
+		if resp.GetMessage() == "stream" {
+			break
+		}
+		if resp.GetMessage() != "unary" {
+			t.Fatalf("OnChatEvent = %+v, want unary fallback or stream", resp)
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("event stream was not re-established")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -0,0 +1,159 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// eventStreamQueueSize 每个有序事件队列的长度
+// 队列满时该事件以 overflow 结果退回，由主进程改用单次调用投递；事件流仍继续读取，其他类别的事件不受影响
+const eventStreamQueueSize = 64
+
+// EventStream 在一条双向流上接收主进程的所有事件回调
+// 同一类事件按到达顺序串行处理；广播、控制台命令与 API 调用可能相互嵌套触发，因此并发处理
+func (s *CallbackServerImpl) EventStream(stream CallbackService_EventStreamServer) error {
+	var sendMu sync.Mutex
+	send := func(result *EventResult) error {
+		sendMu.Lock()
+		defer sendMu.Unlock()
+		return stream.Send(result)
+	}
+
+	// 通知主进程事件流已就绪
+	if err := send(&EventResult{Sequence: 0}); err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	queues := make(map[string]chan *EventEnvelope)
+	defer func() {
+		for _, queue := range queues {
+			close(queue)
+		}
+	}()
+
+	for {
+		env, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		key := eventOrderKey(env)
+		if key == "" {
+			go send(s.dispatchEnvelope(ctx, env))
+			continue
+		}
+
+		queue, ok := queues[key]
+		if !ok {
+			queue = make(chan *EventEnvelope, eventStreamQueueSize)
+			queues[key] = queue
+			go func() {
+				for env := range queue {
+					send(s.dispatchEnvelope(ctx, env))
+				}
+			}()
+		}
+
+		select {
+		case queue <- env:
+		default:
+			// 处理器阻塞导致队列已满：不能等待，否则停止读取事件流会拖住所有类别的事件
+			// 退回该事件，由主进程改用单次调用投递
+			go send(&EventResult{
+				Sequence: env.Sequence,
+				Error:    fmt.Sprintf("%s event queue full (%d pending), handler is not keeping up", key, eventStreamQueueSize),
+				Overflow: true,
+			})
+		}
+	}
+}
+
+// eventOrderKey 返回事件所属的有序队列，空字符串表示无需保序
+func eventOrderKey(env *EventEnvelope) string {
+	switch env.Event.(type) {
+	case *EventEnvelope_Chat:
+		return "chat"
+	case *EventEnvelope_PlayerJoin, *EventEnvelope_PlayerLeave:
+		return "player"
+	case *EventEnvelope_Packet, *EventEnvelope_BytesPacket:
+		return "packet"
+	case *EventEnvelope_Preload, *EventEnvelope_Active, *EventEnvelope_FrameExit:
+		return "lifecycle"
+	default:
+		return ""
+	}
+}
+
+// dispatchEnvelope 将事件交给对应的单次调用实现，并把结果包装为 EventResult
+// 处理器的 context 使用主进程在事件中携带的超时
+func (s *CallbackServerImpl) dispatchEnvelope(ctx context.Context, env *EventEnvelope) *EventResult {
+	result := &EventResult{Sequence: env.Sequence}
+	if env.TimeoutMs > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(env.TimeoutMs)*time.Millisecond)
+		defer cancel()
+	}
+
+	var err error
+	switch ev := env.Event.(type) {
+	case *EventEnvelope_Chat:
+		var resp *ChatEventResponse
+		resp, err = s.OnChatEvent(ctx, ev.Chat)
+		result.Result = &EventResult_Chat{Chat: resp}
+	case *EventEnvelope_PlayerJoin:
+		var resp *PlayerEventResponse
+		resp, err = s.OnPlayerJoinEvent(ctx, ev.PlayerJoin)
+		result.Result = &EventResult_Player{Player: resp}
+	case *EventEnvelope_PlayerLeave:
+		var resp *PlayerEventResponse
+		resp, err = s.OnPlayerLeaveEvent(ctx, ev.PlayerLeave)
+		result.Result = &EventResult_Player{Player: resp}
+	case *EventEnvelope_Packet:
+		var resp *PacketEventResponse
+		resp, err = s.OnPacketEvent(ctx, ev.Packet)
+		result.Result = &EventResult_Packet{Packet: resp}
+	case *EventEnvelope_BytesPacket:
+		var resp *BytesPacketEventResponse
+		resp, err = s.OnBytesPacketEvent(ctx, ev.BytesPacket)
+		result.Result = &EventResult_BytesPacket{BytesPacket: resp}
+	case *EventEnvelope_Preload:
+		var resp *PreloadEventResponse
+		resp, err = s.OnPreloadEvent(ctx, ev.Preload)
+		result.Result = &EventResult_Preload{Preload: resp}
+	case *EventEnvelope_Active:
+		var resp *ActiveEventResponse
+		resp, err = s.OnActiveEvent(ctx, ev.Active)
+		result.Result = &EventResult_Active{Active: resp}
+	case *EventEnvelope_FrameExit:
+		var resp *FrameExitEventResponse
+		resp, err = s.OnFrameExitEvent(ctx, ev.FrameExit)
+		result.Result = &EventResult_FrameExit{FrameExit: resp}
+	case *EventEnvelope_Broadcast:
+		var resp *BroadcastEventResponse
+		resp, err = s.OnBroadcastEvent(ctx, ev.Broadcast)
+		result.Result = &EventResult_Broadcast{Broadcast: resp}
+	case *EventEnvelope_ConsoleCommand:
+		var resp *ConsoleCommandResponse
+		resp, err = s.OnConsoleCommand(ctx, ev.ConsoleCommand)
+		result.Result = &EventResult_ConsoleCommand{ConsoleCommand: resp}
+	case *EventEnvelope_PluginApiCall:
+		var resp *PluginAPICallResponse
+		resp, err = s.OnPluginAPICall(ctx, ev.PluginApiCall)
+		result.Result = &EventResult_PluginApiCall{PluginApiCall: resp}
+	default:
+		err = fmt.Errorf("unknown event type %T", env.Event)
+	}
+
+	if err != nil {
+		result.Result = nil
+		result.Error = err.Error()
+	}
+	return result
+}
@@ -0,0 +1,85 @@
+package sdk
+
+import "time"
+
+// GameInterface 是主进程提供给 GameUtils 的游戏接口
+// 主进程实现该接口即可在编译期检查方法签名；
+// 仍在使用旧版游戏接口对象的主进程可以通过 LegacyGameInterface 包装后传入
+//
+// 示例:
+//
+//	var _ sdk.GameInterface = (*HostGame)(nil)
+//	utils := sdk.NewGameUtils(&HostGame{})
+type GameInterface interface {
+	// Commands 返回命令发送器
+	Commands() CommandSender
+	// Querytarget 返回目标查询器
+	Querytarget() TargetQuerier
+	// SendPacket 发送游戏网络数据包
+	SendPacket(packetID uint32, packet interface{}) error
+}
+
+// CommandSender 负责向游戏发送命令与消息
+type CommandSender interface {
+	// SendWSCommand 以玩家身份发送命令，不等待响应
+	SendWSCommand(cmd string) error
+	// SendSettings 通过 Settings 通道发送高权限控制台命令
+	SendSettings(cmd string) error
+	// SendChat 让机器人在聊天栏发言
+	SendChat(message string) error
+	// Title 以 actionbar 形式向所有玩家显示消息
+	Title(message string) error
+	// SendWSCommandWithResp 发送命令并等待响应
+	SendWSCommandWithResp(cmd string) (CommandOutput, error)
+	// SendWSCommandWithTimeout 发送命令并在 timeout 内等待响应，第二个返回值表示是否超时
+	SendWSCommandWithTimeout(cmd string, timeout time.Duration) (CommandOutput, bool, error)
+}
+
+// CommandBatchSender 是 CommandSender 可选实现的接口，在命令通道上流水线发送多条命令
+// 实现应先连续发送全部命令再等待响应，按 CommandOrigin.RequestID 把响应对应回各条命令（可借助 CommandPipeline）；
+// 返回的结果与 cmds 顺序一致，每条命令在 timeout 内未收到响应时标记为超时。
+// 未实现该接口时，GameUtils.SendCommandBatch 改为并发调用 SendWSCommandWithTimeout
+type CommandBatchSender interface {
+	SendWSCommandBatch(cmds []string, timeout time.Duration) []CommandBatchOutput
+}
+
+// CommandBatchOutput 是 CommandBatchSender 返回的一条命令的输出
+type CommandBatchOutput struct {
+	Output   CommandOutput // 命令输出，超时或出错时为 nil
+	TimedOut bool          // 是否超时
+	Err      error         // 发送错误
+}
+
+// CommandOutput 是命令执行后游戏返回的输出
+type CommandOutput interface {
+	// GetSuccessCount 返回命令成功执行的次数，0 表示执行失败
+	GetSuccessCount() uint32
+	// GetOutputMessages 返回命令的输出消息
+	GetOutputMessages() []CommandOutputMessage
+}
+
+// CommandOutputDetails 是 CommandOutput 可选实现的接口，提供命令来源与附加数据
+type CommandOutputDetails interface {
+	CommandOutput
+	GetCommandOrigin() CommandOrigin
+	GetDataSet() string
+}
+
+// CommandOutputMessage 是命令输出中的一条消息
+type CommandOutputMessage struct {
+	Success    bool     // 该消息是否表示成功
+	MessageID  string   `json:"Message"` // 消息的翻译键（如 "commands.scoreboard.players.test.success"）
+	Parameters []string // 翻译参数
+}
+
+// TargetQuerier 负责查询目标选择器匹配的实体
+type TargetQuerier interface {
+	// DoQuerytarget 返回 target 匹配的所有实体
+	DoQuerytarget(target string) ([]QueryTargetResult, error)
+}
+
+// QueryTargetResult 是一个被目标选择器匹配到的实体
+type QueryTargetResult struct {
+	EntityName string   // 实体名称，玩家为玩家名
+	Position   Position // 坐标、维度与视角
+}
@@ -0,0 +1,347 @@
+package sdk
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// LegacyGameInterface 通过反射把旧版主进程的游戏接口对象（如 *game_interface.GameInterface）包装为 GameInterface
+// 方法签名不匹配的问题只能在运行时以错误的形式发现，新的主进程应直接实现 GameInterface
+// gi 已经实现 GameInterface 时原样返回，gi 为 nil 时返回 nil
+//
+// 示例:
+//
+//	utils := sdk.NewGameUtils(sdk.LegacyGameInterface(gameInterface))
+func LegacyGameInterface(gi interface{}) GameInterface {
+	if typed, ok := gi.(GameInterface); ok {
+		return typed
+	}
+	v := reflect.ValueOf(gi)
+	if !v.IsValid() || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return nil
+	}
+	return &legacyGame{v: v}
+}
+
+// legacyGame 通过反射调用旧版游戏接口对象
+type legacyGame struct {
+	v reflect.Value
+}
+
+func (g *legacyGame) Commands() CommandSender {
+	results, err := callLegacy(g.v, "gameInterface", "Commands", 1)
+	if err == nil && !validResult(results[0]) {
+		err = fmt.Errorf("Commands 返回值无效")
+	}
+	if err != nil {
+		return &legacyCommands{err: err}
+	}
+	return &legacyCommands{v: results[0]}
+}
+
+func (g *legacyGame) Querytarget() TargetQuerier {
+	results, err := callLegacy(g.v, "gameInterface", "Querytarget", 1)
+	if err == nil && !validResult(results[0]) {
+		err = fmt.Errorf("Querytarget 返回值无效")
+	}
+	if err != nil {
+		return &legacyQuerytarget{err: err}
+	}
+	return &legacyQuerytarget{v: results[0]}
+}
+
+func (g *legacyGame) SendPacket(packetID uint32, packet interface{}) error {
+	results, err := callLegacy(g.v, "gameInterface", "SendPacket", -1, packetID, packet)
+	if err != nil {
+		return err
+	}
+	if len(results) > 0 {
+		return errorResult(results[0])
+	}
+	return nil
+}
+
+// legacyCommands 通过反射调用旧版命令发送器
+// 获取命令发送器失败时，所有方法都返回 err
+type legacyCommands struct {
+	v   reflect.Value
+	err error
+}
+
+func (c *legacyCommands) send(method string, arg string) error {
+	if c.err != nil {
+		return c.err
+	}
+	results, err := callLegacy(c.v, "Commands", method, 1, arg)
+	if err != nil {
+		return err
+	}
+	return errorResult(results[0])
+}
+
+func (c *legacyCommands) SendWSCommand(cmd string) error {
+	return c.send("SendWSCommand", cmd)
+}
+
+// SendSettings 在旧版主进程不支持 Settings 通道时回退到 SendWSCommand
+func (c *legacyCommands) SendSettings(cmd string) error {
+	if c.err != nil {
+		return c.err
+	}
+	if !c.v.MethodByName("SendSettings").IsValid() {
+		return c.SendWSCommand(cmd)
+	}
+	results, err := callLegacy(c.v, "Commands", "SendSettings", -1, cmd)
+	if err != nil {
+		return err
+	}
+	if len(results) > 0 {
+		return errorResult(results[0])
+	}
+	return nil
+}
+
+func (c *legacyCommands) SendChat(message string) error {
+	return c.send("SendChat", message)
+}
+
+func (c *legacyCommands) Title(message string) error {
+	return c.send("Title", message)
+}
+
+func (c *legacyCommands) SendWSCommandWithResp(cmd string) (CommandOutput, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	results, err := callLegacy(c.v, "Commands", "SendWSCommandWithResp", 2, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return newLegacyCommandOutput(results[0]), errorResult(results[1])
+}
+
+func (c *legacyCommands) SendWSCommandWithTimeout(cmd string, timeout time.Duration) (CommandOutput, bool, error) {
+	if c.err != nil {
+		return nil, false, c.err
+	}
+	results, err := callLegacy(c.v, "Commands", "SendWSCommandWithTimeout", 3, cmd, timeout)
+	if err != nil {
+		return nil, false, err
+	}
+	timedOut := results[1].Kind() == reflect.Bool && results[1].Bool()
+	return newLegacyCommandOutput(results[0]), timedOut, errorResult(results[2])
+}
+
+// legacyCommandOutput 通过字段名读取旧版命令输出（SuccessCount、OutputMessages、CommandOrigin、DataSet）
+type legacyCommandOutput struct {
+	v reflect.Value // 已解引用的输出结构体
+}
+
+// newLegacyCommandOutput 包装旧版命令输出，输出为 nil 时返回 nil
+func newLegacyCommandOutput(v reflect.Value) CommandOutput {
+	v = indirect(v)
+	if !v.IsValid() || v.Kind() != reflect.Struct {
+		return nil
+	}
+	return &legacyCommandOutput{v: v}
+}
+
+func (o *legacyCommandOutput) GetSuccessCount() uint32 {
+	return uint32(intField(o.v, "SuccessCount"))
+}
+
+func (o *legacyCommandOutput) GetOutputMessages() []CommandOutputMessage {
+	msgs := o.v.FieldByName("OutputMessages")
+	if !msgs.IsValid() || msgs.Kind() != reflect.Slice {
+		return nil
+	}
+	result := make([]CommandOutputMessage, 0, msgs.Len())
+	for i := 0; i < msgs.Len(); i++ {
+		msg := indirect(msgs.Index(i))
+		if !msg.IsValid() || msg.Kind() != reflect.Struct {
+			continue
+		}
+		converted := CommandOutputMessage{
+			MessageID: stringField(msg, "Message"),
+		}
+		if success := msg.FieldByName("Success"); success.IsValid() && success.Kind() == reflect.Bool {
+			converted.Success = success.Bool()
+		}
+		if params := msg.FieldByName("Parameters"); params.IsValid() && params.Kind() == reflect.Slice {
+			for j := 0; j < params.Len(); j++ {
+				if param := params.Index(j); param.Kind() == reflect.String {
+					converted.Parameters = append(converted.Parameters, param.String())
+				}
+			}
+		}
+		result = append(result, converted)
+	}
+	return result
+}
+
+func (o *legacyCommandOutput) GetCommandOrigin() CommandOrigin {
+	origin := indirect(o.v.FieldByName("CommandOrigin"))
+	if !origin.IsValid() || origin.Kind() != reflect.Struct {
+		return CommandOrigin{}
+	}
+	result := CommandOrigin{
+		Origin:         uint32(intField(origin, "Origin")),
+		RequestID:      stringField(origin, "RequestID"),
+		PlayerUniqueID: intField(origin, "PlayerUniqueID"),
+	}
+	if id := origin.FieldByName("UUID"); id.IsValid() && id.CanInterface() {
+		if stringer, ok := id.Interface().(fmt.Stringer); ok {
+			result.UUID = stringer.String()
+		} else if id.Kind() == reflect.String {
+			result.UUID = id.String()
+		}
+	}
+	return result
+}
+
+func (o *legacyCommandOutput) GetDataSet() string {
+	return stringField(o.v, "DataSet")
+}
+
+// legacyQuerytarget 通过反射调用旧版目标查询器
+type legacyQuerytarget struct {
+	v   reflect.Value
+	err error
+}
+
+func (q *legacyQuerytarget) DoQuerytarget(target string) ([]QueryTargetResult, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	results, err := callLegacy(q.v, "Querytarget", "DoQuerytarget", 2, target)
+	if err != nil {
+		return nil, err
+	}
+	if err := errorResult(results[1]); err != nil {
+		return nil, err
+	}
+	if results[0].Kind() != reflect.Slice {
+		return []QueryTargetResult{}, nil
+	}
+
+	items := make([]QueryTargetResult, 0, results[0].Len())
+	for i := 0; i < results[0].Len(); i++ {
+		item := indirect(results[0].Index(i))
+		if !item.IsValid() || item.Kind() != reflect.Struct {
+			continue
+		}
+		result := QueryTargetResult{EntityName: stringField(item, "EntityName")}
+		if pos := indirect(item.FieldByName("Position")); pos.IsValid() && pos.Kind() == reflect.Struct {
+			result.Position.X = float32(floatField(pos, "X"))
+			result.Position.Y = float32(floatField(pos, "Y"))
+			result.Position.Z = float32(floatField(pos, "Z"))
+		}
+		result.Position.Dimension = uint8(intField(item, "Dimension"))
+		result.Position.YRot = float32(floatField(item, "YRot"))
+		items = append(items, result)
+	}
+	return items, nil
+}
+
+// callLegacy 通过反射调用 v 的 method 方法
+// want 为期望的返回值数量，-1 表示不检查
+func callLegacy(v reflect.Value, owner, method string, want int, args ...interface{}) ([]reflect.Value, error) {
+	m := v.MethodByName(method)
+	if !m.IsValid() {
+		return nil, fmt.Errorf("%s 不支持 %s 方法", owner, method)
+	}
+	if m.Type().NumIn() != len(args) {
+		return nil, fmt.Errorf("%s 参数数量不正确", method)
+	}
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		paramType := m.Type().In(i)
+		argVal := reflect.ValueOf(arg)
+		switch {
+		case !argVal.IsValid():
+			argVal = reflect.Zero(paramType)
+		case argVal.Kind() == paramType.Kind() && argVal.Type().ConvertibleTo(paramType):
+			argVal = argVal.Convert(paramType)
+		case !argVal.Type().AssignableTo(paramType):
+			return nil, fmt.Errorf("%s 参数类型不正确: 需要 %s", method, paramType)
+		}
+		in[i] = argVal
+	}
+	results := m.Call(in)
+	if want >= 0 && len(results) != want {
+		return nil, fmt.Errorf("%s 返回值数量不正确", method)
+	}
+	return results, nil
+}
+
+// validResult 判断反射调用的返回值是否可用
+func validResult(v reflect.Value) bool {
+	if !v.IsValid() {
+		return false
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return !v.IsNil()
+	}
+	return true
+}
+
+// errorResult 把 error 类型的返回值转换为 error
+func errorResult(v reflect.Value) error {
+	if !validResult(v) {
+		return nil
+	}
+	if err, ok := v.Interface().(error); ok {
+		return err
+	}
+	return nil
+}
+
+// indirect 解开指针与接口，nil 时返回无效值
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func stringField(v reflect.Value, name string) string {
+	if f := v.FieldByName(name); f.IsValid() && f.Kind() == reflect.String {
+		return f.String()
+	}
+	return ""
+}
+
+func intField(v reflect.Value, name string) int64 {
+	f := v.FieldByName(name)
+	if !f.IsValid() {
+		return 0
+	}
+	switch {
+	case f.CanInt():
+		return f.Int()
+	case f.CanUint():
+		return int64(f.Uint())
+	}
+	return 0
+}
+
+func floatField(v reflect.Value, name string) float64 {
+	f := v.FieldByName(name)
+	if !f.IsValid() {
+		return 0
+	}
+	switch {
+	case f.CanFloat():
+		return f.Float()
+	case f.CanInt():
+		return float64(f.Int())
+	case f.CanUint():
+		return float64(f.Uint())
+	}
+	return 0
+}
@@ -0,0 +1,125 @@
+package sdk
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// 以下类型模仿旧版主进程的游戏接口：方法返回具体类型，输出通过字段读取
+
+type legacyTestOutputMessage struct {
+	Success    bool
+	Message    string
+	Parameters []string
+}
+
+type legacyTestOutput struct {
+	SuccessCount   uint32
+	OutputMessages []legacyTestOutputMessage
+}
+
+type legacyTestCommands struct {
+	sent []string
+}
+
+func (c *legacyTestCommands) SendWSCommand(cmd string) error {
+	c.sent = append(c.sent, cmd)
+	return nil
+}
+
+func (c *legacyTestCommands) SendChat(msg string) error { return nil }
+
+func (c *legacyTestCommands) Title(msg string) error { return nil }
+
+func (c *legacyTestCommands) SendWSCommandWithResp(cmd string) (*legacyTestOutput, error) {
+	c.sent = append(c.sent, cmd)
+	return &legacyTestOutput{SuccessCount: 3}, nil
+}
+
+func (c *legacyTestCommands) SendWSCommandWithTimeout(cmd string, timeout time.Duration) (*legacyTestOutput, bool, error) {
+	c.sent = append(c.sent, cmd)
+	if strings.Contains(cmd, "missing") {
+		return nil, false, errors.New("no such objective")
+	}
+	return &legacyTestOutput{
+		SuccessCount:   1,
+		OutputMessages: []legacyTestOutputMessage{{Success: true, Parameters: []string{"42", "money"}}},
+	}, false, nil
+}
+
+type legacyTestTarget struct {
+	EntityName string
+	Position   struct{ X, Y, Z float32 }
+	Dimension  uint8
+	YRot       float32
+}
+
+type legacyTestQuerytarget struct{}
+
+func (legacyTestQuerytarget) DoQuerytarget(target string) ([]*legacyTestTarget, error) {
+	result := &legacyTestTarget{EntityName: "Steve", Dimension: 2, YRot: 45}
+	result.Position.X, result.Position.Y, result.Position.Z = 1, 2, 3
+	return []*legacyTestTarget{result}, nil
+}
+
+type legacyTestGame struct {
+	commands *legacyTestCommands
+}
+
+func (g *legacyTestGame) Commands() *legacyTestCommands { return g.commands }
+
+func (g *legacyTestGame) Querytarget() legacyTestQuerytarget { return legacyTestQuerytarget{} }
+
+func TestLegacyGameInterface(t *testing.T) {
+	commands := &legacyTestCommands{}
+	utils := NewGameUtils(LegacyGameInterface(&legacyTestGame{commands: commands}))
+
+	if score, err := utils.GetScore("money", "Steve", 1); err != nil || score != 42 {
+		t.Fatalf("GetScore = %d, %v; want 42", score, err)
+	}
+	if _, err := utils.GetScore("money", "missing", 1); err == nil {
+		t.Fatalf("GetScore of missing target: want error")
+	}
+	if count, err := utils.GetItem("Steve", "minecraft:apple", -1); err != nil || count != 3 {
+		t.Fatalf("GetItem = %d, %v; want 3", count, err)
+	}
+	if pos, err := utils.GetPos("Steve"); err != nil || *pos != (Position{X: 1, Y: 2, Z: 3, Dimension: 2, YRot: 45}) {
+		t.Fatalf("GetPos = %+v, %v", pos, err)
+	}
+
+	// 不支持 SendSettings 时回退到 SendWSCommand
+	if err := utils.SendWOCommand("list"); err != nil {
+		t.Fatalf("SendWOCommand: %v", err)
+	}
+	if last := commands.sent[len(commands.sent)-1]; last != "list" {
+		t.Fatalf("last command = %q, want list", last)
+	}
+
+	result, _, err := utils.SendCommandWithResponse("testfor @a", 1)
+	if err != nil {
+		t.Fatalf("SendCommandWithResponse: %v", err)
+	}
+	if !result.Succeeded() || !reflect.DeepEqual(result.Parameters(), []string{"42", "money"}) {
+		t.Fatalf("result = %+v", result)
+	}
+
+	if err := utils.SendPacket(1, nil); err == nil || !strings.Contains(err.Error(), "SendPacket") {
+		t.Fatalf("SendPacket on legacy host without SendPacket: err = %v", err)
+	}
+}
+
+func TestLegacyGameInterfacePassthrough(t *testing.T) {
+	if LegacyGameInterface(nil) != nil {
+		t.Fatalf("LegacyGameInterface(nil) should be nil")
+	}
+	var game *legacyTestGame
+	if LegacyGameInterface(game) != nil {
+		t.Fatalf("LegacyGameInterface(nil pointer) should be nil")
+	}
+	if err := NewGameUtils(nil).SendCommand("say hi"); err == nil {
+		t.Fatalf("SendCommand without game interface: want error")
+	}
+}
@@ -1,9 +1,8 @@
 package sdk
 
 import (
-	"encoding/json"
 	"fmt"
-	"reflect"
+	"sync/atomic"
 	"time"
 )
 
@@ -16,84 +15,106 @@ type Position struct {
 	YRot      float32
 }
 
-// InventorySlot 表示背包中的一个物品槽位
-type InventorySlot struct {
-	Slot     int    // 槽位编号
-	ItemID   string // 物品 ID (如 "minecraft:diamond")
-	ItemName string // 物品名称
-	Count    int    // 物品数量
-	Aux      int    // 物品附加值（如工具耐久度）
-}
-
 // GameUtils 提供高级游戏交互接口，类似 ToolDelta 的 game_utils
 type GameUtils struct {
-	gi        interface{} // 存储 *game_interface.GameInterface
-	sayToFunc func(player, message string) // gRPC 代理函数
+	gi        GameInterface                // 主进程的游戏接口
+	remote    gameUtilsBackend             // gRPC 代理后端（跨平台插件）
+	inventory *InventoryTracker            // 背包跟踪器（GetInventory 使用）
+	world     *World                       // 方块查询器（GetBlock / GetBlocks 使用）
+	queue     atomic.Pointer[CommandQueue] // 命令队列（EnableCommandQueue 启用）
+}
+
+// gameUtilsBackend 是跨进程插件使用的 GameUtils 后端
+// 设置后相应方法不再访问 gi，而是转发到主进程执行
+type gameUtilsBackend interface {
+	SayTo(target, text string) error
+	SendCommand(cmd string) error
+	SendWOCommand(cmd string) error
+	SendChat(message string) error
+	Title(message string) error
+	SendCommandWithResponse(cmd string, timeout float64) (*CommandResult, bool, error)
+	SendCommandBatch(cmds []string, timeout float64) ([]CommandBatchResult, error)
+	GetScore(scbName, target string, timeout float64) (int, error)
+	GetPos(target string) (*Position, error)
+	GetTarget(target string, timeout float64) ([]string, error)
+	GetItem(target, itemName string, itemSpecialID int) (int, error)
+	IsOp(playerName string) (bool, error)
+	Tellraw(selector, message string) error
+	SetEffect(target string, effectID int, opts EffectOptions) error
+	SendPacket(packetID uint32, packet interface{}) error
 }
 
 // NewGameUtils 创建 GameUtils 实例
-func NewGameUtils(gi interface{}) *GameUtils {
+// 旧版游戏接口对象需先经 LegacyGameInterface 包装
+func NewGameUtils(gi GameInterface) *GameUtils {
 	return &GameUtils{gi: gi}
 }
 
-// GetTarget 获取匹配目标选择器的玩家名称列表
-// target: 目标选择器（如 "@a", "@p", "PlayerName"）
-// timeout: 超时时间（秒），默认 5 秒
-func (g *GameUtils) GetTarget(target string, timeout float64) ([]string, error) {
-	if timeout <= 0 {
-		timeout = 5.0
-	}
+// SetInventoryTracker 设置 GetInventory 使用的背包跟踪器
+// 通常由 Context.Inventory 自动设置
+func (g *GameUtils) SetInventoryTracker(tracker *InventoryTracker) {
+	g.inventory = tracker
+}
 
-	// 使用反射调用 gi.Querytarget().DoQuerytarget(target)
-	giVal := reflect.ValueOf(g.gi)
-	if !giVal.IsValid() || giVal.IsNil() {
+// SetWorld 设置 GetBlock / GetBlockState / GetBlocks 使用的方块查询器
+// 通常由 Context.World 自动设置
+func (g *GameUtils) SetWorld(world *World) {
+	g.world = world
+}
+
+// commands 返回主进程的命令发送器
+func (g *GameUtils) commands() (CommandSender, error) {
+	if g.gi == nil {
 		return nil, fmt.Errorf("gameInterface 未初始化")
 	}
-
-	querytargetMethod := giVal.MethodByName("Querytarget")
-	if !querytargetMethod.IsValid() {
-		return nil, fmt.Errorf("gameInterface 不支持 Querytarget 方法")
+	commands := g.gi.Commands()
+	if commands == nil {
+		return nil, fmt.Errorf("Commands 返回值无效")
 	}
+	return commands, nil
+}
 
-	querytargetVal := querytargetMethod.Call(nil)
-	if len(querytargetVal) == 0 || !querytargetVal[0].IsValid() {
+// querytarget 返回主进程的目标查询器
+func (g *GameUtils) querytarget() (TargetQuerier, error) {
+	if g.gi == nil {
+		return nil, fmt.Errorf("gameInterface 未初始化")
+	}
+	querier := g.gi.Querytarget()
+	if querier == nil {
 		return nil, fmt.Errorf("Querytarget 返回值无效")
 	}
+	return querier, nil
+}
 
-	doQueryMethod := querytargetVal[0].MethodByName("DoQuerytarget")
-	if !doQueryMethod.IsValid() {
-		return nil, fmt.Errorf("Querytarget 不支持 DoQuerytarget 方法")
+// GetTarget 获取匹配目标选择器的玩家名称列表
+// target: 目标选择器（如 "@a", "@p", "PlayerName" 或 sdk.AllPlayers().Tag("vip")）
+// timeout: 超时时间（秒），默认 5 秒
+func (g *GameUtils) GetTarget(target Target, timeout float64) ([]string, error) {
+	formatted, err := FormatTarget(target)
+	if err != nil {
+		return nil, err
 	}
-
-	results := doQueryMethod.Call([]reflect.Value{reflect.ValueOf(target)})
-	if len(results) != 2 {
-		return nil, fmt.Errorf("DoQuerytarget 返回值数量不正确")
+	if timeout <= 0 {
+		timeout = 5.0
+	}
+	if g.remote != nil {
+		return g.remote.GetTarget(formatted, timeout)
 	}
 
-	if !results[1].IsNil() {
-		return nil, fmt.Errorf("查询目标失败: %v", results[1].Interface())
+	querier, err := g.querytarget()
+	if err != nil {
+		return nil, err
 	}
 
-	// 解析返回的切片
-	if results[0].Kind() != reflect.Slice {
-		return []string{}, nil
+	results, err := querier.DoQuerytarget(formatted)
+	if err != nil {
+		return nil, fmt.Errorf("查询目标失败: %v", err)
 	}
 
 	names := []string{}
-	for i := 0; i < results[0].Len(); i++ {
-		item := results[0].Index(i)
-		// 如果是指针，需要解引用
-		if item.Kind() == reflect.Ptr {
-			item = item.Elem()
-		}
-
-		// 提取 EntityName 字段
-		nameField := item.FieldByName("EntityName")
-		if nameField.IsValid() && nameField.Kind() == reflect.String {
-			name := nameField.String()
-			if name != "" {
-				names = append(names, name)
-			}
+	for _, result := range results {
+		if result.EntityName != "" {
+			names = append(names, result.EntityName)
 		}
 	}
 
@@ -103,72 +124,37 @@ func (g *GameUtils) GetTarget(target string, timeout float64) ([]string, error)
 // GetPos 获取玩家的详细坐标信息
 // target: 目标玩家名称或选择器
 // 返回: Position 包含坐标、维度、视角等信息
-func (g *GameUtils) GetPos(target string) (*Position, error) {
-	giVal := reflect.ValueOf(g.gi)
-	if !giVal.IsValid() || giVal.IsNil() {
-		return nil, fmt.Errorf("gameInterface 未初始化")
-	}
-
-	querytargetMethod := giVal.MethodByName("Querytarget")
-	if !querytargetMethod.IsValid() {
-		return nil, fmt.Errorf("gameInterface 不支持 Querytarget 方法")
-	}
-
-	querytargetVal := querytargetMethod.Call(nil)
-	if len(querytargetVal) == 0 || !querytargetVal[0].IsValid() {
-		return nil, fmt.Errorf("Querytarget 返回值无效")
+func (g *GameUtils) GetPos(target Target) (*Position, error) {
+	formatted, err := FormatTarget(target)
+	if err != nil {
+		return nil, err
 	}
-
-	doQueryMethod := querytargetVal[0].MethodByName("DoQuerytarget")
-	if !doQueryMethod.IsValid() {
-		return nil, fmt.Errorf("Querytarget 不支持 DoQuerytarget 方法")
+	if g.remote != nil {
+		return g.remote.GetPos(formatted)
 	}
 
-	results := doQueryMethod.Call([]reflect.Value{reflect.ValueOf(target)})
-	if len(results) != 2 {
-		return nil, fmt.Errorf("DoQuerytarget 返回值数量不正确")
+	querier, err := g.querytarget()
+	if err != nil {
+		return nil, err
 	}
 
-	if !results[1].IsNil() {
-		return nil, fmt.Errorf("查询玩家坐标失败: %v", results[1].Interface())
+	results, err := querier.DoQuerytarget(formatted)
+	if err != nil {
+		return nil, fmt.Errorf("查询玩家坐标失败: %v", err)
 	}
 
-	if results[0].Kind() != reflect.Slice || results[0].Len() == 0 {
+	if len(results) == 0 {
 		return nil, fmt.Errorf("玩家不存在或不在线")
 	}
 
-	// 解析第一个结果
-	firstResult := results[0].Index(0)
-	// 如果是指针，需要解引用
-	if firstResult.Kind() == reflect.Ptr {
-		firstResult = firstResult.Elem()
-	}
-
-	// 提取 Position 字段
-	posField := firstResult.FieldByName("Position")
-	if !posField.IsValid() {
-		return nil, fmt.Errorf("无法提取 Position 字段")
-	}
-
-	x := float32(posField.FieldByName("X").Float())
-	y := float32(posField.FieldByName("Y").Float())
-	z := float32(posField.FieldByName("Z").Float())
-	dimension := uint8(firstResult.FieldByName("Dimension").Uint())
-	yRot := float32(firstResult.FieldByName("YRot").Float())
-
-	return &Position{
-		X:         x,
-		Y:         y,
-		Z:         z,
-		Dimension: dimension,
-		YRot:      yRot,
-	}, nil
+	pos := results[0].Position
+	return &pos, nil
 }
 
 // GetPosXYZ 获取玩家的简单坐标值
 // target: 目标玩家名称或选择器
 // 返回: (x, y, z) 坐标元组
-func (g *GameUtils) GetPosXYZ(target string) (float32, float32, float32, error) {
+func (g *GameUtils) GetPosXYZ(target Target) (float32, float32, float32, error) {
 	pos, err := g.GetPos(target)
 	if err != nil {
 		return 0, 0, 0, err
@@ -180,131 +166,75 @@ func (g *GameUtils) GetPosXYZ(target string) (float32, float32, float32, error)
 // target: 目标玩家名称或选择器
 // itemName: 物品的 Minecraft ID（如 "minecraft:diamond"）
 // itemSpecialID: 物品特殊 ID（默认 -1 表示忽略）
-func (g *GameUtils) GetItem(target, itemName string, itemSpecialID int) (int, error) {
-	giVal := reflect.ValueOf(g.gi)
-	if !giVal.IsValid() || giVal.IsNil() {
-		return 0, fmt.Errorf("gameInterface 未初始化")
+func (g *GameUtils) GetItem(target Target, itemName string, itemSpecialID int) (int, error) {
+	formatted, err := FormatTarget(target)
+	if err != nil {
+		return 0, err
 	}
-
-	commandsMethod := giVal.MethodByName("Commands")
-	if !commandsMethod.IsValid() {
-		return 0, fmt.Errorf("gameInterface 不支持 Commands 方法")
+	if g.remote != nil {
+		return g.remote.GetItem(formatted, itemName, itemSpecialID)
 	}
 
-	commandsVal := commandsMethod.Call(nil)
-	if len(commandsVal) == 0 || !commandsVal[0].IsValid() {
-		return 0, fmt.Errorf("Commands 返回值无效")
+	commands, err := g.commands()
+	if err != nil {
+		return 0, err
 	}
 
 	// 使用 clear 命令的测试模式来统计物品数量
-	cmd := fmt.Sprintf("clear %s %s %d 0", target, itemName, itemSpecialID)
-	sendMethod := commandsVal[0].MethodByName("SendWSCommandWithResp")
-	if !sendMethod.IsValid() {
-		return 0, fmt.Errorf("Commands 不支持 SendWSCommandWithResp 方法")
-	}
-
-	results := sendMethod.Call([]reflect.Value{reflect.ValueOf(cmd)})
-	if len(results) != 2 {
-		return 0, fmt.Errorf("SendWSCommandWithResp 返回值数量不正确")
+	cmd, err := NewCommand("clear").Target(formatted).Identifier(itemName).Int(itemSpecialID).Int(0).Build()
+	if err != nil {
+		return 0, err
 	}
-
-	if !results[1].IsNil() {
-		return 0, fmt.Errorf("查询物品数量失败: %v", results[1].Interface())
+	output, err := commands.SendWSCommandWithResp(cmd)
+	if err != nil {
+		return 0, fmt.Errorf("查询物品数量失败: %v", err)
 	}
 
-	// 从 CommandOutput 提取 SuccessCount
-	output := results[0]
-	if output.Kind() == reflect.Ptr {
-		output = output.Elem()
-	}
-	successCountField := output.FieldByName("SuccessCount")
-	var successCount int64
-	if successCountField.Kind() == reflect.Uint32 || successCountField.Kind() == reflect.Uint {
-		successCount = int64(successCountField.Uint())
-	} else {
-		successCount = successCountField.Int()
+	result := ParseCommandOutput(output)
+	if result == nil {
+		return 0, nil
 	}
-
-	return int(successCount), nil
+	return int(result.SuccessCount), nil
 }
 
 // GetScore 获取计分板中目标的分数
 // scbName: 计分板名称
 // target: 目标名称
 // timeout: 超时时间（秒），默认 30 秒
-func (g *GameUtils) GetScore(scbName, target string, timeout float64) (int, error) {
+func (g *GameUtils) GetScore(scbName string, target Target, timeout float64) (int, error) {
+	formatted, err := FormatTarget(target)
+	if err != nil {
+		return 0, err
+	}
 	if timeout <= 0 {
 		timeout = 30.0
 	}
-
-	giVal := reflect.ValueOf(g.gi)
-	if !giVal.IsValid() || giVal.IsNil() {
-		return 0, fmt.Errorf("gameInterface 未初始化")
-	}
-
-	commandsMethod := giVal.MethodByName("Commands")
-	if !commandsMethod.IsValid() {
-		return 0, fmt.Errorf("gameInterface 不支持 Commands 方法")
+	if g.remote != nil {
+		return g.remote.GetScore(scbName, formatted, timeout)
 	}
 
-	commandsVal := commandsMethod.Call(nil)
-	if len(commandsVal) == 0 || !commandsVal[0].IsValid() {
-		return 0, fmt.Errorf("Commands 返回值无效")
+	commands, err := g.commands()
+	if err != nil {
+		return 0, err
 	}
 
 	// 使用 scoreboard players test 命令获取分数
-	cmd := fmt.Sprintf("scoreboard players test %s %s * *", target, scbName)
-	timeoutDuration := time.Duration(timeout * float64(time.Second))
-
-	sendMethod := commandsVal[0].MethodByName("SendWSCommandWithTimeout")
-	if !sendMethod.IsValid() {
-		return 0, fmt.Errorf("Commands 不支持 SendWSCommandWithTimeout 方法")
-	}
-
-	results := sendMethod.Call([]reflect.Value{
-		reflect.ValueOf(cmd),
-		reflect.ValueOf(timeoutDuration),
-	})
-	if len(results) != 3 {
-		return 0, fmt.Errorf("SendWSCommandWithTimeout 返回值数量不正确")
+	cmd, err := NewCommand("scoreboard players test").Target(formatted).Name(scbName).Keyword("* *").Build()
+	if err != nil {
+		return 0, err
 	}
+	timeoutDuration := time.Duration(timeout * float64(time.Second))
 
-	// 检查是否超时
-	if results[1].Bool() {
+	output, timedOut, err := commands.SendWSCommandWithTimeout(cmd, timeoutDuration)
+	if timedOut {
 		return 0, fmt.Errorf("获取分数超时")
 	}
-
-	if !results[2].IsNil() {
-		return 0, fmt.Errorf("计分板或目标不存在: %v", results[2].Interface())
-	}
-
-	// 从 CommandOutput.OutputMessages[0].Parameters[0] 提取分数
-	output := results[0]
-	if output.Kind() == reflect.Ptr {
-		output = output.Elem()
-	}
-	outputMsgs := output.FieldByName("OutputMessages")
-	if outputMsgs.Len() == 0 {
-		return 0, fmt.Errorf("无法获取分数")
-	}
-
-	firstMsg := outputMsgs.Index(0)
-	if firstMsg.Kind() == reflect.Ptr {
-		firstMsg = firstMsg.Elem()
-	}
-	parameters := firstMsg.FieldByName("Parameters")
-	if parameters.Len() == 0 {
-		return 0, fmt.Errorf("无法获取分数参数")
-	}
-
-	// 通常分数在第一个参数中
-	scoreStr := parameters.Index(0).String()
-	var score int
-	if _, err := fmt.Sscanf(scoreStr, "%d", &score); err != nil {
-		return 0, fmt.Errorf("解析分数失败: %w", err)
+	if err != nil {
+		return 0, fmt.Errorf("计分板或目标不存在: %v", err)
 	}
 
-	return score, nil
+	// 从 OutputMessages[0].Parameters[0] 提取分数
+	return parseScore(ParseCommandOutput(output))
 }
 
 // IsCmdSuccess 检查命令是否执行成功
@@ -315,68 +245,38 @@ func (g *GameUtils) IsCmdSuccess(cmd string, timeout float64) (bool, error) {
 		timeout = 30.0
 	}
 
-	giVal := reflect.ValueOf(g.gi)
-	if !giVal.IsValid() || giVal.IsNil() {
-		return false, fmt.Errorf("gameInterface 未初始化")
-	}
-
-	commandsMethod := giVal.MethodByName("Commands")
-	if !commandsMethod.IsValid() {
-		return false, fmt.Errorf("gameInterface 不支持 Commands 方法")
-	}
-
-	commandsVal := commandsMethod.Call(nil)
-	if len(commandsVal) == 0 || !commandsVal[0].IsValid() {
-		return false, fmt.Errorf("Commands 返回值无效")
-	}
-
-	timeoutDuration := time.Duration(timeout * float64(time.Second))
-
-	sendMethod := commandsVal[0].MethodByName("SendWSCommandWithTimeout")
-	if !sendMethod.IsValid() {
-		return false, fmt.Errorf("Commands 不支持 SendWSCommandWithTimeout 方法")
-	}
-
-	results := sendMethod.Call([]reflect.Value{
-		reflect.ValueOf(cmd),
-		reflect.ValueOf(timeoutDuration),
-	})
-	if len(results) != 3 {
-		return false, fmt.Errorf("SendWSCommandWithTimeout 返回值数量不正确")
-	}
+	result, timedOut, err := g.SendCommandWithResponse(cmd, timeout)
 
 	// 检查是否超时
-	if results[1].Bool() {
+	if timedOut {
 		return false, fmt.Errorf("命令执行超时")
 	}
 
 	// 检查是否有错误
-	if !results[2].IsNil() {
+	if err != nil {
 		return false, nil
 	}
 
-	// 检查 SuccessCount
-	output := results[0]
-	if output.Kind() == reflect.Ptr {
-		output = output.Elem()
-	}
-	successCountField := output.FieldByName("SuccessCount")
-	var successCount int64
-	if successCountField.Kind() == reflect.Uint32 || successCountField.Kind() == reflect.Uint {
-		successCount = int64(successCountField.Uint())
-	} else {
-		successCount = successCountField.Int()
-	}
-
-	return successCount > 0, nil
+	return result.Succeeded(), nil
 }
 
 // IsOp 检查玩家是否拥有管理员权限
-// playerName: 玩家名称
-func (g *GameUtils) IsOp(playerName string) (bool, error) {
+// target: 玩家名称或选择器
+func (g *GameUtils) IsOp(target Target) (bool, error) {
+	formatted, err := FormatTarget(target)
+	if err != nil {
+		return false, err
+	}
+	if g.remote != nil {
+		return g.remote.IsOp(formatted)
+	}
+
 	// 尝试执行一个需要 OP 权限的命令来判断
 	// 使用 tag 命令测试权限（需要 OP 才能操作）
-	testCmd := fmt.Sprintf("tag \"%s\" list", playerName)
+	testCmd, err := NewCommand("tag").Target(formatted).Keyword("list").Build()
+	if err != nil {
+		return false, err
+	}
 	success, err := g.IsCmdSuccess(testCmd, 5.0)
 	if err != nil {
 		return false, fmt.Errorf("检查管理员权限失败: %w", err)
@@ -388,36 +288,13 @@ func (g *GameUtils) IsOp(playerName string) (bool, error) {
 // TakeItemOutItemFrame 从展示框中取出物品
 // x, y, z: 展示框的坐标
 func (g *GameUtils) TakeItemOutItemFrame(x, y, z int) error {
-	giVal := reflect.ValueOf(g.gi)
-	if !giVal.IsValid() || giVal.IsNil() {
-		return fmt.Errorf("gameInterface 未初始化")
-	}
-
-	commandsMethod := giVal.MethodByName("Commands")
-	if !commandsMethod.IsValid() {
-		return fmt.Errorf("gameInterface 不支持 Commands 方法")
-	}
-
-	commandsVal := commandsMethod.Call(nil)
-	if len(commandsVal) == 0 || !commandsVal[0].IsValid() {
-		return fmt.Errorf("Commands 返回值无效")
-	}
-
 	// 使用 kill 命令移除展示框中的物品实体
-	cmd := fmt.Sprintf("kill @e[type=item_frame,x=%d,y=%d,z=%d,r=1]", x, y, z)
-
-	sendMethod := commandsVal[0].MethodByName("SendWSCommandWithResp")
-	if !sendMethod.IsValid() {
-		return fmt.Errorf("Commands 不支持 SendWSCommandWithResp 方法")
+	cmd, err := NewCommand("kill").Target(fmt.Sprintf("@e[type=item_frame,x=%d,y=%d,z=%d,r=1]", x, y, z)).Build()
+	if err != nil {
+		return err
 	}
-
-	results := sendMethod.Call([]reflect.Value{reflect.ValueOf(cmd)})
-	if len(results) != 2 {
-		return fmt.Errorf("SendWSCommandWithResp 返回值数量不正确")
-	}
-
-	if !results[1].IsNil() {
-		return fmt.Errorf("移除展示框物品失败: %v", results[1].Interface())
+	if _, _, err := g.SendCommandWithResponse(cmd); err != nil {
+		return fmt.Errorf("移除展示框物品失败: %v", err)
 	}
 
 	return nil
@@ -425,34 +302,27 @@ func (g *GameUtils) TakeItemOutItemFrame(x, y, z int) error {
 
 // SendCommand 发送游戏命令（封装常用命令发送功能）
 // cmd: Minecraft 命令
+// 启用命令队列（EnableCommandQueue）时排队限速发送，入队后即返回
 func (g *GameUtils) SendCommand(cmd string) error {
-	giVal := reflect.ValueOf(g.gi)
-	if !giVal.IsValid() || giVal.IsNil() {
-		return fmt.Errorf("gameInterface 未初始化")
-	}
-
-	commandsMethod := giVal.MethodByName("Commands")
-	if !commandsMethod.IsValid() {
-		return fmt.Errorf("gameInterface 不支持 Commands 方法")
-	}
-
-	commandsVal := commandsMethod.Call(nil)
-	if len(commandsVal) == 0 || !commandsVal[0].IsValid() {
-		return fmt.Errorf("Commands 返回值无效")
+	if queue := g.queue.Load(); queue != nil {
+		return queue.Enqueue(CommandChannelWS, cmd, CommandPriorityNormal)
 	}
+	return g.sendWSCommand(cmd)
+}
 
-	sendMethod := commandsVal[0].MethodByName("SendWSCommand")
-	if !sendMethod.IsValid() {
-		return fmt.Errorf("Commands 不支持 SendWSCommand 方法")
+// sendWSCommand 不经过命令队列直接发送游戏命令
+func (g *GameUtils) sendWSCommand(cmd string) error {
+	if g.remote != nil {
+		return g.remote.SendCommand(cmd)
 	}
 
-	results := sendMethod.Call([]reflect.Value{reflect.ValueOf(cmd)})
-	if len(results) != 1 {
-		return fmt.Errorf("SendWSCommand 返回值数量不正确")
+	commands, err := g.commands()
+	if err != nil {
+		return err
 	}
 
-	if !results[0].IsNil() {
-		return fmt.Errorf("发送命令失败: %v", results[0].Interface())
+	if err := commands.SendWSCommand(cmd); err != nil {
+		return fmt.Errorf("发送命令失败: %v", err)
 	}
 
 	return nil
@@ -461,33 +331,25 @@ func (g *GameUtils) SendCommand(cmd string) error {
 // SendChat 让机器人在聊天栏发言
 // message: 聊天消息内容
 func (g *GameUtils) SendChat(message string) error {
-	giVal := reflect.ValueOf(g.gi)
-	if !giVal.IsValid() || giVal.IsNil() {
-		return fmt.Errorf("gameInterface 未初始化")
-	}
-
-	commandsMethod := giVal.MethodByName("Commands")
-	if !commandsMethod.IsValid() {
-		return fmt.Errorf("gameInterface 不支持 Commands 方法")
-	}
-
-	commandsVal := commandsMethod.Call(nil)
-	if len(commandsVal) == 0 || !commandsVal[0].IsValid() {
-		return fmt.Errorf("Commands 返回值无效")
+	if queue := g.queue.Load(); queue != nil {
+		return queue.Enqueue(CommandChannelPlayer, message, CommandPriorityNormal)
 	}
+	return g.sendChat(message)
+}
 
-	sendChatMethod := commandsVal[0].MethodByName("SendChat")
-	if !sendChatMethod.IsValid() {
-		return fmt.Errorf("Commands 不支持 SendChat 方法")
+// sendChat 不经过命令队列直接发送聊天消息
+func (g *GameUtils) sendChat(message string) error {
+	if g.remote != nil {
+		return g.remote.SendChat(message)
 	}
 
-	results := sendChatMethod.Call([]reflect.Value{reflect.ValueOf(message)})
-	if len(results) != 1 {
-		return fmt.Errorf("SendChat 返回值数量不正确")
+	commands, err := g.commands()
+	if err != nil {
+		return err
 	}
 
-	if !results[0].IsNil() {
-		return fmt.Errorf("发送聊天消息失败: %v", results[0].Interface())
+	if err := commands.SendChat(message); err != nil {
+		return fmt.Errorf("发送聊天消息失败: %v", err)
 	}
 
 	return nil
@@ -496,108 +358,99 @@ func (g *GameUtils) SendChat(message string) error {
 // Title 以 actionbar 形式向所有玩家显示消息
 // message: 要显示的消息
 func (g *GameUtils) Title(message string) error {
-	giVal := reflect.ValueOf(g.gi)
-	if !giVal.IsValid() || giVal.IsNil() {
-		return fmt.Errorf("gameInterface 未初始化")
-	}
-
-	commandsMethod := giVal.MethodByName("Commands")
-	if !commandsMethod.IsValid() {
-		return fmt.Errorf("gameInterface 不支持 Commands 方法")
-	}
-
-	commandsVal := commandsMethod.Call(nil)
-	if len(commandsVal) == 0 || !commandsVal[0].IsValid() {
-		return fmt.Errorf("Commands 返回值无效")
+	if queue := g.queue.Load(); queue != nil {
+		// 与同内容的聊天消息区分开，避免被合并
+		return queue.enqueue(CommandChannelPlayer, message, queue.autoKey("title\x00"+message), CommandPriorityNormal, func() error {
+			return g.sendTitle(message)
+		})
 	}
+	return g.sendTitle(message)
+}
 
-	titleMethod := commandsVal[0].MethodByName("Title")
-	if !titleMethod.IsValid() {
-		return fmt.Errorf("Commands 不支持 Title 方法")
+// sendTitle 不经过命令队列直接显示 actionbar 消息
+func (g *GameUtils) sendTitle(message string) error {
+	if g.remote != nil {
+		return g.remote.Title(message)
 	}
 
-	results := titleMethod.Call([]reflect.Value{reflect.ValueOf(message)})
-	if len(results) != 1 {
-		return fmt.Errorf("Title 返回值数量不正确")
+	commands, err := g.commands()
+	if err != nil {
+		return err
 	}
 
-	if !results[0].IsNil() {
-		return fmt.Errorf("显示标题失败: %v", results[0].Interface())
+	if err := commands.Title(message); err != nil {
+		return fmt.Errorf("显示标题失败: %v", err)
 	}
 
 	return nil
 }
 
 // Tellraw 使用 tellraw 命令向指定玩家发送 JSON 格式消息
-// selector: 玩家选择器（如 "@a", "@p", "PlayerName"）
-// message: 消息内容（会自动包装为 rawtext 格式）
-func (g *GameUtils) Tellraw(selector, message string) error {
-	payload := map[string]interface{}{
-		"rawtext": []map[string]string{
-			{"text": message},
-		},
+// selector: 玩家选择器（如 "@a", "@p", "PlayerName" 或 *Selector）
+// message: 消息内容（会自动包装为 rawtext 格式，需要翻译、分数等组件时使用 TellrawComponent）
+func (g *GameUtils) Tellraw(selector Target, message string) error {
+	formatted, err := FormatTarget(selector)
+	if err != nil {
+		return err
 	}
-	jsonBytes, err := json.Marshal(payload)
+	cmd, err := tellrawText(formatted, message)
 	if err != nil {
-		return fmt.Errorf("构造 tellraw 消息失败: %w", err)
+		return err
 	}
+	return g.sendBuiltCommand(cmd, func() error {
+		return g.remote.Tellraw(formatted, message)
+	})
+}
 
-	cmd := fmt.Sprintf("tellraw %s %s", selector, string(jsonBytes))
-	return g.SendCommand(cmd)
+// tellrawText 构造向 target 发送纯文本消息的 tellraw 命令
+func tellrawText(target, message string) (string, error) {
+	cmd, err := NewCommand("tellraw").Target(target).JSON(NewRawText(PlainText(message))).Build()
+	if err != nil {
+		return "", fmt.Errorf("构造 tellraw 消息失败: %w", err)
+	}
+	return cmd, nil
+}
+
+// sendBuiltCommand 发送已构造好的命令，与 SendCommand 一样在启用命令队列时排队
+// 跨进程插件改为调用 remote，由主进程执行对应的方法；排队同样发生在插件进程中
+func (g *GameUtils) sendBuiltCommand(cmd string, remote func() error) error {
+	if g.remote == nil {
+		return g.SendCommand(cmd)
+	}
+	if queue := g.queue.Load(); queue != nil {
+		return queue.enqueue(CommandChannelWS, cmd, queue.autoKey(cmd), CommandPriorityNormal, remote)
+	}
+	return remote()
 }
 
 // SendCommandWithResponse 发送命令并等待响应
 // cmd: Minecraft 命令
 // timeout: 超时时间（秒），默认 30 秒
-// 返回: 命令输出结果、是否超时、错误
+// 返回: 命令执行结果（没有输出时为 nil）、是否超时、错误
 //
 // 示例:
-//   output, timedOut, err := utils.SendCommandWithResponse("testfor @a", 10.0)
-//   if err == nil && !timedOut {
-//       // 处理输出
+//   result, timedOut, err := utils.SendCommandWithResponse("testfor @a", 10.0)
+//   if err == nil && !timedOut && result.Succeeded() {
+//       ctx.LogInfo("%s", result.Render(nil))
 //   }
-func (g *GameUtils) SendCommandWithResponse(cmd string, timeout ...float64) (interface{}, bool, error) {
+func (g *GameUtils) SendCommandWithResponse(cmd string, timeout ...float64) (*CommandResult, bool, error) {
 	t := 30.0
-	if len(timeout) > 0 {
+	if len(timeout) > 0 && timeout[0] > 0 {
 		t = timeout[0]
 	}
 
-	giVal := reflect.ValueOf(g.gi)
-	if !giVal.IsValid() || giVal.IsNil() {
-		return nil, false, fmt.Errorf("gameInterface 未初始化")
-	}
-
-	commandsMethod := giVal.MethodByName("Commands")
-	if !commandsMethod.IsValid() {
-		return nil, false, fmt.Errorf("gameInterface 不支持 Commands 方法")
+	if g.remote != nil {
+		return g.remote.SendCommandWithResponse(cmd, t)
 	}
 
-	commandsVal := commandsMethod.Call(nil)
-	if len(commandsVal) == 0 || !commandsVal[0].IsValid() {
-		return nil, false, fmt.Errorf("Commands 返回值无效")
-	}
-
-	sendMethod := commandsVal[0].MethodByName("SendWSCommandWithTimeout")
-	if !sendMethod.IsValid() {
-		return nil, false, fmt.Errorf("Commands 不支持 SendWSCommandWithTimeout 方法")
+	commands, err := g.commands()
+	if err != nil {
+		return nil, false, err
 	}
 
 	timeoutDuration := time.Duration(t * float64(time.Second))
-	results := sendMethod.Call([]reflect.Value{
-		reflect.ValueOf(cmd),
-		reflect.ValueOf(timeoutDuration),
-	})
-	if len(results) != 3 {
-		return nil, false, fmt.Errorf("SendWSCommandWithTimeout 返回值数量不正确")
-	}
-
-	timedOut := results[1].Bool()
-	var err error
-	if !results[2].IsNil() {
-		err = results[2].Interface().(error)
-	}
-
-	return results[0].Interface(), timedOut, err
+	output, timedOut, err := commands.SendWSCommandWithTimeout(cmd, timeoutDuration)
+	return ParseCommandOutput(output), timedOut, err
 }
 
 // SayTo 向指定目标发送聊天消息（使用 tellraw）
@@ -607,14 +460,18 @@ func (g *GameUtils) SendCommandWithResponse(cmd string, timeout ...float64) (int
 // 示例:
 //   utils.SayTo("@a", "欢迎来到服务器！")
 //   utils.SayTo("Steve", "你好！")
-func (g *GameUtils) SayTo(target, text string) error {
-	// 优先使用 gRPC 代理函数（跨平台插件）
-	if g.sayToFunc != nil {
-		g.sayToFunc(target, text)
-		return nil
+func (g *GameUtils) SayTo(target Target, text string) error {
+	formatted, err := FormatTarget(target)
+	if err != nil {
+		return err
+	}
+	cmd, err := tellrawText(formatted, text)
+	if err != nil {
+		return err
 	}
-	// 传统方式（本地插件）
-	return g.Tellraw(target, text)
+	return g.sendBuiltCommand(cmd, func() error {
+		return g.remote.SayTo(formatted, text)
+	})
 }
 
 // PlayerTitle 向指定玩家显示标题
@@ -623,8 +480,11 @@ func (g *GameUtils) SayTo(target, text string) error {
 //
 // 示例:
 //   utils.PlayerTitle("@a", "游戏开始")
-func (g *GameUtils) PlayerTitle(target, text string) error {
-	cmd := fmt.Sprintf("title %s title %s", target, text)
+func (g *GameUtils) PlayerTitle(target Target, text string) error {
+	cmd, err := NewCommand("title").Target(target).Keyword("title").Message(text).Build()
+	if err != nil {
+		return err
+	}
 	return g.SendCommand(cmd)
 }
 
@@ -634,8 +494,11 @@ func (g *GameUtils) PlayerTitle(target, text string) error {
 //
 // 示例:
 //   utils.PlayerSubtitle("@a", "Good Luck")
-func (g *GameUtils) PlayerSubtitle(target, text string) error {
-	cmd := fmt.Sprintf("title %s subtitle %s", target, text)
+func (g *GameUtils) PlayerSubtitle(target Target, text string) error {
+	cmd, err := NewCommand("title").Target(target).Keyword("subtitle").Message(text).Build()
+	if err != nil {
+		return err
+	}
 	return g.SendCommand(cmd)
 }
 
@@ -645,8 +508,11 @@ func (g *GameUtils) PlayerSubtitle(target, text string) error {
 //
 // 示例:
 //   utils.PlayerActionbar("@a", "当前血量: 20/20")
-func (g *GameUtils) PlayerActionbar(target, text string) error {
-	cmd := fmt.Sprintf("title %s actionbar %s", target, text)
+func (g *GameUtils) PlayerActionbar(target Target, text string) error {
+	cmd, err := NewCommand("title").Target(target).Keyword("actionbar").Message(text).Build()
+	if err != nil {
+		return err
+	}
 	return g.SendCommand(cmd)
 }
 
@@ -658,31 +524,25 @@ func (g *GameUtils) PlayerActionbar(target, text string) error {
 // 示例:
 //   utils.SendWOCommand("list")
 func (g *GameUtils) SendWOCommand(cmd string) error {
-	giVal := reflect.ValueOf(g.gi)
-	if !giVal.IsValid() || giVal.IsNil() {
-		return fmt.Errorf("gameInterface 未初始化")
-	}
-
-	commandsMethod := giVal.MethodByName("Commands")
-	if !commandsMethod.IsValid() {
-		return fmt.Errorf("gameInterface 不支持 Commands 方法")
+	if queue := g.queue.Load(); queue != nil {
+		return queue.Enqueue(CommandChannelWO, cmd, CommandPriorityNormal)
 	}
+	return g.sendWOCommand(cmd)
+}
 
-	commandsVal := commandsMethod.Call(nil)
-	if len(commandsVal) == 0 || !commandsVal[0].IsValid() {
-		return fmt.Errorf("Commands 返回值无效")
+// sendWOCommand 不经过命令队列直接发送控制台命令
+func (g *GameUtils) sendWOCommand(cmd string) error {
+	if g.remote != nil {
+		return g.remote.SendWOCommand(cmd)
 	}
 
-	// 尝试调用 SendSettings 方法
-	sendMethod := commandsVal[0].MethodByName("SendSettings")
-	if !sendMethod.IsValid() {
-		// 如果不支持，回退到普通命令
-		return g.SendCommand(cmd)
+	commands, err := g.commands()
+	if err != nil {
+		return err
 	}
 
-	results := sendMethod.Call([]reflect.Value{reflect.ValueOf(cmd)})
-	if len(results) > 0 && !results[0].IsNil() {
-		return fmt.Errorf("发送控制台命令失败: %v", results[0].Interface())
+	if err := commands.SendSettings(cmd); err != nil {
+		return fmt.Errorf("发送控制台命令失败: %v", err)
 	}
 
 	return nil
@@ -699,102 +559,124 @@ func (g *GameUtils) SendWOCommand(cmd string) error {
 //       "text": "Hello",
 //   })
 func (g *GameUtils) SendPacket(packetID uint32, packet interface{}) error {
-	giVal := reflect.ValueOf(g.gi)
-	if !giVal.IsValid() || giVal.IsNil() {
-		return fmt.Errorf("gameInterface 未初始化")
+	if g.remote != nil {
+		return g.remote.SendPacket(packetID, packet)
 	}
 
-	// 尝试调用 SendPacket 方法
-	sendMethod := giVal.MethodByName("SendPacket")
-	if !sendMethod.IsValid() {
-		return fmt.Errorf("gameInterface 不支持 SendPacket 方法")
+	if g.gi == nil {
+		return fmt.Errorf("gameInterface 未初始化")
 	}
 
-	results := sendMethod.Call([]reflect.Value{
-		reflect.ValueOf(packetID),
-		reflect.ValueOf(packet),
-	})
-	if len(results) > 0 && !results[0].IsNil() {
-		return fmt.Errorf("发送数据包失败: %v", results[0].Interface())
+	if err := g.gi.SendPacket(packetID, packet); err != nil {
+		return fmt.Errorf("发送数据包失败: %v", err)
 	}
 
 	return nil
 }
 
-// GetInventory 查询玩家背包信息
-// selector: 目标玩家名称或选择器
-// 返回: 背包槽位列表，包含物品 ID、数量等信息
+// GetInventory 查询机器人的背包信息
+// selector: 为空或 "@s" 时查询机器人自身的主背包
+// 返回: 非空槽位列表，包含物品 ID、数量、附魔等信息
 //
-// 注意: 这个方法需要服务器支持背包查询命令或数据包
+// 注意: 背包数据来自 InventoryContent / InventorySlot 数据包，需要先调用 ctx.Inventory() 开始跟踪；
+// 服务器不会向机器人同步其他玩家的背包，查询其他玩家的物品数量请使用 GetItem
 //
 // 示例:
-//   slots, err := ctx.GameUtils().GetInventory("Steve")
+//   ctx.Inventory()
+//   slots, err := ctx.GameUtils().GetInventory("@s")
 //   if err == nil {
 //       for _, slot := range slots {
 //           ctx.Logf("槽位 %d: %s x%d", slot.Slot, slot.ItemID, slot.Count)
 //       }
 //   }
-func (g *GameUtils) GetInventory(selector string) ([]InventorySlot, error) {
-	giVal := reflect.ValueOf(g.gi)
-	if !giVal.IsValid() || giVal.IsNil() {
-		return nil, fmt.Errorf("gameInterface 未初始化")
+func (g *GameUtils) GetInventory(selector Target) ([]InventorySlot, error) {
+	if g.inventory == nil {
+		return nil, fmt.Errorf("背包跟踪未启用，请先调用 ctx.Inventory()")
 	}
 
-	// 使用 replaceitem 测试每个槽位来获取背包信息
-	// 这是一种间接方法，因为 Minecraft 基岩版没有直接的背包查询命令
-	// 更好的实现需要拦截 InventoryContent 数据包
+	if selector != nil && selector != "" {
+		formatted, err := FormatTarget(selector)
+		if err != nil {
+			return nil, err
+		}
+		selector = formatted
+	}
+	if selector != nil && selector != "" && selector != "@s" {
+		return nil, fmt.Errorf("只能查询机器人自身的背包，其他玩家的物品数量请使用 GetItem")
+	}
 
-	// 返回空实现，提示需要数据包拦截
-	return nil, fmt.Errorf("GetInventory 需要通过拦截 InventoryContent 数据包实现，当前版本暂不支持")
+	return g.inventory.Window(WindowIDInventory), nil
 }
 
 // GetBlock 获取指定坐标的方块类型
 // x, y, z: 方块坐标
 // 返回: 方块 ID (如 "minecraft:stone")
 //
+// 注意: 精确查询通过结构导出实现，需要先调用 ctx.World()，且机器人需要管理员权限；
+// 未启用时只能通过 testforblock 判断是否为空气
+//
 // 示例:
+//   ctx.World()
 //   blockID, err := ctx.GameUtils().GetBlock(100, 64, 100)
 //   if err == nil {
 //       ctx.Logf("方块类型: %s", blockID)
 //   }
 func (g *GameUtils) GetBlock(x, y, z int) (string, error) {
-	giVal := reflect.ValueOf(g.gi)
-	if !giVal.IsValid() || giVal.IsNil() {
-		return "", fmt.Errorf("gameInterface 未初始化")
-	}
-
-	commandsMethod := giVal.MethodByName("Commands")
-	if !commandsMethod.IsValid() {
-		return "", fmt.Errorf("gameInterface 不支持 Commands 方法")
+	if g.world != nil {
+		block, err := g.world.GetBlock(x, y, z)
+		if err != nil {
+			return "", err
+		}
+		return block.Name, nil
 	}
 
-	commandsVal := commandsMethod.Call(nil)
-	if len(commandsVal) == 0 || !commandsVal[0].IsValid() {
-		return "", fmt.Errorf("Commands 返回值无效")
+	commands, err := g.commands()
+	if err != nil {
+		return "", err
 	}
 
-	// 使用 testforblock 命令来检测方块
-	// 由于没有直接的查询命令，这里使用 setblock 的 keep 模式来检测
-	cmd := fmt.Sprintf("testforblock %d %d %d air", x, y, z)
+	// 未启用方块查询时只能用 testforblock 检测空气
+	cmd := NewCommand("testforblock").BlockPos(BlockPos{x, y, z}).Keyword("air").String()
 
-	sendMethod := commandsVal[0].MethodByName("SendWSCommandWithResp")
-	if !sendMethod.IsValid() {
-		return "", fmt.Errorf("Commands 不支持 SendWSCommandWithResp 方法")
+	// 命令执行成功才说明是空气方块，发送成功但检测失败时仍不知道方块类型
+	if output, err := commands.SendWSCommandWithResp(cmd); err == nil && ParseCommandOutput(output).Succeeded() {
+		return "minecraft:air", nil
 	}
 
-	results := sendMethod.Call([]reflect.Value{reflect.ValueOf(cmd)})
-	if len(results) != 2 {
-		return "", fmt.Errorf("SendWSCommandWithResp 返回值数量不正确")
-	}
+	return "", fmt.Errorf("方块查询未启用，请先调用 ctx.World()")
+}
 
-	// 如果成功，说明是空气方块
-	if results[1].IsNil() {
-		return "minecraft:air", nil
+// GetBlockState 获取指定坐标的方块 ID 与方块状态
+// 需要先调用 ctx.World()
+//
+// 示例:
+//   block, err := ctx.GameUtils().GetBlockState(100, 64, 100)
+//   if err == nil {
+//       ctx.Logf("%s %v", block.Name, block.States)
+//   }
+func (g *GameUtils) GetBlockState(x, y, z int) (Block, error) {
+	if g.world == nil {
+		return Block{}, fmt.Errorf("方块查询未启用，请先调用 ctx.World()")
 	}
+	return g.world.GetBlock(x, y, z)
+}
 
-	// 无法直接获取方块 ID，需要通过其他方式
-	// 返回提示信息
-	return "", fmt.Errorf("GetBlock 需要通过拦截 BlockUpdate 数据包或使用结构方块实现，当前版本暂不支持精确查询")
+// GetBlocks 获取以 a、b 为对角的长方体区域内所有方块的快照
+// 需要先调用 ctx.World()；区域需要已加载，边长超过 64 时会拆分为多次查询
+//
+// 示例:
+//   region, err := ctx.GameUtils().GetBlocks(sdk.BlockPos{0, 60, 0}, sdk.BlockPos{15, 70, 15})
+//   if err == nil {
+//       region.ForEach(func(pos sdk.BlockPos, block sdk.Block) bool {
+//           ctx.Logf("%v: %s", pos, block.Name)
+//           return true
+//       })
+//   }
+func (g *GameUtils) GetBlocks(a, b BlockPos) (*BlockRegion, error) {
+	if g.world == nil {
+		return nil, fmt.Errorf("方块查询未启用，请先调用 ctx.World()")
+	}
+	return g.world.GetBlocks(a, b)
 }
 
 // EffectOptions 药水效果配置选项
@@ -829,20 +711,10 @@ type EffectOptions struct {
 //       Level:         1,
 //       HideParticles: true,
 //   })
-func (g *GameUtils) SetEffect(target string, effectID int, opts EffectOptions) error {
-	giVal := reflect.ValueOf(g.gi)
-	if !giVal.IsValid() || giVal.IsNil() {
-		return fmt.Errorf("gameInterface 未初始化")
-	}
-
-	commandsMethod := giVal.MethodByName("Commands")
-	if !commandsMethod.IsValid() {
-		return fmt.Errorf("gameInterface 不支持 Commands 方法")
-	}
-
-	commandsVal := commandsMethod.Call(nil)
-	if len(commandsVal) == 0 || !commandsVal[0].IsValid() {
-		return fmt.Errorf("Commands 返回值无效")
+func (g *GameUtils) SetEffect(target Target, effectID int, opts EffectOptions) error {
+	formatted, err := FormatTarget(target)
+	if err != nil {
+		return err
 	}
 
 	// 设置默认值
@@ -854,25 +726,20 @@ func (g *GameUtils) SetEffect(target string, effectID int, opts EffectOptions) e
 	}
 
 	// 构造 effect 命令
-	hideParticle := ""
+	command := NewCommand("effect").Target(formatted).Int(effectID).Int(opts.Duration).Int(opts.Level)
 	if opts.HideParticles {
-		hideParticle = " true"
+		command.Bool(true)
 	}
-
-	cmd := fmt.Sprintf("effect \"%s\" %d %d %d%s", target, effectID, opts.Duration, opts.Level, hideParticle)
-
-	sendMethod := commandsVal[0].MethodByName("SendWSCommand")
-	if !sendMethod.IsValid() {
-		return fmt.Errorf("Commands 不支持 SendWSCommand 方法")
-	}
-
-	results := sendMethod.Call([]reflect.Value{reflect.ValueOf(cmd)})
-	if len(results) != 1 {
-		return fmt.Errorf("SendWSCommand 返回值数量不正确")
+	cmd, err := command.Build()
+	if err != nil {
+		return err
 	}
 
-	if !results[0].IsNil() {
-		return fmt.Errorf("添加药水效果失败: %v", results[0].Interface())
+	err = g.sendBuiltCommand(cmd, func() error {
+		return g.remote.SetEffect(formatted, effectID, opts)
+	})
+	if err != nil {
+		return fmt.Errorf("添加药水效果失败: %v", err)
 	}
 
 	return nil
@@ -887,43 +754,19 @@ func (g *GameUtils) SetEffect(target string, effectID int, opts EffectOptions) e
 //   ctx.GameUtils().ClearEffect("Steve", 1)
 //   // 清除所有效果
 //   ctx.GameUtils().ClearEffect("Steve", -1)
-func (g *GameUtils) ClearEffect(target string, effectID int) error {
-	giVal := reflect.ValueOf(g.gi)
-	if !giVal.IsValid() || giVal.IsNil() {
-		return fmt.Errorf("gameInterface 未初始化")
-	}
-
-	commandsMethod := giVal.MethodByName("Commands")
-	if !commandsMethod.IsValid() {
-		return fmt.Errorf("gameInterface 不支持 Commands 方法")
-	}
-
-	commandsVal := commandsMethod.Call(nil)
-	if len(commandsVal) == 0 || !commandsVal[0].IsValid() {
-		return fmt.Errorf("Commands 返回值无效")
-	}
-
-	var cmd string
-	if effectID < 0 {
-		// 清除所有效果
-		cmd = fmt.Sprintf("effect \"%s\" clear", target)
-	} else {
+func (g *GameUtils) ClearEffect(target Target, effectID int) error {
+	command := NewCommand("effect").Target(target).Keyword("clear")
+	if effectID >= 0 {
 		// 清除特定效果
-		cmd = fmt.Sprintf("effect \"%s\" clear %d", target, effectID)
-	}
-
-	sendMethod := commandsVal[0].MethodByName("SendWSCommand")
-	if !sendMethod.IsValid() {
-		return fmt.Errorf("Commands 不支持 SendWSCommand 方法")
+		command.Int(effectID)
 	}
-
-	results := sendMethod.Call([]reflect.Value{reflect.ValueOf(cmd)})
-	if len(results) != 1 {
-		return fmt.Errorf("SendWSCommand 返回值数量不正确")
+	cmd, err := command.Build()
+	if err != nil {
+		return err
 	}
 
-	if !results[0].IsNil() {
-		return fmt.Errorf("清除药水效果失败: %v", results[0].Interface())
+	if err := g.SendCommand(cmd); err != nil {
+		return fmt.Errorf("清除药水效果失败: %v", err)
 	}
 
 	return nil
@@ -0,0 +1,259 @@
+package sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// gameUtilsGRPCProxy 是 GameUtils 的 gRPC 代理后端
+// 运行在插件进程中，将所有游戏操作转发到主进程的 ContextServer
+type gameUtilsGRPCProxy struct {
+	client ContextServiceClient
+	calls  *callPolicy
+}
+
+// newGRPCGameUtils 创建由 gRPC 代理驱动的 GameUtils
+func newGRPCGameUtils(client ContextServiceClient, calls *callPolicy) *GameUtils {
+	return &GameUtils{remote: &gameUtilsGRPCProxy{client: client, calls: calls}}
+}
+
+// boolResult 将 BoolResponse 转换为 error
+func boolResult(resp *BoolResponse, err error) error {
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return errors.New(resp.Error)
+	}
+	return nil
+}
+
+func (p *gameUtilsGRPCProxy) SayTo(target, text string) error {
+	callCtx, done := p.calls.start("SayTo")
+	resp, err := p.client.SayTo(callCtx, &SayToRequest{
+		Player:  target,
+		Message: text,
+	})
+	done(err)
+	return boolResult(resp, err)
+}
+
+func (p *gameUtilsGRPCProxy) SendCommand(cmd string) error {
+	callCtx, done := p.calls.start("SendCommand")
+	resp, err := p.client.SendCommand(callCtx, &SendCommandRequest{
+		Command: cmd,
+	})
+	done(err)
+	return boolResult(resp, err)
+}
+
+func (p *gameUtilsGRPCProxy) SendWOCommand(cmd string) error {
+	callCtx, done := p.calls.start("SendWOCommand")
+	resp, err := p.client.SendWOCommand(callCtx, &SendCommandRequest{
+		Command: cmd,
+	})
+	done(err)
+	return boolResult(resp, err)
+}
+
+func (p *gameUtilsGRPCProxy) SendChat(message string) error {
+	callCtx, done := p.calls.start("SendChat")
+	resp, err := p.client.SendChat(callCtx, &SendChatRequest{
+		Message: message,
+	})
+	done(err)
+	return boolResult(resp, err)
+}
+
+func (p *gameUtilsGRPCProxy) Title(message string) error {
+	callCtx, done := p.calls.start("Title")
+	resp, err := p.client.Title(callCtx, &SendChatRequest{
+		Message: message,
+	})
+	done(err)
+	return boolResult(resp, err)
+}
+
+func (p *gameUtilsGRPCProxy) SendCommandWithResponse(cmd string, timeout float64) (*CommandResult, bool, error) {
+	callCtx, done := p.calls.startAtLeast("SendCommandWithResponse", time.Duration(timeout*float64(time.Second))+time.Second)
+	resp, err := p.client.SendCommandWithResponse(callCtx, &SendCommandWithResponseRequest{
+		Command: cmd,
+		Timeout: timeout,
+	})
+	done(err)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var output *CommandResult
+	if len(resp.Output) > 0 {
+		if err := json.Unmarshal(resp.Output, &output); err != nil {
+			return nil, resp.TimedOut, fmt.Errorf("解析命令输出失败: %w", err)
+		}
+	}
+	if !resp.Success {
+		return output, resp.TimedOut, errors.New(resp.Error)
+	}
+	return output, resp.TimedOut, nil
+}
+
+func (p *gameUtilsGRPCProxy) SendCommandBatch(cmds []string, timeout float64) ([]CommandBatchResult, error) {
+	callCtx, done := p.calls.startAtLeast("SendCommandBatch", time.Duration(timeout*float64(time.Second))+time.Second)
+	resp, err := p.client.SendCommandBatch(callCtx, &SendCommandBatchRequest{
+		Commands: cmds,
+		Timeout:  timeout,
+	})
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, errors.New(resp.Error)
+	}
+	if len(resp.Results) != len(cmds) {
+		return nil, fmt.Errorf("主进程返回了 %d 条结果，应为 %d 条", len(resp.Results), len(cmds))
+	}
+
+	results := make([]CommandBatchResult, len(cmds))
+	for i, item := range resp.Results {
+		results[i] = CommandBatchResult{Command: cmds[i], TimedOut: item.TimedOut}
+		if len(item.Output) > 0 {
+			if err := json.Unmarshal(item.Output, &results[i].Result); err != nil {
+				results[i].Err = fmt.Errorf("解析命令输出失败: %w", err)
+				continue
+			}
+		}
+		if !item.Success {
+			results[i].Err = errors.New(item.Error)
+		}
+	}
+	return results, nil
+}
+
+func (p *gameUtilsGRPCProxy) GetScore(scbName, target string, timeout float64) (int, error) {
+	callCtx, done := p.calls.startAtLeast("GetScore", time.Duration(timeout*float64(time.Second))+time.Second)
+	resp, err := p.client.GetScore(callCtx, &GetScoreRequest{
+		Scoreboard: scbName,
+		Target:     target,
+		Timeout:    timeout,
+	})
+	done(err)
+	if err != nil {
+		return 0, err
+	}
+	if !resp.Success {
+		return 0, errors.New(resp.Error)
+	}
+	return int(resp.Score), nil
+}
+
+func (p *gameUtilsGRPCProxy) GetPos(target string) (*Position, error) {
+	callCtx, done := p.calls.start("GetPos")
+	resp, err := p.client.GetPos(callCtx, &GetPosRequest{
+		Target: target,
+	})
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, errors.New(resp.Error)
+	}
+	return &Position{
+		X:         resp.X,
+		Y:         resp.Y,
+		Z:         resp.Z,
+		Dimension: uint8(resp.Dimension),
+		YRot:      resp.YRot,
+	}, nil
+}
+
+func (p *gameUtilsGRPCProxy) GetTarget(target string, timeout float64) ([]string, error) {
+	callCtx, done := p.calls.startAtLeast("GetTarget", time.Duration(timeout*float64(time.Second))+time.Second)
+	resp, err := p.client.GetTarget(callCtx, &GetTargetRequest{
+		Target:  target,
+		Timeout: timeout,
+	})
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, errors.New(resp.Error)
+	}
+	if resp.Names == nil {
+		return []string{}, nil
+	}
+	return resp.Names, nil
+}
+
+func (p *gameUtilsGRPCProxy) GetItem(target, itemName string, itemSpecialID int) (int, error) {
+	callCtx, done := p.calls.start("GetItem")
+	resp, err := p.client.GetItem(callCtx, &GetItemRequest{
+		Target:        target,
+		ItemName:      itemName,
+		ItemSpecialId: int32(itemSpecialID),
+	})
+	done(err)
+	if err != nil {
+		return 0, err
+	}
+	if !resp.Success {
+		return 0, errors.New(resp.Error)
+	}
+	return int(resp.Count), nil
+}
+
+func (p *gameUtilsGRPCProxy) IsOp(playerName string) (bool, error) {
+	callCtx, done := p.calls.start("IsOp")
+	resp, err := p.client.IsOp(callCtx, &IsOpRequest{
+		PlayerName: playerName,
+	})
+	done(err)
+	if err != nil {
+		return false, err
+	}
+	if !resp.Success {
+		return false, errors.New(resp.Error)
+	}
+	return resp.IsOp, nil
+}
+
+func (p *gameUtilsGRPCProxy) Tellraw(selector, message string) error {
+	callCtx, done := p.calls.start("Tellraw")
+	resp, err := p.client.Tellraw(callCtx, &TellrawRequest{
+		Selector: selector,
+		Message:  message,
+	})
+	done(err)
+	return boolResult(resp, err)
+}
+
+func (p *gameUtilsGRPCProxy) SetEffect(target string, effectID int, opts EffectOptions) error {
+	callCtx, done := p.calls.start("SetEffect")
+	resp, err := p.client.SetEffect(callCtx, &SetEffectRequest{
+		Target:        target,
+		EffectId:      int32(effectID),
+		Duration:      int32(opts.Duration),
+		Level:         int32(opts.Level),
+		HideParticles: opts.HideParticles,
+	})
+	done(err)
+	return boolResult(resp, err)
+}
+
+func (p *gameUtilsGRPCProxy) SendPacket(packetID uint32, packet interface{}) error {
+	packetData, err := json.Marshal(packet)
+	if err != nil {
+		return fmt.Errorf("序列化数据包失败: %w", err)
+	}
+	callCtx, done := p.calls.start("SendPacket")
+	resp, err := p.client.SendPacket(callCtx, &SendPacketRequest{
+		PacketId:   packetID,
+		PacketData: packetData,
+	})
+	done(err)
+	return boolResult(resp, err)
+}
@@ -0,0 +1,91 @@
+package sdk
+
+import (
+	"sync"
+	"testing"
+)
+
+// timeoutRecordingBackend 记录转发到主进程的超时参数
+type timeoutRecordingBackend struct {
+	gameUtilsBackend
+	timeouts map[string]float64
+}
+
+func (b *timeoutRecordingBackend) SendCommandWithResponse(cmd string, timeout float64) (*CommandResult, bool, error) {
+	b.timeouts["SendCommandWithResponse"] = timeout
+	return nil, false, nil
+}
+
+func (b *timeoutRecordingBackend) GetScore(scbName, target string, timeout float64) (int, error) {
+	b.timeouts["GetScore"] = timeout
+	return 0, nil
+}
+
+func (b *timeoutRecordingBackend) GetTarget(target string, timeout float64) ([]string, error) {
+	b.timeouts["GetTarget"] = timeout
+	return nil, nil
+}
+
+func TestGameUtilsRemoteDefaultTimeouts(t *testing.T) {
+	backend := &timeoutRecordingBackend{timeouts: map[string]float64{}}
+	utils := &GameUtils{remote: backend}
+
+	if _, _, err := utils.SendCommandWithResponse("list"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := utils.SendCommandWithResponse("list", 0); err != nil {
+		t.Fatal(err)
+	}
+	if got := backend.timeouts["SendCommandWithResponse"]; got != 30 {
+		t.Fatalf("SendCommandWithResponse timeout = %v, want 30", got)
+	}
+	if _, err := utils.GetScore("money", "Steve", 0); err != nil {
+		t.Fatal(err)
+	}
+	if got := backend.timeouts["GetScore"]; got != 30 {
+		t.Fatalf("GetScore timeout = %v, want 30", got)
+	}
+	if _, err := utils.GetTarget("@a", -1); err != nil {
+		t.Fatal(err)
+	}
+	if got := backend.timeouts["GetTarget"]; got != 5 {
+		t.Fatalf("GetTarget timeout = %v, want 5", got)
+	}
+	if _, err := utils.GetScore("money", "Steve", 2); err != nil {
+		t.Fatal(err)
+	}
+	if got := backend.timeouts["GetScore"]; got != 2 {
+		t.Fatalf("GetScore timeout = %v, want 2", got)
+	}
+}
+
+// legacyBatchBackend 模拟没有批量调用的旧版主进程
+type legacyBatchBackend struct {
+	gameUtilsBackend
+	mu   sync.Mutex
+	sent []string
+}
+
+func (b *legacyBatchBackend) SendCommandBatch(cmds []string, timeout float64) ([]CommandBatchResult, error) {
+	return nil, notSupportedByHost("SendCommandBatch")
+}
+
+func (b *legacyBatchBackend) SendCommandWithResponse(cmd string, timeout float64) (*CommandResult, bool, error) {
+	b.mu.Lock()
+	b.sent = append(b.sent, cmd)
+	b.mu.Unlock()
+	return &CommandResult{SuccessCount: 1}, false, nil
+}
+
+func TestGameUtilsBatchFallsBackOnOldHost(t *testing.T) {
+	backend := &legacyBatchBackend{}
+	utils := &GameUtils{remote: backend}
+
+	results := utils.SendCommandBatch([]string{"testfor A", "testfor B"})
+	if len(results) != 2 || !results[0].Succeeded() || !results[1].Succeeded() || results[1].Command != "testfor B" {
+		t.Fatalf("results = %+v", results)
+	}
+	if len(backend.sent) != 2 {
+		t.Fatalf("sent %v, want one SendCommandWithResponse per command", backend.sent)
+	}
+}
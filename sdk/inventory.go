@@ -0,0 +1,335 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// 背包相关的数据包 ID
+const (
+	PacketIDStartGame        uint32 = 11  // StartGame，旧版协议在其中下发物品表
+	PacketIDInventoryContent uint32 = 49  // InventoryContent，整个窗口的内容
+	PacketIDInventorySlot    uint32 = 50  // InventorySlot，单个槽位的变化
+	PacketIDItemRegistry     uint32 = 162 // ItemRegistry，新版协议下发物品表
+)
+
+// InventoryPacketIDs 是 InventoryTracker 需要监听的数据包
+var InventoryPacketIDs = []uint32{
+	PacketIDStartGame,
+	PacketIDInventoryContent,
+	PacketIDInventorySlot,
+	PacketIDItemRegistry,
+}
+
+// 常用的窗口 ID
+const (
+	WindowIDInventory uint32 = 0   // 主背包（含快捷栏）
+	WindowIDOffHand   uint32 = 119 // 副手
+	WindowIDArmour    uint32 = 120 // 盔甲栏
+	WindowIDUI        uint32 = 124 // 界面（光标等）
+)
+
+// InventorySlot 表示背包中的一个物品槽位
+type InventorySlot struct {
+	Slot     int    // 槽位编号
+	ItemID   string // 物品 ID (如 "minecraft:diamond")，物品表未知时为空
+	ItemName string // 物品名称（NBT 中的自定义名称，没有时与 ItemID 相同）
+	Count    int    // 物品数量
+	Aux      int    // 物品附加值（数据值）
+
+	WindowID     uint32            // 所在窗口 ID
+	NetworkID    int32             // 物品网络 ID
+	Damage       int               // 耐久损耗（NBT 中的 Damage）
+	Enchantments []ItemEnchantment // 附魔列表
+	NBT          map[string]any    // 原始 NBT 数据
+}
+
+// ItemEnchantment 表示物品上的一个附魔
+type ItemEnchantment struct {
+	ID    int // 附魔 ID
+	Level int // 附魔等级
+}
+
+// InventoryChange 描述一次背包变化
+type InventoryChange struct {
+	WindowID uint32
+	Full     bool            // true 表示整个窗口被刷新（InventoryContent）
+	Slots    []InventorySlot // 变化后的槽位，清空的槽位 Count 为 0
+}
+
+// InventoryTracker 通过 InventoryContent / InventorySlot 数据包跟踪机器人的背包与打开的容器
+// 注意: 服务器只会向机器人同步它自己的背包，其他玩家的背包无法通过数据包获得
+//
+// 示例:
+//
+//	inv := ctx.Inventory()
+//	for _, slot := range inv.Window(sdk.WindowIDInventory) {
+//	    ctx.Logf("槽位 %d: %s x%d", slot.Slot, slot.ItemID, slot.Count)
+//	}
+//	inv.OnChange(func(change sdk.InventoryChange) {
+//	    ctx.Logf("窗口 %d 发生变化", change.WindowID)
+//	})
+type InventoryTracker struct {
+	mu        sync.RWMutex
+	windows   map[uint32]map[int]InventorySlot
+	itemNames map[int32]string
+
+	listenersMu sync.Mutex
+	nextID      int
+	listeners   map[int]func(InventoryChange)
+}
+
+// NewInventoryTracker 创建背包跟踪器
+// 需要把 InventoryPacketIDs 中的数据包交给 HandlePacket，或调用 Attach 自动监听
+func NewInventoryTracker() *InventoryTracker {
+	return &InventoryTracker{
+		windows:   make(map[uint32]map[int]InventorySlot),
+		itemNames: make(map[int32]string),
+		listeners: make(map[int]func(InventoryChange)),
+	}
+}
+
+// Attach 通过 ctx 监听背包相关的数据包
+func (t *InventoryTracker) Attach(ctx *Context) (*ListenerHandle, error) {
+	return ctx.ListenPacket(t.HandlePacket, InventoryPacketIDs...)
+}
+
+// HandlePacket 处理背包相关的数据包，可以直接作为 PacketHandler 使用
+// 数据包可以是主进程的数据包结构体，也可以是跨平台插件收到的 JSON 对象
+func (t *InventoryTracker) HandlePacket(event PacketEvent) {
+	if event.Raw == nil {
+		return
+	}
+	switch event.ID {
+	case PacketIDInventoryContent:
+		var pk inventoryContentPacket
+		if decodePacket(event.Raw, &pk) == nil {
+			t.applyContent(pk)
+		}
+	case PacketIDInventorySlot:
+		var pk inventorySlotPacket
+		if decodePacket(event.Raw, &pk) == nil {
+			t.applySlot(pk)
+		}
+	case PacketIDStartGame, PacketIDItemRegistry:
+		var pk itemTablePacket
+		if decodePacket(event.Raw, &pk) == nil {
+			t.mu.Lock()
+			for _, item := range pk.Items {
+				t.itemNames[int32(item.RuntimeID)] = item.Name
+			}
+			t.mu.Unlock()
+		}
+	}
+}
+
+// SetItemName 手动登记物品网络 ID 对应的物品 ID（如 "minecraft:diamond"）
+// 主进程未转发物品表时使用
+func (t *InventoryTracker) SetItemName(networkID int32, itemID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.itemNames[networkID] = itemID
+}
+
+// Windows 返回已知的窗口 ID
+func (t *InventoryTracker) Windows() []uint32 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	ids := make([]uint32, 0, len(t.windows))
+	for id := range t.windows {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// Window 返回窗口中所有非空槽位，按槽位编号排序
+func (t *InventoryTracker) Window(windowID uint32) []InventorySlot {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	slots := make([]InventorySlot, 0, len(t.windows[windowID]))
+	for _, slot := range t.windows[windowID] {
+		slots = append(slots, slot)
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i].Slot < slots[j].Slot })
+	return slots
+}
+
+// Slot 返回窗口中指定槽位的物品，槽位为空时返回 false
+func (t *InventoryTracker) Slot(windowID uint32, slot int) (InventorySlot, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	item, ok := t.windows[windowID][slot]
+	return item, ok
+}
+
+// CountItem 统计窗口中指定物品 ID 的总数量
+func (t *InventoryTracker) CountItem(windowID uint32, itemID string) int {
+	total := 0
+	for _, slot := range t.Window(windowID) {
+		if slot.ItemID == itemID {
+			total += slot.Count
+		}
+	}
+	return total
+}
+
+// OnChange 注册背包变化通知，返回取消注册的函数
+// 回调在处理数据包的协程中同步执行，不应阻塞
+func (t *InventoryTracker) OnChange(fn func(InventoryChange)) func() {
+	t.listenersMu.Lock()
+	defer t.listenersMu.Unlock()
+	t.nextID++
+	id := t.nextID
+	t.listeners[id] = fn
+	return func() {
+		t.listenersMu.Lock()
+		defer t.listenersMu.Unlock()
+		delete(t.listeners, id)
+	}
+}
+
+// Clear 清空已跟踪的背包（如重新进入服务器时）
+func (t *InventoryTracker) Clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.windows = make(map[uint32]map[int]InventorySlot)
+}
+
+func (t *InventoryTracker) applyContent(pk inventoryContentPacket) {
+	t.mu.Lock()
+	window := make(map[int]InventorySlot, len(pk.Content))
+	changed := make([]InventorySlot, 0, len(pk.Content))
+	for i, item := range pk.Content {
+		slot := t.convert(pk.WindowID, i, item.Stack)
+		if slot.Count > 0 {
+			window[i] = slot
+			changed = append(changed, slot)
+		}
+	}
+	t.windows[pk.WindowID] = window
+	t.mu.Unlock()
+
+	t.notify(InventoryChange{WindowID: pk.WindowID, Full: true, Slots: changed})
+}
+
+func (t *InventoryTracker) applySlot(pk inventorySlotPacket) {
+	t.mu.Lock()
+	slot := t.convert(pk.WindowID, int(pk.Slot), pk.NewItem.Stack)
+	window := t.windows[pk.WindowID]
+	if window == nil {
+		window = make(map[int]InventorySlot)
+		t.windows[pk.WindowID] = window
+	}
+	if slot.Count > 0 {
+		window[slot.Slot] = slot
+	} else {
+		delete(window, slot.Slot)
+	}
+	t.mu.Unlock()
+
+	t.notify(InventoryChange{WindowID: pk.WindowID, Slots: []InventorySlot{slot}})
+}
+
+// convert 把数据包中的物品转换为 InventorySlot，调用时需持有 t.mu
+func (t *InventoryTracker) convert(windowID uint32, index int, stack itemStack) InventorySlot {
+	slot := InventorySlot{Slot: index, WindowID: windowID}
+	if stack.ItemType.NetworkID == 0 || stack.Count == 0 {
+		return slot
+	}
+	slot.NetworkID = stack.ItemType.NetworkID
+	slot.ItemID = t.itemNames[stack.ItemType.NetworkID]
+	slot.Count = int(stack.Count)
+	slot.Aux = int(stack.ItemType.MetadataValue)
+	slot.NBT = stack.NBTData
+
+	slot.ItemName = slot.ItemID
+	if display, ok := stack.NBTData["display"].(map[string]any); ok {
+		if name, ok := display["Name"].(string); ok && name != "" {
+			slot.ItemName = name
+		}
+	}
+	slot.Damage = nbtInt(stack.NBTData["Damage"])
+	if enchants, ok := stack.NBTData["ench"].([]any); ok {
+		for _, entry := range enchants {
+			if ench, ok := entry.(map[string]any); ok {
+				slot.Enchantments = append(slot.Enchantments, ItemEnchantment{
+					ID:    nbtInt(ench["id"]),
+					Level: nbtInt(ench["lvl"]),
+				})
+			}
+		}
+	}
+	return slot
+}
+
+func (t *InventoryTracker) notify(change InventoryChange) {
+	t.listenersMu.Lock()
+	ids := make([]int, 0, len(t.listeners))
+	for id := range t.listeners {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	listeners := make([]func(InventoryChange), 0, len(ids))
+	for _, id := range ids {
+		listeners = append(listeners, t.listeners[id])
+	}
+	t.listenersMu.Unlock()
+
+	for _, fn := range listeners {
+		fn(change)
+	}
+}
+
+// 以下结构体与主进程数据包的字段名一致，用于从结构体或 JSON 对象中解码
+
+type inventoryContentPacket struct {
+	WindowID uint32
+	Content  []itemInstance
+}
+
+type inventorySlotPacket struct {
+	WindowID uint32
+	Slot     uint32
+	NewItem  itemInstance
+}
+
+type itemInstance struct {
+	StackNetworkID int32
+	Stack          itemStack
+}
+
+type itemStack struct {
+	ItemType struct {
+		NetworkID     int32
+		MetadataValue uint32
+	}
+	Count   uint16
+	NBTData map[string]any
+}
+
+type itemTablePacket struct {
+	Items []struct {
+		Name      string
+		RuntimeID int16
+	}
+}
+
+// decodePacket 经由 JSON 把数据包结构体或 JSON 对象解码到 v
+func decodePacket(raw any, v any) error {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return fmt.Errorf("序列化数据包失败: %w", err)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// nbtInt 把 NBT 中的整数（JSON 解码后为 float64）转换为 int
+func nbtInt(v any) int {
+	if n, ok := v.(float64); ok {
+		return int(n)
+	}
+	return 0
+}
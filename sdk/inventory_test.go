@@ -0,0 +1,123 @@
+package sdk
+
+import (
+	"reflect"
+	"testing"
+)
+
+// 以下类型模仿主进程的数据包结构体
+
+type testItemType struct {
+	NetworkID     int32
+	MetadataValue uint32
+}
+
+type testItemStack struct {
+	ItemType       testItemType
+	BlockRuntimeID int32
+	Count          uint16
+	NBTData        map[string]any
+}
+
+type testItemInstance struct {
+	StackNetworkID int32
+	Stack          testItemStack
+}
+
+type testInventoryContent struct {
+	WindowID uint32
+	Content  []testItemInstance
+}
+
+type testItemEntry struct {
+	Name           string
+	RuntimeID      int16
+	ComponentBased bool
+}
+
+type testItemRegistry struct {
+	Items []testItemEntry
+}
+
+func TestInventoryTrackerContent(t *testing.T) {
+	tracker := NewInventoryTracker()
+	var changes []InventoryChange
+	tracker.OnChange(func(change InventoryChange) {
+		changes = append(changes, change)
+	})
+
+	tracker.HandlePacket(PacketEvent{ID: PacketIDItemRegistry, Raw: &testItemRegistry{Items: []testItemEntry{
+		{Name: "minecraft:diamond_sword", RuntimeID: 316},
+		{Name: "minecraft:apple", RuntimeID: 257},
+	}}})
+	tracker.HandlePacket(PacketEvent{ID: PacketIDInventoryContent, Raw: &testInventoryContent{
+		WindowID: WindowIDInventory,
+		Content: []testItemInstance{
+			{Stack: testItemStack{ItemType: testItemType{NetworkID: 257}, Count: 5}},
+			{},
+			{Stack: testItemStack{
+				ItemType: testItemType{NetworkID: 316},
+				Count:    1,
+				NBTData: map[string]any{
+					"Damage":  int32(12),
+					"display": map[string]any{"Name": "Excalibur"},
+					"ench":    []any{map[string]any{"id": int16(9), "lvl": int16(5)}},
+				},
+			}},
+		},
+	}})
+
+	slots := tracker.Window(WindowIDInventory)
+	if len(slots) != 2 {
+		t.Fatalf("slots = %+v", slots)
+	}
+	if slots[0].ItemID != "minecraft:apple" || slots[0].Count != 5 || slots[0].ItemName != "minecraft:apple" {
+		t.Fatalf("slot 0 = %+v", slots[0])
+	}
+	sword := slots[1]
+	if sword.Slot != 2 || sword.ItemName != "Excalibur" || sword.Damage != 12 ||
+		!reflect.DeepEqual(sword.Enchantments, []ItemEnchantment{{ID: 9, Level: 5}}) {
+		t.Fatalf("sword = %+v", sword)
+	}
+	if len(changes) != 1 || !changes[0].Full || len(changes[0].Slots) != 2 {
+		t.Fatalf("changes = %+v", changes)
+	}
+
+	// 跨平台插件收到的是 JSON 对象
+	tracker.HandlePacket(PacketEvent{ID: PacketIDInventorySlot, Raw: map[string]any{
+		"WindowID": float64(0),
+		"Slot":     float64(0),
+		"NewItem":  map[string]any{"Stack": map[string]any{"ItemType": map[string]any{"NetworkID": float64(0)}}},
+	}})
+	if _, ok := tracker.Slot(WindowIDInventory, 0); ok {
+		t.Fatalf("slot 0 should be cleared")
+	}
+	if got := tracker.CountItem(WindowIDInventory, "minecraft:apple"); got != 0 {
+		t.Fatalf("apples = %d, want 0", got)
+	}
+	if len(changes) != 2 || changes[1].Full || changes[1].Slots[0].Count != 0 {
+		t.Fatalf("changes = %+v", changes)
+	}
+}
+
+func TestGameUtilsGetInventory(t *testing.T) {
+	utils := NewGameUtils(nil)
+	if _, err := utils.GetInventory("@s"); err == nil {
+		t.Fatalf("GetInventory without tracker: want error")
+	}
+
+	tracker := NewInventoryTracker()
+	tracker.SetItemName(5, "minecraft:stone")
+	utils.SetInventoryTracker(tracker)
+	tracker.HandlePacket(PacketEvent{ID: PacketIDInventoryContent, Raw: &testInventoryContent{
+		Content: []testItemInstance{{Stack: testItemStack{ItemType: testItemType{NetworkID: 5}, Count: 64}}},
+	}})
+
+	slots, err := utils.GetInventory("")
+	if err != nil || len(slots) != 1 || slots[0].ItemID != "minecraft:stone" || slots[0].Count != 64 {
+		t.Fatalf("GetInventory = %+v, %v", slots, err)
+	}
+	if _, err := utils.GetInventory("Steve"); err == nil {
+		t.Fatalf("GetInventory of another player: want error")
+	}
+}
@@ -0,0 +1,50 @@
+package sdk
+
+import "sync"
+
+// ListenerHandle 事件监听句柄
+// 由 Listen* 系列方法返回，用于在不再需要时移除监听器
+//
+// 跨平台（gRPC）插件通过事件流接收事件时：
+//   同类事件按到达顺序串行处理；处理器跟不上、同类事件积压超过 64 个时，多出的事件改用单次调用投递，可能先于排队中的事件执行
+//   主进程等待结果超时或事件流中断时仍未返回的事件按处理失败计算（聊天不会被改写或取消，数据包不会被拦截），不会重新投递
+//   事件流中断后，之后的事件先改用单次调用，约 1 秒后重新建立事件流
+//
+// 示例:
+//   handle, err := ctx.ListenChat(func(event *sdk.ChatEvent) {
+//       // 小游戏进行中才需要处理聊天
+//   })
+//   if err != nil {
+//       return err
+//   }
+//   defer handle.Unregister()
+type ListenerHandle struct {
+	once       sync.Once
+	unregister func()
+}
+
+// newListenerHandle 创建监听句柄，unregister 可以为 nil（表示不支持移除）
+func newListenerHandle(unregister func()) *ListenerHandle {
+	return &ListenerHandle{unregister: unregister}
+}
+
+// Unregister 移除监听器
+// 多次调用是安全的，只有第一次调用生效
+func (h *ListenerHandle) Unregister() {
+	if h == nil {
+		return
+	}
+	h.once.Do(func() {
+		if h.unregister != nil {
+			h.unregister()
+		}
+	})
+}
+
+// unregisterFunc 将 Listen* 的返回值转换为 ContextOptions 注册函数的返回值
+func unregisterFunc(handle *ListenerHandle, err error) (func(), error) {
+	if err != nil {
+		return nil, err
+	}
+	return handle.Unregister, nil
+}
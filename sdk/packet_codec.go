@@ -0,0 +1,108 @@
+package sdk
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// PacketDecoder 将数据包的原始协议字节解码为 Go 结构体
+type PacketDecoder func(data []byte) (any, error)
+
+// PacketCodecRegistry 数据包解码器注册表
+// 跨平台插件为数据包 ID 注册解码器后，主进程会以原始协议字节转发这些数据包，
+// 并在插件进程中解码为类型化的结构体，避免 JSON 序列化丢失类型与二进制字段
+type PacketCodecRegistry struct {
+	mu       sync.RWMutex
+	decoders map[uint32]PacketDecoder
+}
+
+// NewPacketCodecRegistry 创建数据包解码器注册表
+func NewPacketCodecRegistry() *PacketCodecRegistry {
+	return &PacketCodecRegistry{
+		decoders: make(map[uint32]PacketDecoder),
+	}
+}
+
+// DefaultPacketCodecs 默认的数据包解码器注册表，gRPC 插件的数据包监听使用此注册表
+var DefaultPacketCodecs = NewPacketCodecRegistry()
+
+// RegisterPacketCodec 在默认注册表中注册数据包解码器
+// decoder 为 nil 时只请求原始字节，PacketEvent.Raw 为空，由插件自行处理 PacketEvent.Data
+// 注意: 需要在 ListenPacket 之前注册，已注册的监听器不会改变传输方式
+//
+// 示例:
+//   sdk.RegisterPacketCodec(packet.IDText, func(data []byte) (any, error) {
+//       pk := &packet.Text{}
+//       pk.Marshal(protocol.NewReader(bytes.NewReader(data), 0, false))
+//       return pk, nil
+//   })
+func RegisterPacketCodec(packetID uint32, decoder PacketDecoder) {
+	DefaultPacketCodecs.Register(packetID, decoder)
+}
+
+// Register 注册数据包解码器，重复注册会覆盖之前的解码器
+func (r *PacketCodecRegistry) Register(packetID uint32, decoder PacketDecoder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.decoders[packetID] = decoder
+}
+
+// Unregister 移除数据包解码器
+func (r *PacketCodecRegistry) Unregister(packetID uint32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.decoders, packetID)
+}
+
+// Has 检查数据包 ID 是否已注册解码器
+func (r *PacketCodecRegistry) Has(packetID uint32) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.decoders[packetID]
+	return ok
+}
+
+// IDs 返回所有已注册的数据包 ID（升序）
+func (r *PacketCodecRegistry) IDs() []uint32 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]uint32, 0, len(r.decoders))
+	for id := range r.decoders {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// Filter 返回 packetIDs 中已注册解码器的 ID
+func (r *PacketCodecRegistry) Filter(packetIDs []uint32) []uint32 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var ids []uint32
+	for _, id := range packetIDs {
+		if _, ok := r.decoders[id]; ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// Decode 解码数据包
+// 未注册解码器或解码器为 nil 时返回 nil
+func (r *PacketCodecRegistry) Decode(packetID uint32, data []byte) (any, error) {
+	r.mu.RLock()
+	decoder := r.decoders[packetID]
+	r.mu.RUnlock()
+
+	if decoder == nil {
+		return nil, nil
+	}
+	pk, err := decoder(data)
+	if err != nil {
+		return nil, fmt.Errorf("解码数据包 %d 失败: %w", packetID, err)
+	}
+	return pk, nil
+}
@@ -20,12 +20,20 @@ type Player struct {
 
 // PlayerManager 玩家信息管理器，类似 ToolDelta 的 PlayerInfoMaintainer
 type PlayerManager struct {
-	mu              sync.RWMutex
-	players         map[string]*Player // key: 玩家名称
-	playersByUUID   map[string]*Player // key: UUID
-	playersByUniqueID map[int64]*Player // key: EntityUniqueID
-	botInfo         *Player
-	gameUtils       *GameUtils
+	mu                sync.RWMutex
+	players           map[string]*Player // key: 玩家名称
+	playersByUUID     map[string]*Player // key: UUID
+	playersByUniqueID map[int64]*Player  // key: EntityUniqueID
+	botInfo           *Player
+	gameUtils         *GameUtils
+	remote            playerManagerBackend // gRPC 代理后端（跨平台插件）
+}
+
+// playerManagerBackend 是跨进程插件使用的玩家查询后端
+// 本地缓存未命中时通过它向主进程查询
+type playerManagerBackend interface {
+	ListPlayers() (players []*Player, bot *Player, err error)
+	LookupPlayer(name, uuid string, uniqueID int64) (*Player, error)
 }
 
 // NewPlayerManager 创建玩家管理器
@@ -128,8 +136,17 @@ func (pm *PlayerManager) GetAllPlayers() []*Player {
 //   }
 func (pm *PlayerManager) GetBotInfo() *Player {
 	pm.mu.RLock()
-	defer pm.mu.RUnlock()
-	return pm.botInfo
+	bot := pm.botInfo
+	pm.mu.RUnlock()
+
+	if bot == nil && pm.remote != nil {
+		if err := pm.Refresh(); err == nil {
+			pm.mu.RLock()
+			bot = pm.botInfo
+			pm.mu.RUnlock()
+		}
+	}
+	return bot
 }
 
 // GetPlayerByName 根据玩家名称获取玩家对象
@@ -144,8 +161,13 @@ func (pm *PlayerManager) GetBotInfo() *Player {
 //   }
 func (pm *PlayerManager) GetPlayerByName(name string) *Player {
 	pm.mu.RLock()
-	defer pm.mu.RUnlock()
-	return pm.players[name]
+	player := pm.players[name]
+	pm.mu.RUnlock()
+
+	if player == nil && pm.remote != nil && name != "" {
+		return pm.lookupRemote(name, "", 0)
+	}
+	return player
 }
 
 // GetPlayerByUUID 根据 UUID 获取玩家对象
@@ -157,8 +179,13 @@ func (pm *PlayerManager) GetPlayerByName(name string) *Player {
 //   player := pm.GetPlayerByUUID("123e4567-e89b-12d3-a456-426614174000")
 func (pm *PlayerManager) GetPlayerByUUID(uuid string) *Player {
 	pm.mu.RLock()
-	defer pm.mu.RUnlock()
-	return pm.playersByUUID[uuid]
+	player := pm.playersByUUID[uuid]
+	pm.mu.RUnlock()
+
+	if player == nil && pm.remote != nil && uuid != "" {
+		return pm.lookupRemote("", uuid, 0)
+	}
+	return player
 }
 
 // GetPlayerByUniqueID 根据实体唯一 ID 获取玩家对象
@@ -170,8 +197,13 @@ func (pm *PlayerManager) GetPlayerByUUID(uuid string) *Player {
 //   player := pm.GetPlayerByUniqueID(1234567890)
 func (pm *PlayerManager) GetPlayerByUniqueID(uniqueID int64) *Player {
 	pm.mu.RLock()
-	defer pm.mu.RUnlock()
-	return pm.playersByUniqueID[uniqueID]
+	player := pm.playersByUniqueID[uniqueID]
+	pm.mu.RUnlock()
+
+	if player == nil && pm.remote != nil && uniqueID != 0 {
+		return pm.lookupRemote("", "", uniqueID)
+	}
+	return player
 }
 
 // GetPlayerCount 获取在线玩家数量
@@ -181,6 +213,55 @@ func (pm *PlayerManager) GetPlayerCount() int {
 	return len(pm.players)
 }
 
+// Refresh 从主进程重新同步玩家列表
+// 仅对跨平台插件有效，本地插件的玩家列表由主程序直接维护
+//
+// 示例:
+//   if err := pm.Refresh(); err != nil {
+//       ctx.LogWarning("同步玩家列表失败: %v", err)
+//   }
+func (pm *PlayerManager) Refresh() error {
+	if pm.remote == nil {
+		return nil
+	}
+
+	players, bot, err := pm.remote.ListPlayers()
+	if err != nil {
+		return err
+	}
+
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	pm.players = make(map[string]*Player, len(players))
+	pm.playersByUUID = make(map[string]*Player, len(players))
+	pm.playersByUniqueID = make(map[int64]*Player, len(players))
+	for _, player := range players {
+		player.gameUtils = pm.gameUtils
+		pm.players[player.Name] = player
+		if player.UUID != "" {
+			pm.playersByUUID[player.UUID] = player
+		}
+		if player.EntityUniqueID != 0 {
+			pm.playersByUniqueID[player.EntityUniqueID] = player
+		}
+	}
+	if bot != nil {
+		bot.gameUtils = pm.gameUtils
+		pm.botInfo = bot
+	}
+	return nil
+}
+
+// lookupRemote 本地缓存未命中时向主进程查询玩家，找到后写入缓存
+func (pm *PlayerManager) lookupRemote(name, uuid string, uniqueID int64) *Player {
+	found, err := pm.remote.LookupPlayer(name, uuid, uniqueID)
+	if err != nil || found == nil || !found.Online {
+		return nil
+	}
+	return pm.AddPlayer(found.Name, found.UUID, found.XUID, found.EntityUniqueID, found.EntityRuntimeID)
+}
+
 // Player 方法
 
 // Show 向玩家发送聊天消息
@@ -194,7 +275,7 @@ func (p *Player) Show(text string) error {
 	if p.gameUtils == nil {
 		return fmt.Errorf("GameUtils 未初始化")
 	}
-	return p.gameUtils.Tellraw(p.Name, text)
+	return p.gameUtils.Tellraw(p.target(), text)
 }
 
 // SetTitle 向玩家显示标题消息
@@ -210,20 +291,16 @@ func (p *Player) SetTitle(title string, subtitle ...string) error {
 		return fmt.Errorf("GameUtils 未初始化")
 	}
 
-	// 构建 title 命令
-	var cmd string
+	// 先发送副标题，再发送主标题
 	if len(subtitle) > 0 && subtitle[0] != "" {
-		// 先发送副标题，再发送主标题
-		p.gameUtils.SendCommand(fmt.Sprintf("title %s subtitle %s", p.Name, subtitle[0]))
-		cmd = fmt.Sprintf("title %s title %s", p.Name, title)
-	} else {
-		cmd = fmt.Sprintf("title %s title %s", p.Name, title)
+		p.gameUtils.PlayerSubtitle(p.target(), subtitle[0])
 	}
 
-	return p.gameUtils.SendCommand(cmd)
+	return p.gameUtils.PlayerTitle(p.target(), title)
 }
 
 // SetActionBar 向玩家显示 ActionBar 消息
+// 只有该玩家能看到；旧版本经 GameUtils.Title 向所有玩家显示，需要全服显示时请改用 GameUtils.Title
 //
 // text: 要显示的文本
 //
@@ -233,7 +310,34 @@ func (p *Player) SetActionBar(text string) error {
 	if p.gameUtils == nil {
 		return fmt.Errorf("GameUtils 未初始化")
 	}
-	return p.gameUtils.Title(text)
+	return p.gameUtils.PlayerActionbar(p.target(), text)
+}
+
+// TellrawComponent 向玩家发送 rawtext 消息
+//
+// 示例:
+//   player.TellrawComponent(sdk.NewRawText(
+//       sdk.PlainText("你的金币: ").Colored(sdk.TextColorGold),
+//       sdk.ScoreText("*", "money"),
+//   ))
+func (p *Player) TellrawComponent(text RawText) error {
+	if p.gameUtils == nil {
+		return fmt.Errorf("GameUtils 未初始化")
+	}
+	return p.gameUtils.TellrawComponent(p.target(), text)
+}
+
+// TitleRawComponent 向玩家显示 rawtext 标题
+//
+// kind: sdk.TitleKindTitle、sdk.TitleKindSubtitle 或 sdk.TitleKindActionbar
+//
+// 示例:
+//   player.TitleRawComponent(sdk.TitleKindTitle, sdk.NewRawText(sdk.TranslateText("%%s 欢迎你", sdk.SelectorText("@s"))))
+func (p *Player) TitleRawComponent(kind string, text RawText) error {
+	if p.gameUtils == nil {
+		return fmt.Errorf("GameUtils 未初始化")
+	}
+	return p.gameUtils.TitleRawComponent(p.target(), kind, text)
 }
 
 // GetPos 获取玩家坐标
@@ -249,7 +353,7 @@ func (p *Player) GetPos() (*Position, error) {
 	if p.gameUtils == nil {
 		return nil, fmt.Errorf("GameUtils 未初始化")
 	}
-	return p.gameUtils.GetPos(p.Name)
+	return p.gameUtils.GetPos(p.target())
 }
 
 // GetPosXYZ 获取玩家简单坐标
@@ -262,7 +366,7 @@ func (p *Player) GetPosXYZ() (float32, float32, float32, error) {
 	if p.gameUtils == nil {
 		return 0, 0, 0, fmt.Errorf("GameUtils 未初始化")
 	}
-	return p.gameUtils.GetPosXYZ(p.Name)
+	return p.gameUtils.GetPosXYZ(p.target())
 }
 
 // GetScore 获取玩家在指定计分板的分数
@@ -287,7 +391,7 @@ func (p *Player) GetScore(scbName string, timeout ...float64) (int, error) {
 		t = timeout[0]
 	}
 
-	return p.gameUtils.GetScore(scbName, p.Name, t)
+	return p.gameUtils.GetScore(scbName, p.target(), t)
 }
 
 // GetItemCount 获取玩家背包中指定物品的数量
@@ -312,7 +416,7 @@ func (p *Player) GetItemCount(itemName string, itemSpecialID ...int) (int, error
 		sid = itemSpecialID[0]
 	}
 
-	return p.gameUtils.GetItem(p.Name, itemName, sid)
+	return p.gameUtils.GetItem(p.target(), itemName, sid)
 }
 
 // IsOp 检查玩家是否有管理员权限
@@ -328,7 +432,7 @@ func (p *Player) IsOp() (bool, error) {
 	if p.gameUtils == nil {
 		return false, fmt.Errorf("GameUtils 未初始化")
 	}
-	return p.gameUtils.IsOp(p.Name)
+	return p.gameUtils.IsOp(p.target())
 }
 
 // Teleport 传送玩家到指定坐标
@@ -341,22 +445,21 @@ func (p *Player) Teleport(x, y, z float32) error {
 	if p.gameUtils == nil {
 		return fmt.Errorf("GameUtils 未初始化")
 	}
-	cmd := fmt.Sprintf("tp %s %.2f %.2f %.2f", p.Name, x, y, z)
-	return p.gameUtils.SendCommand(cmd)
+	return p.sendCommand(NewCommand("tp").Name(p.Name).Pos(x, y, z))
 }
 
 // TeleportTo 传送玩家到另一个玩家位置
 //
-// targetPlayer: 目标玩家名称
+// targetPlayer: 目标玩家名称或选择器
 //
 // 示例:
 //   player.TeleportTo("Steve")
-func (p *Player) TeleportTo(targetPlayer string) error {
+//   player.TeleportTo(sdk.NearestPlayer().NotName(player.Name))
+func (p *Player) TeleportTo(targetPlayer Target) error {
 	if p.gameUtils == nil {
 		return fmt.Errorf("GameUtils 未初始化")
 	}
-	cmd := fmt.Sprintf("tp %s %s", p.Name, targetPlayer)
-	return p.gameUtils.SendCommand(cmd)
+	return p.sendCommand(NewCommand("tp").Name(p.Name).Target(targetPlayer))
 }
 
 // SetGameMode 设置玩家游戏模式
@@ -369,8 +472,7 @@ func (p *Player) SetGameMode(mode int) error {
 	if p.gameUtils == nil {
 		return fmt.Errorf("GameUtils 未初始化")
 	}
-	cmd := fmt.Sprintf("gamemode %d %s", mode, p.Name)
-	return p.gameUtils.SendCommand(cmd)
+	return p.sendCommand(NewCommand("gamemode").Int(mode).Name(p.Name))
 }
 
 // GiveItem 给予玩家物品
@@ -391,8 +493,7 @@ func (p *Player) GiveItem(itemName string, amount int, data ...int) error {
 		dataValue = data[0]
 	}
 
-	cmd := fmt.Sprintf("give %s %s %d %d", p.Name, itemName, amount, dataValue)
-	return p.gameUtils.SendCommand(cmd)
+	return p.sendCommand(NewCommand("give").Name(p.Name).Identifier(itemName).Int(amount).Int(dataValue))
 }
 
 // ClearItem 清除玩家物品
@@ -413,16 +514,16 @@ func (p *Player) ClearItem(itemName string, maxCount ...int) error {
 		count = maxCount[0]
 	}
 
-	var cmd string
-	if itemName == "" {
-		cmd = fmt.Sprintf("clear %s", p.Name)
-	} else if count == -1 {
-		cmd = fmt.Sprintf("clear %s %s", p.Name, itemName)
-	} else {
-		cmd = fmt.Sprintf("clear %s %s %d", p.Name, itemName, count)
+	cmd := NewCommand("clear").Name(p.Name)
+	if itemName != "" {
+		cmd.Identifier(itemName)
+		if count != -1 {
+			// clear 命令在数量前需要数据值，-1 表示任意数据值
+			cmd.Int(-1).Int(count)
+		}
 	}
 
-	return p.gameUtils.SendCommand(cmd)
+	return p.sendCommand(cmd)
 }
 
 // AddEffect 给予玩家药水效果
@@ -439,13 +540,7 @@ func (p *Player) AddEffect(effect string, duration int, amplifier int, hideParti
 		return fmt.Errorf("GameUtils 未初始化")
 	}
 
-	hideFlag := "false"
-	if hideParticles {
-		hideFlag = "true"
-	}
-
-	cmd := fmt.Sprintf("effect %s %s %d %d %s", p.Name, effect, duration, amplifier, hideFlag)
-	return p.gameUtils.SendCommand(cmd)
+	return p.sendCommand(NewCommand("effect").Name(p.Name).Identifier(effect).Int(duration).Int(amplifier).Bool(hideParticles))
 }
 
 // ClearEffects 清除玩家所有药水效果
@@ -456,8 +551,7 @@ func (p *Player) ClearEffects() error {
 	if p.gameUtils == nil {
 		return fmt.Errorf("GameUtils 未初始化")
 	}
-	cmd := fmt.Sprintf("effect %s clear", p.Name)
-	return p.gameUtils.SendCommand(cmd)
+	return p.sendCommand(NewCommand("effect").Name(p.Name).Keyword("clear"))
 }
 
 // Kill 杀死玩家
@@ -468,8 +562,7 @@ func (p *Player) Kill() error {
 	if p.gameUtils == nil {
 		return fmt.Errorf("GameUtils 未初始化")
 	}
-	cmd := fmt.Sprintf("kill %s", p.Name)
-	return p.gameUtils.SendCommand(cmd)
+	return p.sendCommand(NewCommand("kill").Name(p.Name))
 }
 
 // Kick 踢出玩家
@@ -483,12 +576,24 @@ func (p *Player) Kick(reason ...string) error {
 		return fmt.Errorf("GameUtils 未初始化")
 	}
 
-	var cmd string
+	cmd := NewCommand("kick").Name(p.Name)
 	if len(reason) > 0 && reason[0] != "" {
-		cmd = fmt.Sprintf("kick %s %s", p.Name, reason[0])
-	} else {
-		cmd = fmt.Sprintf("kick %s", p.Name)
+		cmd.Message(reason[0])
 	}
 
-	return p.gameUtils.SendCommand(cmd)
+	return p.sendCommand(cmd)
+}
+
+// target 返回在命令中指代该玩家的参数，名称以 @ 开头或含空格时同样不会被当作选择器
+func (p *Player) target() string {
+	return QuoteName(p.Name)
+}
+
+// sendCommand 构建并发送命令
+func (p *Player) sendCommand(cmd *Command) error {
+	built, err := cmd.Build()
+	if err != nil {
+		return err
+	}
+	return p.gameUtils.SendCommand(built)
 }
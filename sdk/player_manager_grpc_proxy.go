@@ -0,0 +1,88 @@
+package sdk
+
+import (
+	"errors"
+)
+
+// playerManagerGRPCProxy 是 PlayerManager 的 gRPC 代理后端
+// 运行在插件进程中，向主进程的 ContextServer 查询玩家信息
+type playerManagerGRPCProxy struct {
+	client ContextServiceClient
+	calls  *callPolicy
+}
+
+// newGRPCPlayerManager 创建由 gRPC 代理驱动的 PlayerManager
+// 玩家对象上的操作（Show、Teleport 等）通过 gameUtils 转发到主进程
+func newGRPCPlayerManager(client ContextServiceClient, calls *callPolicy, gameUtils *GameUtils) *PlayerManager {
+	pm := NewPlayerManager(gameUtils)
+	pm.remote = &playerManagerGRPCProxy{client: client, calls: calls}
+	return pm
+}
+
+func (p *playerManagerGRPCProxy) ListPlayers() ([]*Player, *Player, error) {
+	callCtx, done := p.calls.start("ListPlayers")
+	resp, err := p.client.ListPlayers(callCtx, &Empty{})
+	done(err)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !resp.Success {
+		return nil, nil, errors.New(resp.Error)
+	}
+
+	players := make([]*Player, 0, len(resp.Players))
+	for _, info := range resp.Players {
+		players = append(players, playerFromInfo(info))
+	}
+	return players, playerFromInfo(resp.Bot), nil
+}
+
+func (p *playerManagerGRPCProxy) LookupPlayer(name, uuid string, uniqueID int64) (*Player, error) {
+	callCtx, done := p.calls.start("GetPlayer")
+	resp, err := p.client.GetPlayer(callCtx, &GetPlayerRequest{
+		Name:           name,
+		Uuid:           uuid,
+		EntityUniqueId: uniqueID,
+	})
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, errors.New(resp.Error)
+	}
+	if !resp.Found {
+		return nil, nil
+	}
+	return playerFromInfo(resp.Player), nil
+}
+
+// playerToInfo 将 Player 转换为 PlayerInfo 消息
+func playerToInfo(player *Player) *PlayerInfo {
+	if player == nil {
+		return nil
+	}
+	return &PlayerInfo{
+		Name:            player.Name,
+		Uuid:            player.UUID,
+		Xuid:            player.XUID,
+		EntityUniqueId:  player.EntityUniqueID,
+		EntityRuntimeId: player.EntityRuntimeID,
+		Online:          player.Online,
+	}
+}
+
+// playerFromInfo 将 PlayerInfo 消息转换为 Player（不含 gameUtils）
+func playerFromInfo(info *PlayerInfo) *Player {
+	if info == nil {
+		return nil
+	}
+	return &Player{
+		Name:            info.Name,
+		UUID:            info.Uuid,
+		XUID:            info.Xuid,
+		EntityUniqueID:  info.EntityUniqueId,
+		EntityRuntimeID: info.EntityRuntimeId,
+		Online:          info.Online,
+	}
+}
@@ -1,10 +1,13 @@
 package sdk
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -48,6 +51,8 @@ type PlayerEvent struct {
 	BuildPlatform   int32
 	Raw             any
 	EntryIndex      int
+
+	ctx context.Context
 }
 
 type PlayerEventHandler func(PlayerEvent)
@@ -59,6 +64,8 @@ type ChatEvent struct {
 	Parameters []string
 	Raw        any
 	Cancelled  bool // 插件可设置为 true 来取消事件传播（如取消转发到 QQ）
+
+	ctx context.Context
 }
 
 type ChatHandler func(*ChatEvent)
@@ -66,27 +73,73 @@ type ChatHandler func(*ChatEvent)
 type FrameExitEvent struct {
 	Signal string
 	Reason string
+
+	ctx context.Context
 }
 
 type FrameExitHandler func(FrameExitEvent)
 
 type PacketEvent struct {
-	ID  uint32
-	Raw any
+	ID   uint32
+	Raw  any
+	Data []byte // 原始协议字节（主进程提供时有效，跨平台插件需为该 ID 注册解码器）
+
+	ctx context.Context
 }
 
 type PacketHandler func(PacketEvent)
 
+// BytesPacketHandler 二进制数据包处理器
+// data 为原始协议字节；返回 true 表示拦截，阻止低优先级处理器与主程序的默认处理
+type BytesPacketHandler func(packetID uint32, data []byte) bool
+
 // Broadcast 表示插件间广播的事件
 type Broadcast struct {
 	Name string                 // 事件名称（如 "player.teleport", "economy.trade"）
 	Data map[string]interface{} // 事件附加数据
+
+	ctx context.Context
 }
 
 // BroadcastHandler 广播事件处理器
 // 返回值可以被广播发送者收集
 type BroadcastHandler func(Broadcast) interface{}
 
+// eventContext 返回事件的处理器 context，未设置时返回 context.Background()
+func eventContext(ctx context.Context) context.Context {
+	if ctx == nil {
+		return context.Background()
+	}
+	return ctx
+}
+
+// Context 返回处理该事件时可用的 context
+// 跨平台（gRPC）插件中，主进程的回调超时、主进程取消调用或插件停止时该 context 会被取消，
+// 处理器中的耗时操作应监听 Done() 及时退出；同进程插件返回 context.Background()
+//
+// 示例:
+//
+//	ctx.ListenChat(func(evt *sdk.ChatEvent) {
+//	    select {
+//	    case result := <-lookup(evt.Sender):
+//	        evt.Message = result
+//	    case <-evt.Context().Done():
+//	    }
+//	})
+func (e *ChatEvent) Context() context.Context { return eventContext(e.ctx) }
+
+// Context 返回处理该事件时可用的 context，参见 ChatEvent.Context
+func (e PlayerEvent) Context() context.Context { return eventContext(e.ctx) }
+
+// Context 返回处理该事件时可用的 context，参见 ChatEvent.Context
+func (e PacketEvent) Context() context.Context { return eventContext(e.ctx) }
+
+// Context 返回处理该事件时可用的 context，参见 ChatEvent.Context
+func (e FrameExitEvent) Context() context.Context { return eventContext(e.ctx) }
+
+// Context 返回处理该事件时可用的 context，参见 ChatEvent.Context
+func (b Broadcast) Context() context.Context { return eventContext(b.ctx) }
+
 type BotInfo struct {
 	Name            string
 	XUID            string
@@ -118,31 +171,75 @@ type ContextOptions struct {
 	GameUtilsProvider     func() *GameUtils
 	PlayerManagerProvider func() *PlayerManager
 	PacketWaiterProvider  func() *PacketWaiter
+	InventoryProvider     func() *InventoryTracker // 主进程统一跟踪的背包；nil 时插件在首次调用 Inventory 时自行监听背包数据包
+	WorldProvider         func() *World            // 主进程提供的方块查询器；nil 时插件在首次调用 World 时自行监听结构数据响应
 	APIRegistryProvider   func() *PluginAPIRegistry
 	ConsoleRegistrar      func(ConsoleCommand) error
+	ConsoleUnregistrar    func(ConsoleCommand) // 移除 ConsoleRegistrar 注册的命令；nil 时命令无法移除
 	Logger                func(format string, args ...interface{})
-	RegisterPreload       func(PreloadHandler, int) error  // 添加优先级参数
-	RegisterActive        func(ActiveHandler, int) error
-	RegisterPlayerJoin    func(PlayerEventHandler, int) error
-	RegisterPlayerLeave   func(PlayerEventHandler, int) error
-	RegisterChat          func(ChatHandler, int) error
-	RegisterFrameExit     func(FrameExitHandler, int) error
-	RegisterPacket        func(PacketHandler, []uint32, int) error
-	RegisterPacketAll     func(PacketHandler, int) error
-	CancelChatMessage     func(sender, message string)          // 取消聊天消息转发到 QQ
-	WaitPlayerMessage     func(playerName string, timeout time.Duration) (string, error) // 等待玩家发送消息
-	RegisterBroadcast     func(name string, handler BroadcastHandler, priority int) error // 注册广播监听器
-	TriggerBroadcast      func(broadcast Broadcast) []interface{} // 触发广播事件
+	// 事件注册函数返回用于移除该监听器的函数（可以为 nil）
+	RegisterPreload     func(PreloadHandler, int) (func(), error) // 添加优先级参数
+	RegisterActive      func(ActiveHandler, int) (func(), error)
+	RegisterPlayerJoin  func(PlayerEventHandler, int) (func(), error)
+	RegisterPlayerLeave func(PlayerEventHandler, int) (func(), error)
+	RegisterChat        func(ChatHandler, int) (func(), error)
+	RegisterFrameExit   func(FrameExitHandler, int) (func(), error)
+	RegisterPacket      func(PacketHandler, []uint32, int) (func(), error)
+	RegisterPacketAll   func(PacketHandler, int) (func(), error)
+	RegisterBytesPacket func(BytesPacketHandler, []uint32, int) (func(), error)
+	CancelChatMessage   func(sender, message string)                                   // 取消聊天消息转发到 QQ
+	WaitPlayerMessage   func(playerName string, timeout time.Duration) (string, error) // 等待玩家发送消息
+	// 可取消的等待玩家消息，ctx 结束时必须移除等待者；设置后优先于 WaitPlayerMessage
+	WaitPlayerMessageContext func(ctx context.Context, playerName string, timeout time.Duration) (string, error)
+	RegisterBroadcast        func(name string, handler BroadcastHandler, priority int) (func(), error) // 注册广播监听器
+	TriggerBroadcast         func(broadcast Broadcast) []interface{}                                   // 触发广播事件
+	// 主进程公布的能力（跨平台插件在 Init 时协商得到）；nil 表示能力未知（同进程运行或协议版本 1 的主进程），按支持 SDK 的全部能力处理
+	HostCapabilities []string
 }
 
 type Context struct {
 	opts ContextOptions
+
+	inventoryOnce sync.Once
+	inventory     *InventoryTracker
+	worldOnce     sync.Once
+	world         *World
 }
 
 func NewContext(opts ContextOptions) *Context {
 	return &Context{opts: opts}
 }
 
+// HostCapabilities 返回主进程支持的能力列表，见 sdk.Capabilities
+func (c *Context) HostCapabilities() []string {
+	if c == nil {
+		return nil
+	}
+	if c.opts.HostCapabilities == nil {
+		return slices.Clone(Capabilities)
+	}
+	return slices.Clone(c.opts.HostCapabilities)
+}
+
+// HostSupports 判断主进程是否支持指定能力
+//
+// 示例:
+//
+//	if ctx.HostSupports(sdk.CapabilityBytesPacket) {
+//	    ctx.ListenBytesPacket(handler, packetID)
+//	}
+func (c *Context) HostSupports(capability string) bool {
+	return slices.Contains(c.HostCapabilities(), capability)
+}
+
+// requireHost 在主进程不支持指定能力时返回 ErrNotSupportedByHost
+func (c *Context) requireHost(capability string) error {
+	if !c.HostSupports(capability) {
+		return notSupportedByHost(capability)
+	}
+	return nil
+}
+
 func (c *Context) PluginName() string {
 	return c.opts.PluginName
 }
@@ -185,11 +282,17 @@ func (c *Context) InterworkInfo() InterworkInfo {
 }
 
 func (c *Context) RegisterConsoleCommand(cmd ConsoleCommand) error {
+	_, err := c.registerConsoleCommand(cmd)
+	return err
+}
+
+// registerConsoleCommand 注册控制台命令，返回移除该命令的函数（主进程不支持移除时为 nil）
+func (c *Context) registerConsoleCommand(cmd ConsoleCommand) (func(), error) {
 	if c == nil || c.opts.ConsoleRegistrar == nil {
-		return fmt.Errorf("控制台命令注册未启用")
+		return nil, fmt.Errorf("控制台命令注册未启用")
 	}
 	if cmd.Handler == nil {
-		return fmt.Errorf("命令处理器不能为空")
+		return nil, fmt.Errorf("命令处理器不能为空")
 	}
 	if len(cmd.Triggers) == 0 {
 		name := strings.TrimSpace(cmd.Name)
@@ -198,7 +301,7 @@ func (c *Context) RegisterConsoleCommand(cmd ConsoleCommand) error {
 		}
 	}
 	if len(cmd.Triggers) == 0 {
-		return fmt.Errorf("命令触发词不能为空")
+		return nil, fmt.Errorf("命令触发词不能为空")
 	}
 	seen := make(map[string]struct{}, len(cmd.Triggers))
 	normalized := make([]string, 0, len(cmd.Triggers))
@@ -215,13 +318,19 @@ func (c *Context) RegisterConsoleCommand(cmd ConsoleCommand) error {
 		normalized = append(normalized, trimmed)
 	}
 	if len(normalized) == 0 {
-		return fmt.Errorf("命令触发词不能为空")
+		return nil, fmt.Errorf("命令触发词不能为空")
 	}
 	cmd.Triggers = normalized
 	if strings.TrimSpace(cmd.Name) == "" {
 		cmd.Name = cmd.Triggers[0]
 	}
-	return c.opts.ConsoleRegistrar(cmd)
+	if err := c.opts.ConsoleRegistrar(cmd); err != nil {
+		return nil, err
+	}
+	if c.opts.ConsoleUnregistrar == nil {
+		return nil, nil
+	}
+	return func() { c.opts.ConsoleUnregistrar(cmd) }, nil
 }
 
 func (c *Context) Logf(format string, args ...interface{}) {
@@ -288,117 +397,180 @@ func (c *Context) LogError(format string, args ...interface{}) {
 }
 
 // ListenPreload 监听预加载事件（默认优先级 0）
-func (c *Context) ListenPreload(handler PreloadHandler) error {
+func (c *Context) ListenPreload(handler PreloadHandler) (*ListenerHandle, error) {
 	return c.ListenPreloadWithPriority(handler, 0)
 }
 
 // ListenPreloadWithPriority 监听预加载事件（指定优先级）
 // priority: 优先级，数值越大越先执行
-func (c *Context) ListenPreloadWithPriority(handler PreloadHandler, priority int) error {
+func (c *Context) ListenPreloadWithPriority(handler PreloadHandler, priority int) (*ListenerHandle, error) {
 	if c == nil || c.opts.RegisterPreload == nil {
-		return fmt.Errorf("预加载事件注册未启用")
+		return nil, fmt.Errorf("预加载事件注册未启用")
 	}
 	if handler == nil {
-		return fmt.Errorf("预加载事件处理器不能为空")
+		return nil, fmt.Errorf("预加载事件处理器不能为空")
 	}
-	return c.opts.RegisterPreload(handler, priority)
+	unregister, err := c.opts.RegisterPreload(handler, priority)
+	if err != nil {
+		return nil, err
+	}
+	return newListenerHandle(unregister), nil
 }
 
 // ListenActive 监听激活事件（默认优先级 0）
-func (c *Context) ListenActive(handler ActiveHandler) error {
+func (c *Context) ListenActive(handler ActiveHandler) (*ListenerHandle, error) {
 	return c.ListenActiveWithPriority(handler, 0)
 }
 
 // ListenActiveWithPriority 监听激活事件（指定优先级）
-func (c *Context) ListenActiveWithPriority(handler ActiveHandler, priority int) error {
+func (c *Context) ListenActiveWithPriority(handler ActiveHandler, priority int) (*ListenerHandle, error) {
 	if c == nil || c.opts.RegisterActive == nil {
-		return fmt.Errorf("激活事件注册未启用")
+		return nil, fmt.Errorf("激活事件注册未启用")
 	}
 	if handler == nil {
-		return fmt.Errorf("激活事件处理器不能为空")
+		return nil, fmt.Errorf("激活事件处理器不能为空")
 	}
-	return c.opts.RegisterActive(handler, priority)
+	unregister, err := c.opts.RegisterActive(handler, priority)
+	if err != nil {
+		return nil, err
+	}
+	return newListenerHandle(unregister), nil
 }
 
 // ListenPlayerJoin 监听玩家加入事件（默认优先级 0）
-func (c *Context) ListenPlayerJoin(handler PlayerEventHandler) error {
+func (c *Context) ListenPlayerJoin(handler PlayerEventHandler) (*ListenerHandle, error) {
 	return c.ListenPlayerJoinWithPriority(handler, 0)
 }
 
 // ListenPlayerJoinWithPriority 监听玩家加入事件（指定优先级）
-func (c *Context) ListenPlayerJoinWithPriority(handler PlayerEventHandler, priority int) error {
+func (c *Context) ListenPlayerJoinWithPriority(handler PlayerEventHandler, priority int) (*ListenerHandle, error) {
 	if c == nil || c.opts.RegisterPlayerJoin == nil {
-		return fmt.Errorf("玩家加入事件注册未启用")
+		return nil, fmt.Errorf("玩家加入事件注册未启用")
 	}
 	if handler == nil {
-		return fmt.Errorf("玩家加入事件处理器不能为空")
+		return nil, fmt.Errorf("玩家加入事件处理器不能为空")
+	}
+	unregister, err := c.opts.RegisterPlayerJoin(handler, priority)
+	if err != nil {
+		return nil, err
 	}
-	return c.opts.RegisterPlayerJoin(handler, priority)
+	return newListenerHandle(unregister), nil
 }
 
 // ListenPlayerLeave 监听玩家离开事件（默认优先级 0）
-func (c *Context) ListenPlayerLeave(handler PlayerEventHandler) error {
+func (c *Context) ListenPlayerLeave(handler PlayerEventHandler) (*ListenerHandle, error) {
 	return c.ListenPlayerLeaveWithPriority(handler, 0)
 }
 
 // ListenPlayerLeaveWithPriority 监听玩家离开事件（指定优先级）
-func (c *Context) ListenPlayerLeaveWithPriority(handler PlayerEventHandler, priority int) error {
+func (c *Context) ListenPlayerLeaveWithPriority(handler PlayerEventHandler, priority int) (*ListenerHandle, error) {
 	if c == nil || c.opts.RegisterPlayerLeave == nil {
-		return fmt.Errorf("玩家离开事件注册未启用")
+		return nil, fmt.Errorf("玩家离开事件注册未启用")
 	}
 	if handler == nil {
-		return fmt.Errorf("玩家离开事件处理器不能为空")
+		return nil, fmt.Errorf("玩家离开事件处理器不能为空")
 	}
-	return c.opts.RegisterPlayerLeave(handler, priority)
+	unregister, err := c.opts.RegisterPlayerLeave(handler, priority)
+	if err != nil {
+		return nil, err
+	}
+	return newListenerHandle(unregister), nil
 }
 
 // ListenChat 监听聊天事件（默认优先级 0）
-func (c *Context) ListenChat(handler ChatHandler) error {
+// 跨平台插件的事件投递顺序与失败处理见 ListenerHandle
+func (c *Context) ListenChat(handler ChatHandler) (*ListenerHandle, error) {
 	return c.ListenChatWithPriority(handler, 0)
 }
 
 // ListenChatWithPriority 监听聊天事件（指定优先级）
 // priority: 优先级，数值越大越先执行，可用于拦截消息
-func (c *Context) ListenChatWithPriority(handler ChatHandler, priority int) error {
+func (c *Context) ListenChatWithPriority(handler ChatHandler, priority int) (*ListenerHandle, error) {
 	if c == nil || c.opts.RegisterChat == nil {
-		return fmt.Errorf("聊天事件注册未启用")
+		return nil, fmt.Errorf("聊天事件注册未启用")
 	}
 	if handler == nil {
-		return fmt.Errorf("聊天事件处理器不能为空")
+		return nil, fmt.Errorf("聊天事件处理器不能为空")
+	}
+	unregister, err := c.opts.RegisterChat(handler, priority)
+	if err != nil {
+		return nil, err
 	}
-	return c.opts.RegisterChat(handler, priority)
+	return newListenerHandle(unregister), nil
 }
 
 // ListenFrameExit 监听框架退出事件（默认优先级 0）
-func (c *Context) ListenFrameExit(handler FrameExitHandler) error {
+func (c *Context) ListenFrameExit(handler FrameExitHandler) (*ListenerHandle, error) {
 	return c.ListenFrameExitWithPriority(handler, 0)
 }
 
 // ListenFrameExitWithPriority 监听框架退出事件（指定优先级）
-func (c *Context) ListenFrameExitWithPriority(handler FrameExitHandler, priority int) error {
+func (c *Context) ListenFrameExitWithPriority(handler FrameExitHandler, priority int) (*ListenerHandle, error) {
 	if c == nil || c.opts.RegisterFrameExit == nil {
-		return fmt.Errorf("退出事件注册未启用")
+		return nil, fmt.Errorf("退出事件注册未启用")
 	}
 	if handler == nil {
-		return fmt.Errorf("退出事件处理器不能为空")
+		return nil, fmt.Errorf("退出事件处理器不能为空")
+	}
+	unregister, err := c.opts.RegisterFrameExit(handler, priority)
+	if err != nil {
+		return nil, err
 	}
-	return c.opts.RegisterFrameExit(handler, priority)
+	return newListenerHandle(unregister), nil
 }
 
 // ListenPacket 监听数据包事件（默认优先级 0）
-func (c *Context) ListenPacket(handler PacketHandler, packetIDs ...uint32) error {
+func (c *Context) ListenPacket(handler PacketHandler, packetIDs ...uint32) (*ListenerHandle, error) {
 	return c.ListenPacketWithPriority(handler, 0, packetIDs...)
 }
 
 // ListenPacketWithPriority 监听数据包事件（指定优先级）
-func (c *Context) ListenPacketWithPriority(handler PacketHandler, priority int, packetIDs ...uint32) error {
+func (c *Context) ListenPacketWithPriority(handler PacketHandler, priority int, packetIDs ...uint32) (*ListenerHandle, error) {
 	if c == nil || c.opts.RegisterPacket == nil {
-		return fmt.Errorf("数据包事件注册未启用")
+		return nil, fmt.Errorf("数据包事件注册未启用")
+	}
+	if handler == nil {
+		return nil, fmt.Errorf("数据包事件处理器不能为空")
+	}
+	unregister, err := c.opts.RegisterPacket(handler, packetIDs, priority)
+	if err != nil {
+		return nil, err
+	}
+	return newListenerHandle(unregister), nil
+}
+
+// ListenBytesPacket 监听二进制数据包（默认优先级 0）
+// 处理器直接接收原始协议字节，不经过反射与 JSON 转换，适合高频数据包
+//
+// 示例:
+//   ctx.ListenBytesPacket(func(packetID uint32, data []byte) bool {
+//       // 处理原始字节数据
+//       return false // true 表示拦截
+//   }, packet.IDMovePlayer)
+func (c *Context) ListenBytesPacket(handler BytesPacketHandler, packetIDs ...uint32) (*ListenerHandle, error) {
+	return c.ListenBytesPacketWithPriority(handler, 0, packetIDs...)
+}
+
+// ListenBytesPacketWithPriority 监听二进制数据包（指定优先级）
+// priority: 优先级，数值越大越先执行，高优先级处理器返回 true 时后续处理器不再收到该数据包
+func (c *Context) ListenBytesPacketWithPriority(handler BytesPacketHandler, priority int, packetIDs ...uint32) (*ListenerHandle, error) {
+	if c == nil || c.opts.RegisterBytesPacket == nil {
+		return nil, fmt.Errorf("二进制数据包事件注册未启用")
 	}
 	if handler == nil {
-		return fmt.Errorf("数据包事件处理器不能为空")
+		return nil, fmt.Errorf("二进制数据包处理器不能为空")
+	}
+	if len(packetIDs) == 0 {
+		return nil, fmt.Errorf("至少需要指定一个数据包 ID")
 	}
-	return c.opts.RegisterPacket(handler, packetIDs, priority)
+	if err := c.requireHost(CapabilityBytesPacket); err != nil {
+		return nil, err
+	}
+	unregister, err := c.opts.RegisterBytesPacket(handler, packetIDs, priority)
+	if err != nil {
+		return nil, err
+	}
+	return newListenerHandle(unregister), nil
 }
 
 func (c *Context) GameUtils() *GameUtils {
@@ -450,20 +622,89 @@ func (c *Context) PacketWaiter() *PacketWaiter {
 	return c.opts.PacketWaiterProvider()
 }
 
+// Inventory 返回机器人的背包跟踪器，GameUtils().GetInventory 同样使用它
+// 主进程未提供时，首次调用会通过 ListenPacket 监听背包数据包，
+// 因此应在 Init 中调用，以免错过进入服务器时下发的 InventoryContent
+//
+// 示例:
+//   inv := ctx.Inventory()
+//   inv.OnChange(func(change sdk.InventoryChange) {
+//       ctx.Logf("窗口 %d 有 %d 个槽位变化", change.WindowID, len(change.Slots))
+//   })
+func (c *Context) Inventory() *InventoryTracker {
+	if c == nil {
+		return nil
+	}
+	c.inventoryOnce.Do(func() {
+		if c.opts.InventoryProvider != nil {
+			c.inventory = c.opts.InventoryProvider()
+		}
+		if c.inventory == nil {
+			c.inventory = NewInventoryTracker()
+			if _, err := c.inventory.Attach(c); err != nil {
+				c.LogWarning("背包跟踪未启用: %v", err)
+			}
+		}
+		if gu := c.GameUtils(); gu != nil {
+			gu.SetInventoryTracker(c.inventory)
+		}
+	})
+	return c.inventory
+}
+
+// World 返回方块查询器，GameUtils().GetBlock / GetBlocks 同样使用它
+// 主进程未提供时，首次调用会通过 ListenPacket 监听 StructureTemplateDataResponse
+//
+// 示例:
+//   region, err := ctx.World().GetBlocks(sdk.BlockPos{0, 60, 0}, sdk.BlockPos{15, 70, 15})
+//   if err == nil {
+//       block, _ := region.At(sdk.BlockPos{0, 64, 0})
+//       ctx.Logf("方块: %s", block.Name)
+//   }
+func (c *Context) World() *World {
+	if c == nil {
+		return nil
+	}
+	c.worldOnce.Do(func() {
+		if c.opts.WorldProvider != nil {
+			c.world = c.opts.WorldProvider()
+		}
+		gu := c.GameUtils()
+		if c.world == nil {
+			var send func(uint32, interface{}) error
+			if gu != nil {
+				send = gu.SendPacket
+			}
+			c.world = NewWorld(send)
+			if _, err := c.world.Attach(c); err != nil {
+				c.LogWarning("方块查询未启用: %v", err)
+			}
+		}
+		if gu != nil {
+			gu.SetWorld(c.world)
+		}
+	})
+	return c.world
+}
+
 // ListenPacketAll 监听所有数据包事件（默认优先级 0）
-func (c *Context) ListenPacketAll(handler PacketHandler) error {
+func (c *Context) ListenPacketAll(handler PacketHandler) (*ListenerHandle, error) {
 	return c.ListenPacketAllWithPriority(handler, 0)
 }
 
 // ListenPacketAllWithPriority 监听所有数据包事件（指定优先级）
-func (c *Context) ListenPacketAllWithPriority(handler PacketHandler, priority int) error {
+func (c *Context) ListenPacketAllWithPriority(handler PacketHandler, priority int) (*ListenerHandle, error) {
 	if c == nil || c.opts.RegisterPacketAll == nil {
-		return fmt.Errorf("全部数据包监听未启用")
+		return nil, fmt.Errorf("全部数据包监听未启用")
 	}
 	if handler == nil {
-		return fmt.Errorf("数据包事件处理器不能为空")
+		return nil, fmt.Errorf("数据包事件处理器不能为空")
 	}
-	return c.opts.RegisterPacketAll(handler, priority)
+	unregister, err := c.opts.RegisterPacketAll(handler, priority)
+	if err != nil {
+		return nil, err
+	}
+	return newListenerHandle(unregister), nil
 }
 
 // GetPluginAPI 获取其他插件的 API
@@ -535,6 +776,75 @@ func (c *Context) RegisterPluginAPI(name string, version PluginAPIVersion, plugi
 	return registry.Register(name, version, plugin)
 }
 
+// ExportPluginAPI 导出可被其他插件调用的 API 方法
+// 与 RegisterPluginAPI 不同，导出的方法可以跨进程调用，调用方通过 GetPluginAPIClient 按方法名调用
+// name: API 名称
+// version: API 版本
+// methods: 方法名到处理函数的映射，可使用 JSONMethod / ProtoMethod 包装强类型函数
+//
+// 注意: 应在 Init 方法中调用，确保在其他插件访问前完成注册
+//
+// 示例:
+//   err := ctx.ExportPluginAPI("example-api", sdk.PluginAPIVersion{0, 0, 1}, sdk.PluginAPIMethods{
+//       "Greet": sdk.JSONMethod(func(name string) (string, error) {
+//           return "Hello, " + name, nil
+//       }),
+//   })
+func (c *Context) ExportPluginAPI(name string, version PluginAPIVersion, methods PluginAPIMethods) error {
+	registry, err := c.apiRegistry()
+	if err != nil {
+		return err
+	}
+	return registry.RegisterMethods(name, version, methods)
+}
+
+// GetPluginAPIClient 获取其他插件导出的 API 调用客户端
+// name: API 名称
+// 返回: 调用客户端、错误
+//
+// 示例:
+//   client, err := ctx.GetPluginAPIClient("example-api")
+//   if err != nil {
+//       return err
+//   }
+//   var message string
+//   err = client.CallJSON("Greet", "Steve", &message)
+func (c *Context) GetPluginAPIClient(name string) (*PluginAPIClient, error) {
+	registry, err := c.apiRegistry()
+	if err != nil {
+		return nil, err
+	}
+	return registry.Client(name)
+}
+
+// GetPluginAPIClientWithVersion 获取指定版本的 API 调用客户端
+// version: 所需版本（主版本号必须相同，次版本号必须大于等于），每次调用时都会重新检查
+//
+// 示例:
+//   client, err := ctx.GetPluginAPIClientWithVersion("example-api", sdk.PluginAPIVersion{0, 0, 1})
+func (c *Context) GetPluginAPIClientWithVersion(name string, version PluginAPIVersion) (*PluginAPIClient, error) {
+	registry, err := c.apiRegistry()
+	if err != nil {
+		return nil, err
+	}
+	return registry.ClientWithVersion(name, version)
+}
+
+// apiRegistry 获取插件 API 注册表
+func (c *Context) apiRegistry() (*PluginAPIRegistry, error) {
+	if c == nil || c.opts.APIRegistryProvider == nil {
+		return nil, fmt.Errorf("插件 API 注册表未启用")
+	}
+	if err := c.requireHost(CapabilityPluginAPI); err != nil {
+		return nil, err
+	}
+	registry := c.opts.APIRegistryProvider()
+	if registry == nil {
+		return nil, fmt.Errorf("插件 API 注册表未初始化")
+	}
+	return registry, nil
+}
+
 // ListPluginAPIs 列出所有已注册的插件 API
 // 返回: API 信息列表
 //
@@ -671,10 +981,43 @@ func (c *Context) CancelMessage(sender, message string) {
 //       return
 //   }
 func (c *Context) WaitMessage(playerName string, timeout time.Duration) (string, error) {
-	if c == nil || c.opts.WaitPlayerMessage == nil {
+	return c.WaitMessageContext(context.Background(), playerName, timeout)
+}
+
+// WaitMessageContext 与 WaitMessage 相同，但 ctx 结束时立即返回 ctx.Err()
+// 放弃等待后主进程不再把该玩家的下一条消息交给这次等待
+//
+// 示例:
+//
+//	waitCtx, cancel := context.WithCancel(evt.Context())
+//	defer cancel()
+//	choice, err := ctx.WaitMessageContext(waitCtx, player, 30*time.Second)
+func (c *Context) WaitMessageContext(ctx context.Context, playerName string, timeout time.Duration) (string, error) {
+	if c == nil || (c.opts.WaitPlayerMessageContext == nil && c.opts.WaitPlayerMessage == nil) {
 		return "", fmt.Errorf("等待消息功能未启用")
 	}
-	return c.opts.WaitPlayerMessage(playerName, timeout)
+	if c.opts.WaitPlayerMessageContext != nil {
+		return c.opts.WaitPlayerMessageContext(ctx, playerName, timeout)
+	}
+	if ctx.Done() == nil {
+		return c.opts.WaitPlayerMessage(playerName, timeout)
+	}
+	// 主进程不支持取消时只能放弃结果，等待者会保留到超时
+	type result struct {
+		message string
+		err     error
+	}
+	done := make(chan result, 1)
+	go func() {
+		message, err := c.opts.WaitPlayerMessage(playerName, timeout)
+		done <- result{message, err}
+	}()
+	select {
+	case r := <-done:
+		return r.message, r.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
 }
 
 // ListenBroadcast 监听指定名称的广播事件（默认优先级）
@@ -687,7 +1030,7 @@ func (c *Context) WaitMessage(playerName string, timeout time.Duration) (string,
 //       ctx.Logf("玩家 %s 传送了", player)
 //       return nil
 //   })
-func (c *Context) ListenBroadcast(name string, handler BroadcastHandler) error {
+func (c *Context) ListenBroadcast(name string, handler BroadcastHandler) (*ListenerHandle, error) {
 	return c.ListenBroadcastWithPriority(name, handler, 0)
 }
 
@@ -701,17 +1044,21 @@ func (c *Context) ListenBroadcast(name string, handler BroadcastHandler) error {
 //       // 高优先级处理逻辑
 //       return "processed"
 //   }, 100)
-func (c *Context) ListenBroadcastWithPriority(name string, handler BroadcastHandler, priority int) error {
+func (c *Context) ListenBroadcastWithPriority(name string, handler BroadcastHandler, priority int) (*ListenerHandle, error) {
 	if c == nil || c.opts.RegisterBroadcast == nil {
-		return fmt.Errorf("广播事件注册未启用")
+		return nil, fmt.Errorf("广播事件注册未启用")
 	}
 	if handler == nil {
-		return fmt.Errorf("广播事件处理器不能为空")
+		return nil, fmt.Errorf("广播事件处理器不能为空")
 	}
 	if name == "" {
-		return fmt.Errorf("广播事件名称不能为空")
+		return nil, fmt.Errorf("广播事件名称不能为空")
+	}
+	unregister, err := c.opts.RegisterBroadcast(name, handler, priority)
+	if err != nil {
+		return nil, err
 	}
-	return c.opts.RegisterBroadcast(name, handler, priority)
+	return newListenerHandle(unregister), nil
 }
 
 // Broadcast 触发广播事件，将事件发送给所有监听者
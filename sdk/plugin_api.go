@@ -1,8 +1,12 @@
 package sdk
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
 	"sync"
+
+	"google.golang.org/protobuf/proto"
 )
 
 // PluginAPIVersion 插件 API 版本
@@ -46,17 +50,86 @@ func (v PluginAPIVersion) IsCompatible(other PluginAPIVersion) bool {
 	return v.Major == other.Major
 }
 
+// PluginAPIMethod 可跨进程调用的 API 方法
+// 参数与返回值均为序列化后的字节，编码方式（JSON 或 protobuf）由 API 提供方与调用方约定
+type PluginAPIMethod func(args []byte) ([]byte, error)
+
+// PluginAPIMethods 方法名到 API 方法的映射
+type PluginAPIMethods map[string]PluginAPIMethod
+
+// JSONMethod 将强类型函数包装为使用 JSON 编码的 API 方法
+//
+// 示例:
+//   methods := sdk.PluginAPIMethods{
+//       "Greet": sdk.JSONMethod(func(name string) (string, error) {
+//           return "Hello, " + name, nil
+//       }),
+//   }
+func JSONMethod[A, R any](fn func(args A) (R, error)) PluginAPIMethod {
+	return func(data []byte) ([]byte, error) {
+		var args A
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, &args); err != nil {
+				return nil, fmt.Errorf("解析参数失败: %w", err)
+			}
+		}
+		result, err := fn(args)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(result)
+	}
+}
+
+// ProtoMethod 将强类型函数包装为使用 protobuf 编码的 API 方法
+//
+// 示例:
+//   methods := sdk.PluginAPIMethods{
+//       "GetPlayer": sdk.ProtoMethod(func(req *sdk.GetPlayerRequest) (*sdk.PlayerInfo, error) {
+//           return lookup(req.Name), nil
+//       }),
+//   }
+func ProtoMethod[A any, PA interface {
+	*A
+	proto.Message
+}, R proto.Message](fn func(args PA) (R, error)) PluginAPIMethod {
+	return func(data []byte) ([]byte, error) {
+		args := PA(new(A))
+		if err := proto.Unmarshal(data, args); err != nil {
+			return nil, fmt.Errorf("解析参数失败: %w", err)
+		}
+		result, err := fn(args)
+		if err != nil {
+			return nil, err
+		}
+		return proto.Marshal(result)
+	}
+}
+
 // PluginAPIInfo 插件 API 信息
 type PluginAPIInfo struct {
-	Name    string             // API 名称
-	Version PluginAPIVersion   // API 版本
-	Plugin  Plugin             // 插件实例
+	Name    string           // API 名称
+	Version PluginAPIVersion // API 版本
+	Plugin  Plugin           // 插件实例（仅同进程注册时有效）
+	Methods []string         // 导出的方法名（通过 ExportPluginAPI 注册时有效）
+
+	methods PluginAPIMethods
 }
 
 // PluginAPIRegistry 插件 API 注册表
 type PluginAPIRegistry struct {
 	mu       sync.RWMutex
 	registry map[string]*PluginAPIInfo // key: API 名称
+	remote   pluginAPIBackend          // gRPC 代理后端（跨平台插件）
+}
+
+// pluginAPIBackend 是跨进程插件使用的 API 注册表后端
+// 注册与调用都转发给主进程中的注册表
+type pluginAPIBackend interface {
+	Export(name string, version PluginAPIVersion, methods PluginAPIMethods) error
+	Lookup(name string, required *PluginAPIVersion) (PluginAPIInfo, error)
+	Call(name string, required *PluginAPIVersion, method string, args []byte) ([]byte, error)
+	List() []PluginAPIInfo
 }
 
 // NewPluginAPIRegistry 创建插件 API 注册表
@@ -74,6 +147,9 @@ func (r *PluginAPIRegistry) Register(name string, version PluginAPIVersion, plug
 	if plugin == nil {
 		return fmt.Errorf("插件实例不能为空")
 	}
+	if r.remote != nil {
+		return fmt.Errorf("跨进程插件无法共享插件实例，请使用 ExportPluginAPI 导出方法")
+	}
 
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -91,46 +167,170 @@ func (r *PluginAPIRegistry) Register(name string, version PluginAPIVersion, plug
 	return nil
 }
 
-// Unregister 注销插件 API
-func (r *PluginAPIRegistry) Unregister(name string) {
+// RegisterMethods 注册可跨进程调用的插件 API
+// 与 Register 不同，调用方无需类型断言，而是通过 PluginAPIClient 按方法名调用
+func (r *PluginAPIRegistry) RegisterMethods(name string, version PluginAPIVersion, methods PluginAPIMethods) error {
+	if name == "" {
+		return fmt.Errorf("API 名称不能为空")
+	}
+	if len(methods) == 0 {
+		return fmt.Errorf("API '%s' 未导出任何方法", name)
+	}
+	if r.remote != nil {
+		return r.remote.Export(name, version, methods)
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	delete(r.registry, name)
+
+	if _, exists := r.registry[name]; exists {
+		return fmt.Errorf("API '%s' 已被注册", name)
+	}
+
+	r.registry[name] = &PluginAPIInfo{
+		Name:    name,
+		Version: version,
+		Methods: methodNames(methods),
+		methods: methods,
+	}
+
+	return nil
 }
 
-// Get 获取插件 API
-func (r *PluginAPIRegistry) Get(name string) (Plugin, PluginAPIVersion, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+// Client 获取插件 API 的调用客户端（不检查版本）
+func (r *PluginAPIRegistry) Client(name string) (*PluginAPIClient, error) {
+	return r.client(name, nil)
+}
 
-	info, exists := r.registry[name]
-	if !exists {
-		return nil, PluginAPIVersion{}, fmt.Errorf("API '%s' 未注册", name)
+// ClientWithVersion 获取指定版本的插件 API 调用客户端（检查兼容性）
+// 每次调用都会重新检查版本，API 插件重新注册后客户端依然可用
+func (r *PluginAPIRegistry) ClientWithVersion(name string, requiredVersion PluginAPIVersion) (*PluginAPIClient, error) {
+	return r.client(name, &requiredVersion)
+}
+
+func (r *PluginAPIRegistry) client(name string, required *PluginAPIVersion) (*PluginAPIClient, error) {
+	var (
+		info PluginAPIInfo
+		err  error
+	)
+	if r.remote != nil {
+		info, err = r.remote.Lookup(name, required)
+	} else {
+		info, err = r.lookup(name, required)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(info.Methods) == 0 {
+		return nil, fmt.Errorf("API '%s' 未导出可调用的方法", name)
 	}
 
-	return info.Plugin, info.Version, nil
+	return &PluginAPIClient{
+		name:    info.Name,
+		version: info.Version,
+		methods: info.Methods,
+		call: func(method string, args []byte) ([]byte, error) {
+			return r.call(name, required, method, args)
+		},
+	}, nil
 }
 
-// GetWithVersion 获取指定版本的插件 API（检查兼容性）
-func (r *PluginAPIRegistry) GetWithVersion(name string, requiredVersion PluginAPIVersion) (Plugin, error) {
+// call 调用已注册 API 的方法
+func (r *PluginAPIRegistry) call(name string, required *PluginAPIVersion, method string, args []byte) ([]byte, error) {
+	if r.remote != nil {
+		return r.remote.Call(name, required, method, args)
+	}
+
+	info, err := r.lookup(name, required)
+	if err != nil {
+		return nil, err
+	}
+	fn, ok := info.methods[method]
+	if !ok {
+		return nil, fmt.Errorf("API '%s' 没有方法 '%s'", name, method)
+	}
+	return fn(args)
+}
+
+// lookup 查找 API 并按需检查版本
+func (r *PluginAPIRegistry) lookup(name string, required *PluginAPIVersion) (PluginAPIInfo, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	info, exists := r.registry[name]
 	if !exists {
-		return nil, fmt.Errorf("API '%s' 未注册", name)
+		return PluginAPIInfo{}, fmt.Errorf("API '%s' 未注册", name)
+	}
+	if required != nil {
+		if err := checkAPIVersion(name, info.Version, *required); err != nil {
+			return PluginAPIInfo{}, err
+		}
 	}
+	return *info, nil
+}
 
+// checkAPIVersion 检查已注册版本是否满足所需版本
+func checkAPIVersion(name string, actual, required PluginAPIVersion) error {
 	// 检查主版本号兼容性
-	if !info.Version.IsCompatible(requiredVersion) {
-		return nil, fmt.Errorf("API '%s' 版本不兼容：需要 %s，实际 %s",
-			name, requiredVersion.String(), info.Version.String())
+	if !actual.IsCompatible(required) {
+		return fmt.Errorf("API '%s' 版本不兼容：需要 %s，实际 %s",
+			name, required.String(), actual.String())
 	}
 
 	// 检查次版本号（向后兼容）
-	if info.Version.Compare(requiredVersion) < 0 {
-		return nil, fmt.Errorf("API '%s' 版本过低：需要 %s，实际 %s",
-			name, requiredVersion.String(), info.Version.String())
+	if actual.Compare(required) < 0 {
+		return fmt.Errorf("API '%s' 版本过低：需要 %s，实际 %s",
+			name, required.String(), actual.String())
+	}
+	return nil
+}
+
+// methodNames 返回排序后的方法名列表
+func methodNames(methods PluginAPIMethods) []string {
+	names := make([]string, 0, len(methods))
+	for name := range methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Unregister 注销插件 API
+func (r *PluginAPIRegistry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.registry, name)
+}
+
+// Get 获取插件 API
+func (r *PluginAPIRegistry) Get(name string) (Plugin, PluginAPIVersion, error) {
+	if r.remote != nil {
+		return nil, PluginAPIVersion{}, fmt.Errorf("跨进程插件无法获取插件实例，请使用 GetPluginAPIClient")
+	}
+
+	info, err := r.lookup(name, nil)
+	if err != nil {
+		return nil, PluginAPIVersion{}, err
+	}
+	if info.Plugin == nil {
+		return nil, PluginAPIVersion{}, fmt.Errorf("API '%s' 仅导出方法，请使用 GetPluginAPIClient", name)
+	}
+
+	return info.Plugin, info.Version, nil
+}
+
+// GetWithVersion 获取指定版本的插件 API（检查兼容性）
+func (r *PluginAPIRegistry) GetWithVersion(name string, requiredVersion PluginAPIVersion) (Plugin, error) {
+	if r.remote != nil {
+		return nil, fmt.Errorf("跨进程插件无法获取插件实例，请使用 GetPluginAPIClientWithVersion")
+	}
+
+	info, err := r.lookup(name, &requiredVersion)
+	if err != nil {
+		return nil, err
+	}
+	if info.Plugin == nil {
+		return nil, fmt.Errorf("API '%s' 仅导出方法，请使用 GetPluginAPIClientWithVersion", name)
 	}
 
 	return info.Plugin, nil
@@ -138,6 +338,10 @@ func (r *PluginAPIRegistry) GetWithVersion(name string, requiredVersion PluginAP
 
 // List 列出所有已注册的 API
 func (r *PluginAPIRegistry) List() []PluginAPIInfo {
+	if r.remote != nil {
+		return r.remote.List()
+	}
+
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
@@ -158,6 +362,11 @@ func (r *PluginAPIRegistry) Clear() {
 
 // Has 检查 API 是否已注册
 func (r *PluginAPIRegistry) Has(name string) bool {
+	if r.remote != nil {
+		_, err := r.remote.Lookup(name, nil)
+		return err == nil
+	}
+
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 	_, exists := r.registry[name]
@@ -0,0 +1,109 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// PluginAPIClient 插件 API 调用客户端
+// 通过方法名调用其他插件导出的 API，同进程与跨进程插件用法一致
+type PluginAPIClient struct {
+	name    string
+	version PluginAPIVersion
+	methods []string
+	call    func(method string, args []byte) ([]byte, error)
+}
+
+// Name 返回 API 名称
+func (c *PluginAPIClient) Name() string {
+	return c.name
+}
+
+// Version 返回获取客户端时 API 的版本
+func (c *PluginAPIClient) Version() PluginAPIVersion {
+	return c.version
+}
+
+// Methods 返回 API 导出的方法名列表
+func (c *PluginAPIClient) Methods() []string {
+	return c.methods
+}
+
+// HasMethod 检查 API 是否导出了指定方法
+func (c *PluginAPIClient) HasMethod(method string) bool {
+	for _, name := range c.methods {
+		if name == method {
+			return true
+		}
+	}
+	return false
+}
+
+// Call 使用原始字节调用 API 方法
+//
+// 示例:
+//   result, err := client.Call("Ping", nil)
+func (c *PluginAPIClient) Call(method string, args []byte) ([]byte, error) {
+	return c.call(method, args)
+}
+
+// CallJSON 使用 JSON 编码调用 API 方法
+// args 为 nil 时不传递参数；result 为 nil 时忽略返回值
+//
+// 示例:
+//   var message string
+//   if err := client.CallJSON("Greet", "Steve", &message); err != nil {
+//       return err
+//   }
+func (c *PluginAPIClient) CallJSON(method string, args interface{}, result interface{}) error {
+	var data []byte
+	if args != nil {
+		var err error
+		data, err = json.Marshal(args)
+		if err != nil {
+			return fmt.Errorf("序列化参数失败: %w", err)
+		}
+	}
+
+	output, err := c.call(method, data)
+	if err != nil {
+		return err
+	}
+	if result == nil || len(output) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(output, result); err != nil {
+		return fmt.Errorf("解析返回值失败: %w", err)
+	}
+	return nil
+}
+
+// CallProto 使用 protobuf 编码调用 API 方法
+//
+// 示例:
+//   info := &sdk.PlayerInfo{}
+//   err := client.CallProto("GetPlayer", &sdk.GetPlayerRequest{Name: "Steve"}, info)
+func (c *PluginAPIClient) CallProto(method string, args proto.Message, result proto.Message) error {
+	var data []byte
+	if args != nil {
+		var err error
+		data, err = proto.Marshal(args)
+		if err != nil {
+			return fmt.Errorf("序列化参数失败: %w", err)
+		}
+	}
+
+	output, err := c.call(method, data)
+	if err != nil {
+		return err
+	}
+	if result == nil {
+		return nil
+	}
+	if err := proto.Unmarshal(output, result); err != nil {
+		return fmt.Errorf("解析返回值失败: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package sdk
+
+import (
+	"errors"
+)
+
+// pluginAPIGRPCProxy 是 PluginAPIRegistry 的 gRPC 代理后端
+// 运行在插件进程中，导出的方法注册到 CallbackService，由主进程的注册表统一分发调用
+type pluginAPIGRPCProxy struct {
+	proxy *ContextGRPCProxy
+}
+
+// newGRPCPluginAPIRegistry 创建由 gRPC 代理驱动的 PluginAPIRegistry
+func newGRPCPluginAPIRegistry(proxy *ContextGRPCProxy) *PluginAPIRegistry {
+	registry := NewPluginAPIRegistry()
+	registry.remote = &pluginAPIGRPCProxy{proxy: proxy}
+	return registry
+}
+
+func (p *pluginAPIGRPCProxy) Export(name string, version PluginAPIVersion, methods PluginAPIMethods) error {
+	callbackID := p.proxy.allocCallbackID()
+	p.proxy.callbackServer.RegisterPluginAPIHandler(callbackID, methods)
+
+	callCtx, done := p.proxy.calls.start("ExportPluginAPI")
+	resp, err := p.proxy.client.ExportPluginAPI(callCtx, &ExportPluginAPIRequest{
+		CallbackId: callbackID,
+		Name:       name,
+		Version:    apiVersionToInfo(version),
+		Methods:    methodNames(methods),
+	})
+	done(err)
+	if err := boolResult(resp, err); err != nil {
+		// 导出失败（如名称重复），主进程不会调用该处理器
+		p.proxy.callbackServer.UnregisterHandler(callbackID)
+		return err
+	}
+	return nil
+}
+
+func (p *pluginAPIGRPCProxy) Lookup(name string, required *PluginAPIVersion) (PluginAPIInfo, error) {
+	req := &GetPluginAPIInfoRequest{Name: name}
+	if required != nil {
+		req.RequiredVersion = apiVersionToInfo(*required)
+	}
+
+	callCtx, done := p.proxy.calls.start("GetPluginAPIInfo")
+	resp, err := p.proxy.client.GetPluginAPIInfo(callCtx, req)
+	done(err)
+	if err != nil {
+		return PluginAPIInfo{}, err
+	}
+	if !resp.Success {
+		return PluginAPIInfo{}, errors.New(resp.Error)
+	}
+	return apiInfoFromDescriptor(resp.Api), nil
+}
+
+func (p *pluginAPIGRPCProxy) Call(name string, required *PluginAPIVersion, method string, args []byte) ([]byte, error) {
+	req := &CallPluginAPIRequest{
+		Name:   name,
+		Method: method,
+		Args:   args,
+	}
+	if required != nil {
+		req.RequiredVersion = apiVersionToInfo(*required)
+	}
+
+	callCtx, done := p.proxy.calls.start("CallPluginAPI")
+	resp, err := p.proxy.client.CallPluginAPI(callCtx, req)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, errors.New(resp.Error)
+	}
+	return resp.Result, nil
+}
+
+func (p *pluginAPIGRPCProxy) List() []PluginAPIInfo {
+	callCtx, done := p.proxy.calls.start("ListPluginAPIs")
+	resp, err := p.proxy.client.ListPluginAPIs(callCtx, &Empty{})
+	done(err)
+	if err != nil {
+		return []PluginAPIInfo{}
+	}
+
+	list := make([]PluginAPIInfo, 0, len(resp.Apis))
+	for _, api := range resp.Apis {
+		list = append(list, apiInfoFromDescriptor(api))
+	}
+	return list
+}
+
+// apiVersionToInfo 将 PluginAPIVersion 转换为 PluginAPIVersionInfo 消息
+func apiVersionToInfo(version PluginAPIVersion) *PluginAPIVersionInfo {
+	return &PluginAPIVersionInfo{
+		Major: int32(version.Major),
+		Minor: int32(version.Minor),
+		Patch: int32(version.Patch),
+	}
+}
+
+// apiVersionFromInfo 将 PluginAPIVersionInfo 消息转换为 PluginAPIVersion
+func apiVersionFromInfo(info *PluginAPIVersionInfo) PluginAPIVersion {
+	return PluginAPIVersion{
+		Major: int(info.GetMajor()),
+		Minor: int(info.GetMinor()),
+		Patch: int(info.GetPatch()),
+	}
+}
+
+// apiInfoToDescriptor 将 PluginAPIInfo 转换为 PluginAPIDescriptor 消息
+func apiInfoToDescriptor(info PluginAPIInfo) *PluginAPIDescriptor {
+	return &PluginAPIDescriptor{
+		Name:    info.Name,
+		Version: apiVersionToInfo(info.Version),
+		Methods: info.Methods,
+	}
+}
+
+// apiInfoFromDescriptor 将 PluginAPIDescriptor 消息转换为 PluginAPIInfo（不含插件实例）
+func apiInfoFromDescriptor(desc *PluginAPIDescriptor) PluginAPIInfo {
+	return PluginAPIInfo{
+		Name:    desc.GetName(),
+		Version: apiVersionFromInfo(desc.GetVersion()),
+		Methods: desc.GetMethods(),
+	}
+}
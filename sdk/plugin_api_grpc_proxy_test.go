@@ -0,0 +1,33 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+)
+
+// rejectingAPIClient 拒绝所有 ExportPluginAPI 请求
+type rejectingAPIClient struct {
+	ContextServiceClient
+}
+
+func (c rejectingAPIClient) ExportPluginAPI(ctx context.Context, in *ExportPluginAPIRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	return &BoolResponse{Success: false, Error: "plugin API " + in.Name + " already exported"}, nil
+}
+
+func TestExportPluginAPIFailureRemovesHandler(t *testing.T) {
+	callbacks := NewCallbackServerImpl()
+	proxy := NewContextGRPCProxy("test", rejectingAPIClient{}, callbacks)
+	api := &pluginAPIGRPCProxy{proxy: proxy}
+
+	err := api.Export("shop", PluginAPIVersion{1, 0, 0}, PluginAPIMethods{
+		"Buy": func(args []byte) ([]byte, error) { return nil, nil },
+	})
+	if err == nil {
+		t.Fatalf("Export: want error")
+	}
+	if n := len(callbacks.apiHandlers); n != 0 {
+		t.Fatalf("%d API handlers left registered", n)
+	}
+}
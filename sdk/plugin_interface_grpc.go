@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-plugin"
 	"google.golang.org/grpc"
@@ -16,39 +17,95 @@ import (
 type PluginGRPC struct {
 	plugin.Plugin
 	Impl Plugin
+
+	// Timeouts 插件调用主进程时的超时，SlowThreshold 同时用于判定慢处理器（仅插件进程使用）
+	Timeouts CallTimeouts
+
+	// ProtocolVersion 该实现对应的协议版本，0 表示 ProtocolVersion1
+	// 协议版本 2 需要通过 VersionedPluginMap / VersionedPlugins 与对端协商后启用
+	ProtocolVersion int
+}
+
+func (p *PluginGRPC) protocolVersion() int {
+	if p.ProtocolVersion == 0 {
+		return ProtocolVersion1
+	}
+	return p.ProtocolVersion
+}
+
+// Broker 在插件与主进程之间建立附加的 gRPC 连接（ContextService 与 CallbackService）
+// *plugin.GRPCBroker 实现了该接口；测试中可以替换为内存实现（见 sdktest 包）
+type Broker interface {
+	NextId() uint32
+	AcceptAndServe(id uint32, newServer func([]grpc.ServerOption) *grpc.Server)
+	Dial(id uint32) (*grpc.ClientConn, error)
 }
 
 func (p *PluginGRPC) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
-	RegisterPluginServiceServer(s, &GRPCServer{
-		Impl:   p.Impl,
-		broker: broker,
-	})
+	RegisterPluginServiceServer(s, p.NewGRPCServer(broker))
 	return nil
 }
 
 func (p *PluginGRPC) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return p.NewGRPCClient(c, broker), nil
+}
+
+// NewGRPCServer 创建插件进程一侧的 PluginService 实现
+func (p *PluginGRPC) NewGRPCServer(broker Broker) *GRPCServer {
+	return &GRPCServer{
+		Impl:            p.Impl,
+		broker:          broker,
+		timeouts:        p.Timeouts,
+		protocolVersion: p.protocolVersion(),
+	}
+}
+
+// NewGRPCClient 创建主进程一侧的插件客户端，conn 为到插件 PluginService 的连接
+func (p *PluginGRPC) NewGRPCClient(conn grpc.ClientConnInterface, broker Broker) *GRPCClient {
 	return &GRPCClient{
-		client: NewPluginServiceClient(c),
-		broker: broker,
-	}, nil
+		client:          NewPluginServiceClient(conn),
+		broker:          broker,
+		calls:           newCallPolicy(),
+		protocolVersion: p.protocolVersion(),
+	}
 }
 
 // GRPCServer 是服务端实现
 type GRPCServer struct {
 	UnimplementedPluginServiceServer
-	Impl          Plugin
-	broker        *plugin.GRPCBroker
-	callbackServer *CallbackServerImpl
-	ctxMutex      sync.RWMutex
+	Impl            Plugin
+	broker          Broker
+	callbackServer  *CallbackServerImpl
+	ctxProxy        *ContextGRPCProxy
+	timeouts        CallTimeouts
+	protocolVersion int
+	ctxMutex        sync.RWMutex
 }
 
 func (s *GRPCServer) Init(ctx context.Context, req *InitRequest) (*InitResponse, error) {
-	// 1. 连接到主进程的 ContextService
+	// 0. 检查主进程是否支持插件必需的能力
+	// 协议版本 1 不进行能力协商，能力未知（nil）：HostSupports 按全部支持处理，
+	// 主进程实际未实现的 RPC 由 hostConn 转换为 ErrNotSupportedByHost
+	var hostCaps []string
+	if s.protocolVersion >= ProtocolVersion2 && req.ProtocolVersion >= ProtocolVersion2 {
+		hostCaps = req.Capabilities
+		if hostCaps == nil {
+			hostCaps = []string{}
+		}
+	}
+	required := requiredCapabilities(s.Impl)
+	if hostCaps != nil {
+		if err := checkCapabilities(required, hostCaps); err != nil {
+			return &InitResponse{Success: false, RequiredCapabilities: required}, err
+		}
+	}
+
+	// 1. 连接到主进程的 ContextService，主进程未实现的 RPC 返回 ErrNotSupportedByHost
 	conn, err := s.broker.Dial(req.ContextServiceId)
 	if err != nil {
 		return &InitResponse{Success: false}, err
 	}
-	contextClient := NewContextServiceClient(conn)
+	contextClient := NewContextServiceClient(hostConn{conn})
 
 	// 2. 创建 CallbackServer，用于接收主进程的事件回调
 	s.callbackServer = NewCallbackServerImpl()
@@ -66,8 +123,16 @@ func (s *GRPCServer) Init(ctx context.Context, req *InitRequest) (*InitResponse,
 		pluginName = info.Name
 	}
 	ctxProxy := NewContextGRPCProxy(pluginName, contextClient, s.callbackServer)
+	ctxProxy.SetCallTimeouts(s.timeouts)
+	ctxProxy.SetHostCapabilities(hostCaps)
+	s.ctxProxy = ctxProxy
 	pluginCtx := ctxProxy.ToContext()
 
+	// 慢处理器会拖慢主进程的事件分发，记录到主进程日志中
+	s.callbackServer.SetSlowHandlerReporter(s.timeouts.SlowThreshold, func(method string, elapsed time.Duration) {
+		pluginCtx.LogWarning("处理器 %s 耗时 %v", method, elapsed)
+	})
+
 	// 4. 调用插件的 Init
 	err = s.Impl.Init(pluginCtx)
 	if err != nil {
@@ -75,8 +140,9 @@ func (s *GRPCServer) Init(ctx context.Context, req *InitRequest) (*InitResponse,
 	}
 
 	return &InitResponse{
-		Success:           true,
-		CallbackServiceId: callbackServiceID,
+		Success:              true,
+		CallbackServiceId:    callbackServiceID,
+		RequiredCapabilities: required,
 	}, nil
 }
 
@@ -90,6 +156,21 @@ func (s *GRPCServer) Start(ctx context.Context, req *StartRequest) (*StartRespon
 
 func (s *GRPCServer) Stop(ctx context.Context, req *StopRequest) (*StopResponse, error) {
 	err := s.Impl.Stop()
+
+	// 发送插件停止前排队的命令，之后不再接受新命令
+	if s.ctxProxy != nil {
+		if drainErr := s.ctxProxy.gameUtils.DisableCommandQueue(DefaultCommandQueueDrainTimeout); drainErr != nil {
+			s.ctxProxy.LogWarning("%v", drainErr)
+		}
+	}
+
+	// 插件已停止，取消仍在进行的处理器与对主进程的调用
+	if s.callbackServer != nil {
+		s.callbackServer.Close()
+	}
+	if s.ctxProxy != nil {
+		s.ctxProxy.Close()
+	}
 	if err != nil {
 		return &StopResponse{Success: false}, err
 	}
@@ -110,14 +191,53 @@ func (s *GRPCServer) GetInfo(ctx context.Context, req *GetInfoRequest) (*GetInfo
 // GRPCClient 是客户端实现
 type GRPCClient struct {
 	client         PluginServiceClient
-	broker         *plugin.GRPCBroker
+	broker         Broker
 	contextServer  *ContextServer
 	callbackClient CallbackServiceClient
+	calls          *callPolicy
+	timeouts       CallTimeouts
+
+	protocolVersion      int
+	requiredCapabilities []string
+}
+
+// ProtocolVersion 返回与该插件握手协商出的协议版本
+func (c *GRPCClient) ProtocolVersion() int {
+	return c.protocolVersion
+}
+
+// RequiredCapabilities 返回插件在 Init 时声明的必需能力
+func (c *GRPCClient) RequiredCapabilities() []string {
+	return c.requiredCapabilities
+}
+
+// SetCallTimeouts 设置主进程调用该插件时的超时（按插件、按方法）
+// 需在 Init 之前调用，才能同时作用于插件生命周期调用与事件回调
+func (c *GRPCClient) SetCallTimeouts(timeouts CallTimeouts) {
+	c.timeouts = timeouts
+	c.calls.setTimeouts(timeouts)
+	if c.contextServer != nil {
+		c.contextServer.SetCallTimeouts(timeouts)
+	}
+}
+
+// CallStats 返回主进程调用该插件的统计，包括生命周期调用与事件回调
+func (c *GRPCClient) CallStats() map[string]CallStat {
+	stats := c.calls.snapshot()
+	if c.contextServer != nil {
+		for method, stat := range c.contextServer.CallStats() {
+			stats[method] = stat
+		}
+	}
+	return stats
 }
 
 func (c *GRPCClient) Init(ctx *Context) error {
 	// 1. 创建 ContextServer，暴露主进程的 Context 给插件
 	c.contextServer = NewContextServer(ctx)
+	c.contextServer.SetCallTimeouts(c.timeouts)
+	c.contextServer.legacy = c.protocolVersion < ProtocolVersion2
+	capabilities := c.contextServer.Capabilities()
 	contextServiceID := c.broker.NextId()
 
 	go c.broker.AcceptAndServe(contextServiceID, func(opts []grpc.ServerOption) *grpc.Server {
@@ -127,15 +247,23 @@ func (c *GRPCClient) Init(ctx *Context) error {
 	})
 
 	// 2. 调用插件的 Init
-	resp, err := c.client.Init(context.Background(), &InitRequest{
+	callCtx, done := c.calls.start("Init")
+	resp, err := c.client.Init(callCtx, &InitRequest{
 		ContextServiceId: contextServiceID,
+		ProtocolVersion:  uint32(c.protocolVersion),
+		Capabilities:     capabilities,
 	})
+	done(err)
 	if err != nil {
 		return err
 	}
 	if !resp.Success {
 		return fmt.Errorf("plugin init failed")
 	}
+	c.requiredCapabilities = resp.RequiredCapabilities
+	if err := checkCapabilities(resp.RequiredCapabilities, capabilities); err != nil {
+		return fmt.Errorf("plugin init failed: %w", err)
+	}
 
 	// 3. 连接到插件的 CallbackService
 	conn, err := c.broker.Dial(resp.CallbackServiceId)
@@ -151,7 +279,9 @@ func (c *GRPCClient) Init(ctx *Context) error {
 }
 
 func (c *GRPCClient) Start() error {
-	resp, err := c.client.Start(context.Background(), &StartRequest{})
+	callCtx, done := c.calls.start("Start")
+	resp, err := c.client.Start(callCtx, &StartRequest{})
+	done(err)
 	if err != nil {
 		return err
 	}
@@ -161,8 +291,12 @@ func (c *GRPCClient) Start() error {
 	return nil
 }
 
+// Stop 停止插件，并取消主进程对该插件的所有进行中的回调
 func (c *GRPCClient) Stop() error {
-	resp, err := c.client.Stop(context.Background(), &StopRequest{})
+	callCtx, done := c.calls.start("Stop")
+	resp, err := c.client.Stop(callCtx, &StopRequest{})
+	done(err)
+	c.Close()
 	if err != nil {
 		return err
 	}
@@ -172,8 +306,18 @@ func (c *GRPCClient) Stop() error {
 	return nil
 }
 
+// Close 移除该插件在主进程中注册的所有监听器
+// 插件进程退出后调用，之后可以对新的插件进程重新执行 Init
+func (c *GRPCClient) Close() {
+	if c.contextServer != nil {
+		c.contextServer.Close()
+	}
+}
+
 func (c *GRPCClient) GetInfo() PluginInfo {
-	resp, err := c.client.GetInfo(context.Background(), &GetInfoRequest{})
+	callCtx, done := c.calls.start("GetInfo")
+	resp, err := c.client.GetInfo(callCtx, &GetInfoRequest{})
+	done(err)
 	if err != nil {
 		return PluginInfo{}
 	}
@@ -186,14 +330,67 @@ func (c *GRPCClient) GetInfo() PluginInfo {
 	}
 }
 
+// InfoSchemaVersion 是信息查询响应（BotInfo、ServerInfo、QQInfo、InterworkInfo）的结构版本
+// 主进程在响应中携带该版本，插件据此决定读取新字段还是回退到旧版字段
+//   0: 旧版结构，只有 bot_name/bot_uuid、server_code/server_password 与 linked_groups
+//   1: 完整携带 sdk.BotInfo、sdk.ServerInfo、sdk.QQInfo、sdk.InterworkInfo 的所有字段
+const InfoSchemaVersion = 1
+
+// 插件协议版本
+//   1: 事件逐个通过单次调用投递，Init 时不进行能力协商
+//   2: Init 时交换能力（见 sdk.Capabilities），事件通过双向事件流投递
+const (
+	ProtocolVersion1 = 1
+	ProtocolVersion2 = 2
+
+	// ProtocolVersion 是当前 SDK 支持的最高协议版本
+	ProtocolVersion = ProtocolVersion2
+)
+
 // HandshakeConfig 用于握手验证
+// 其中的协议版本固定为 1，是未使用 VersionedPlugins 时双方的回退版本；
+// 只有双方都通过 VersionedPluginMap / VersionedPlugins 声明支持时才会协商到协议版本 2
 var HandshakeConfig = plugin.HandshakeConfig{
-	ProtocolVersion:  1,
+	ProtocolVersion:  ProtocolVersion1,
 	MagicCookieKey:   "FUN_INTERWORK_PLUGIN",
 	MagicCookieValue: "funinterwork_v1",
 }
 
-// PluginMap 是插件映射表
+// PluginMap 是插件映射表，只支持协议版本 1
+// 需要事件流与能力协商的主进程请使用 VersionedPluginMap
 var PluginMap = map[string]plugin.Plugin{
 	"plugin": &PluginGRPC{},
 }
+
+// VersionedPluginMap 是按协议版本划分的插件映射表
+// 主进程在 ClientConfig.VersionedPlugins 中使用，可同时加载协议版本 1 与 2 的插件
+//
+// 示例:
+//
+//	client := plugin.NewClient(&plugin.ClientConfig{
+//	    HandshakeConfig:  sdk.HandshakeConfig,
+//	    VersionedPlugins: sdk.VersionedPluginMap,
+//	    Cmd:              exec.Command("./my-plugin"),
+//	    AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+//	})
+var VersionedPluginMap = map[int]plugin.PluginSet{
+	ProtocolVersion1: {"plugin": &PluginGRPC{ProtocolVersion: ProtocolVersion1}},
+	ProtocolVersion2: {"plugin": &PluginGRPC{ProtocolVersion: ProtocolVersion2}},
+}
+
+// VersionedPlugins 返回插件进程在 ServeConfig.VersionedPlugins 中使用的映射表
+// 同时提供协议版本 1 与 2，使插件既能被新版主进程加载，也能运行在旧版主进程上
+//
+// 示例:
+//
+//	plugin.Serve(&plugin.ServeConfig{
+//	    HandshakeConfig:  sdk.HandshakeConfig,
+//	    VersionedPlugins: sdk.VersionedPlugins(&MyPlugin{}),
+//	    GRPCServer:       plugin.DefaultGRPCServer,
+//	})
+func VersionedPlugins(impl Plugin) map[int]plugin.PluginSet {
+	return map[int]plugin.PluginSet{
+		ProtocolVersion1: {"plugin": &PluginGRPC{Impl: impl, ProtocolVersion: ProtocolVersion1}},
+		ProtocolVersion2: {"plugin": &PluginGRPC{Impl: impl, ProtocolVersion: ProtocolVersion2}},
+	}
+}
@@ -0,0 +1,357 @@
+package sdk
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+)
+
+// 插件生命周期广播名称
+// PluginSupervisor 在插件进程崩溃、重启或放弃重启时通过 Context.Broadcast 通知其他插件
+// 广播数据包含 "plugin"（插件名称）与 "crashes"（时间窗口内的崩溃次数），崩溃事件另含 "error"
+const (
+	BroadcastPluginCrashed   = "plugin.crashed"
+	BroadcastPluginRestarted = "plugin.restarted"
+	BroadcastPluginGaveUp    = "plugin.gave_up"
+)
+
+// SupervisorConfig 插件守护配置
+type SupervisorConfig struct {
+	NewClient      func() *plugin.Client // 创建新的插件进程客户端，每次重启都会调用
+	MaxCrashes     int                   // CrashWindow 内允许的最大崩溃次数，超过后不再重启（默认 5）
+	CrashWindow    time.Duration         // 统计崩溃次数的时间窗口（默认 10 分钟）
+	InitialBackoff time.Duration         // 首次重启前的等待时间（默认 1 秒）
+	MaxBackoff     time.Duration         // 重启等待时间上限（默认 30 秒）
+	CheckInterval  time.Duration         // 存活检查间隔（默认 1 秒）
+	Timeouts       CallTimeouts          // 主进程调用插件时的超时
+}
+
+// PluginSupervisor 守护一个跨平台（gRPC）插件进程
+// 实现 Plugin 接口，可直接替代 GRPCClient 交给主程序管理
+// 检测到插件进程退出后会移除其注册的监听器，按指数退避重启进程并重新执行 Init 与 Start
+//
+// 示例:
+//
+//	sup := sdk.NewPluginSupervisor(sdk.SupervisorConfig{
+//	    NewClient: func() *plugin.Client {
+//	        return plugin.NewClient(&plugin.ClientConfig{
+//	            HandshakeConfig:  sdk.HandshakeConfig,
+//	            VersionedPlugins: sdk.VersionedPluginMap,
+//	            Cmd:              exec.Command("./my-plugin"),
+//	            AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+//	        })
+//	    },
+//	})
+//	if err := sup.Init(ctx); err != nil { ... }
+//	if err := sup.Start(); err != nil { ... }
+type PluginSupervisor struct {
+	cfg SupervisorConfig
+	ctx *Context
+
+	mu       sync.Mutex
+	client   *plugin.Client
+	rpc      plugin.ClientProtocol
+	instance *GRPCClient
+	name     string
+	crashes  []time.Time
+	restarts int
+	watching bool
+	stopped  bool
+	stopCh   chan struct{}
+	done     chan struct{}
+}
+
+// NewPluginSupervisor 创建插件守护，未设置的配置项使用默认值
+func NewPluginSupervisor(cfg SupervisorConfig) *PluginSupervisor {
+	if cfg.MaxCrashes <= 0 {
+		cfg.MaxCrashes = 5
+	}
+	if cfg.CrashWindow <= 0 {
+		cfg.CrashWindow = 10 * time.Minute
+	}
+	if cfg.InitialBackoff <= 0 {
+		cfg.InitialBackoff = time.Second
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = 30 * time.Second
+	}
+	if cfg.CheckInterval <= 0 {
+		cfg.CheckInterval = time.Second
+	}
+	return &PluginSupervisor{
+		cfg:    cfg,
+		stopCh: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+}
+
+// Init 启动插件进程并执行插件的 Init
+func (s *PluginSupervisor) Init(ctx *Context) error {
+	if s.cfg.NewClient == nil {
+		return fmt.Errorf("supervisor: NewClient is required")
+	}
+	s.ctx = ctx
+	return s.launch()
+}
+
+// Start 执行插件的 Start 并开始监控插件进程
+func (s *PluginSupervisor) Start() error {
+	s.mu.Lock()
+	instance := s.instance
+	s.mu.Unlock()
+	if instance == nil {
+		return fmt.Errorf("supervisor: plugin not initialized")
+	}
+	if err := instance.Start(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.watching && !s.stopped {
+		s.watching = true
+		go s.watch()
+	}
+	return nil
+}
+
+// Stop 停止监控并关闭插件进程，之后不会再重启
+func (s *PluginSupervisor) Stop() error {
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		return nil
+	}
+	s.stopped = true
+	close(s.stopCh)
+	if !s.watching {
+		close(s.done)
+	}
+	instance := s.instance
+	s.mu.Unlock()
+
+	var err error
+	if instance != nil {
+		err = instance.Stop()
+	}
+	s.shutdown()
+	return err
+}
+
+// GetInfo 返回插件信息，插件进程不可用时返回最近一次获取的名称
+func (s *PluginSupervisor) GetInfo() PluginInfo {
+	s.mu.Lock()
+	instance := s.instance
+	name := s.name
+	s.mu.Unlock()
+	if instance != nil {
+		if info := instance.GetInfo(); info.Name != "" {
+			return info
+		}
+	}
+	return PluginInfo{Name: name}
+}
+
+// Restarts 返回插件进程被重启的总次数
+func (s *PluginSupervisor) Restarts() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.restarts
+}
+
+// Done 在守护结束（调用 Stop 或超过最大崩溃次数）后关闭
+func (s *PluginSupervisor) Done() <-chan struct{} {
+	return s.done
+}
+
+// launch 启动新的插件进程并执行 Init
+func (s *PluginSupervisor) launch() error {
+	client := s.cfg.NewClient()
+	rpc, err := client.Client()
+	if err != nil {
+		client.Kill()
+		return fmt.Errorf("failed to start plugin process: %v", err)
+	}
+	raw, err := rpc.Dispense("plugin")
+	if err != nil {
+		client.Kill()
+		return fmt.Errorf("failed to dispense plugin: %v", err)
+	}
+	instance, ok := raw.(*GRPCClient)
+	if !ok {
+		client.Kill()
+		return fmt.Errorf("unexpected plugin type %T", raw)
+	}
+	instance.SetCallTimeouts(s.cfg.Timeouts)
+	if err := instance.Init(s.ctx); err != nil {
+		instance.Close()
+		client.Kill()
+		return err
+	}
+
+	name := instance.GetInfo().Name
+
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		instance.Close()
+		client.Kill()
+		return fmt.Errorf("supervisor stopped")
+	}
+	s.client = client
+	s.rpc = rpc
+	s.instance = instance
+	if name != "" {
+		s.name = name
+	}
+	s.mu.Unlock()
+	return nil
+}
+
+// shutdown 移除当前插件进程注册的监听器并结束进程
+func (s *PluginSupervisor) shutdown() {
+	s.mu.Lock()
+	client := s.client
+	instance := s.instance
+	s.client = nil
+	s.rpc = nil
+	s.instance = nil
+	s.mu.Unlock()
+
+	if instance != nil {
+		instance.Close()
+	}
+	if client != nil {
+		client.Kill()
+	}
+}
+
+// alive 检查插件进程是否仍在运行并能响应
+func (s *PluginSupervisor) alive() error {
+	s.mu.Lock()
+	client := s.client
+	rpc := s.rpc
+	s.mu.Unlock()
+	if client == nil || client.Exited() {
+		return fmt.Errorf("plugin process exited")
+	}
+	return rpc.Ping()
+}
+
+// watch 周期性检查插件进程，崩溃后按退避策略重启
+func (s *PluginSupervisor) watch() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.cfg.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+		}
+
+		err := s.alive()
+		if err == nil {
+			continue
+		}
+		if s.isStopped() {
+			return
+		}
+		if !s.recover(err) {
+			return
+		}
+	}
+}
+
+// recover 处理一次崩溃，直到重启成功返回 true；放弃重启或守护已停止返回 false
+func (s *PluginSupervisor) recover(cause error) bool {
+	for {
+		s.shutdown()
+		crashes := s.recordCrash()
+		s.ctx.LogError("插件 %s 进程异常退出（%d 次）: %v", s.name, crashes, cause)
+		s.broadcast(BroadcastPluginCrashed, crashes, cause)
+
+		if crashes > s.cfg.MaxCrashes {
+			s.ctx.LogError("插件 %s 在 %v 内崩溃超过 %d 次，停止重启", s.name, s.cfg.CrashWindow, s.cfg.MaxCrashes)
+			s.broadcast(BroadcastPluginGaveUp, crashes, nil)
+			return false
+		}
+
+		select {
+		case <-s.stopCh:
+			return false
+		case <-time.After(s.backoff(crashes)):
+		}
+
+		err := s.launch()
+		if err == nil {
+			s.mu.Lock()
+			instance := s.instance
+			s.mu.Unlock()
+			if instance == nil {
+				return false
+			}
+			err = instance.Start()
+		}
+		if err != nil {
+			cause = err
+			continue
+		}
+
+		s.mu.Lock()
+		s.restarts++
+		s.mu.Unlock()
+		s.ctx.LogSuccess("插件 %s 已重启", s.name)
+		s.broadcast(BroadcastPluginRestarted, crashes, nil)
+		return true
+	}
+}
+
+// recordCrash 记录一次崩溃并返回时间窗口内的崩溃次数
+func (s *PluginSupervisor) recordCrash() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	kept := s.crashes[:0]
+	for _, t := range s.crashes {
+		if now.Sub(t) < s.cfg.CrashWindow {
+			kept = append(kept, t)
+		}
+	}
+	s.crashes = append(kept, now)
+	return len(s.crashes)
+}
+
+// backoff 返回第 n 次崩溃后的重启等待时间
+func (s *PluginSupervisor) backoff(n int) time.Duration {
+	d := s.cfg.InitialBackoff
+	for i := 1; i < n && d < s.cfg.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > s.cfg.MaxBackoff {
+		d = s.cfg.MaxBackoff
+	}
+	return d
+}
+
+func (s *PluginSupervisor) isStopped() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stopped
+}
+
+// broadcast 向其他插件发送生命周期广播
+func (s *PluginSupervisor) broadcast(name string, crashes int, cause error) {
+	data := map[string]interface{}{
+		"plugin":  s.name,
+		"crashes": crashes,
+	}
+	if cause != nil {
+		data["error"] = cause.Error()
+	}
+	s.ctx.Broadcast(Broadcast{Name: name, Data: data})
+}
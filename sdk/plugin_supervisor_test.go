@@ -0,0 +1,204 @@
+package sdk
+
+import (
+	"os"
+	"os/exec"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+)
+
+// crashPluginEnv 设置后测试二进制作为插件进程运行，启动后很快崩溃
+const crashPluginEnv = "FIN_SUPERVISOR_TEST_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(crashPluginEnv) != "" {
+		plugin.Serve(&plugin.ServeConfig{
+			HandshakeConfig:  HandshakeConfig,
+			VersionedPlugins: VersionedPlugins(&crashPlugin{}),
+			GRPCServer:       plugin.DefaultGRPCServer,
+		})
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// crashPlugin 注册一个聊天监听器与一个控制台命令，Start 后进程退出
+type crashPlugin struct{}
+
+func (p *crashPlugin) Init(ctx *Context) error {
+	if _, err := ctx.ListenChat(func(*ChatEvent) {}); err != nil {
+		return err
+	}
+	return ctx.RegisterConsoleCommand(ConsoleCommand{
+		Name:    "crashy",
+		Handler: func([]string) error { return nil },
+	})
+}
+
+func (p *crashPlugin) Start() error {
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		os.Exit(3)
+	}()
+	return nil
+}
+
+func (p *crashPlugin) Stop() error { return nil }
+
+func (p *crashPlugin) GetInfo() PluginInfo { return PluginInfo{Name: "crashy"} }
+
+// supervisorHost 记录守护过程中主进程侧的注册与广播
+type supervisorHost struct {
+	mu         sync.Mutex
+	chat       int
+	console    int
+	broadcasts []supervisorBroadcast
+}
+
+// supervisorBroadcast 是一次广播及其发出时仍注册着的监听器与命令数量
+type supervisorBroadcast struct {
+	Broadcast
+	at      time.Time
+	chat    int
+	console int
+}
+
+func (h *supervisorHost) context() *Context {
+	return NewContext(ContextOptions{
+		PluginName: "host",
+		Logger:     func(string, ...interface{}) {},
+		RegisterChat: func(ChatHandler, int) (func(), error) {
+			h.mu.Lock()
+			h.chat++
+			h.mu.Unlock()
+			var once sync.Once
+			return func() {
+				once.Do(func() {
+					h.mu.Lock()
+					h.chat--
+					h.mu.Unlock()
+				})
+			}, nil
+		},
+		ConsoleRegistrar: func(ConsoleCommand) error {
+			h.mu.Lock()
+			h.console++
+			h.mu.Unlock()
+			return nil
+		},
+		ConsoleUnregistrar: func(ConsoleCommand) {
+			h.mu.Lock()
+			h.console--
+			h.mu.Unlock()
+		},
+		TriggerBroadcast: func(b Broadcast) []interface{} {
+			h.mu.Lock()
+			h.broadcasts = append(h.broadcasts, supervisorBroadcast{Broadcast: b, at: time.Now(), chat: h.chat, console: h.console})
+			h.mu.Unlock()
+			return nil
+		},
+	})
+}
+
+func TestSupervisorBackoff(t *testing.T) {
+	sup := NewPluginSupervisor(SupervisorConfig{InitialBackoff: time.Second, MaxBackoff: 30 * time.Second})
+	want := []time.Duration{1, 2, 4, 8, 16, 30, 30}
+	for i, w := range want {
+		if got := sup.backoff(i + 1); got != w*time.Second {
+			t.Errorf("backoff(%d) = %v, want %v", i+1, got, w*time.Second)
+		}
+	}
+}
+
+func TestSupervisorRestartsAndGivesUp(t *testing.T) {
+	host := &supervisorHost{}
+	var launchMu sync.Mutex
+	var launches []time.Time
+	sup := NewPluginSupervisor(SupervisorConfig{
+		NewClient: func() *plugin.Client {
+			launchMu.Lock()
+			launches = append(launches, time.Now())
+			launchMu.Unlock()
+			cmd := exec.Command(os.Args[0], "-test.run=^$")
+			cmd.Env = append(os.Environ(), crashPluginEnv+"=1")
+			return plugin.NewClient(&plugin.ClientConfig{
+				HandshakeConfig:  HandshakeConfig,
+				VersionedPlugins: VersionedPluginMap,
+				Cmd:              cmd,
+				AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+			})
+		},
+		MaxCrashes:     2,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     150 * time.Millisecond,
+		CheckInterval:  20 * time.Millisecond,
+	})
+	defer sup.Stop()
+
+	if err := sup.Init(host.context()); err != nil {
+		t.Fatalf("Init: %v", err)
+	}
+	if err := sup.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	select {
+	case <-sup.Done():
+	case <-time.After(30 * time.Second):
+		t.Fatal("supervisor did not give up")
+	}
+
+	host.mu.Lock()
+	defer host.mu.Unlock()
+	launchMu.Lock()
+	defer launchMu.Unlock()
+
+	wantNames := []string{
+		BroadcastPluginCrashed, BroadcastPluginRestarted,
+		BroadcastPluginCrashed, BroadcastPluginRestarted,
+		BroadcastPluginCrashed, BroadcastPluginGaveUp,
+	}
+	if len(host.broadcasts) != len(wantNames) {
+		names := make([]string, len(host.broadcasts))
+		for i, b := range host.broadcasts {
+			names[i] = b.Name
+		}
+		t.Fatalf("broadcasts = %v, want %v", names, wantNames)
+	}
+	for i, b := range host.broadcasts {
+		crashes := i/2 + 1
+		if b.Name != wantNames[i] || b.Data["plugin"] != "crashy" || b.Data["crashes"] != crashes {
+			t.Errorf("broadcast %d = %s %v, want %s for crashy with %d crashes", i, b.Name, b.Data, wantNames[i], crashes)
+		}
+		_, hasErr := b.Data["error"]
+		if hasErr != (b.Name == BroadcastPluginCrashed) {
+			t.Errorf("broadcast %d (%s) error field present = %v", i, b.Name, hasErr)
+		}
+		// 崩溃后先移除旧进程的注册再广播；重启后新进程重新注册
+		wantRegistered := 0
+		if b.Name == BroadcastPluginRestarted {
+			wantRegistered = 1
+		}
+		if b.chat != wantRegistered || b.console != wantRegistered {
+			t.Errorf("broadcast %d (%s): %d chat listeners and %d console commands registered, want %d", i, b.Name, b.chat, b.console, wantRegistered)
+		}
+	}
+	if host.chat != 0 || host.console != 0 {
+		t.Errorf("after giving up: %d chat listeners and %d console commands still registered", host.chat, host.console)
+	}
+
+	if len(launches) != 3 {
+		t.Fatalf("plugin launched %d times, want 3", len(launches))
+	}
+	for i, crash := range []supervisorBroadcast{host.broadcasts[0], host.broadcasts[2]} {
+		want := sup.backoff(i + 1)
+		if wait := launches[i+1].Sub(crash.at); wait < want {
+			t.Errorf("restart %d after %v, want at least %v", i+1, wait, want)
+		}
+	}
+	if got := sup.Restarts(); got != 2 {
+		t.Errorf("Restarts() = %d, want 2", got)
+	}
+}
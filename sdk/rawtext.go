@@ -0,0 +1,280 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// § 格式代码
+const (
+	TextColorBlack        = "§0"
+	TextColorDarkBlue     = "§1"
+	TextColorDarkGreen    = "§2"
+	TextColorDarkAqua     = "§3"
+	TextColorDarkRed      = "§4"
+	TextColorDarkPurple   = "§5"
+	TextColorGold         = "§6"
+	TextColorGray         = "§7"
+	TextColorDarkGray     = "§8"
+	TextColorBlue         = "§9"
+	TextColorGreen        = "§a"
+	TextColorAqua         = "§b"
+	TextColorRed          = "§c"
+	TextColorLightPurple  = "§d"
+	TextColorYellow       = "§e"
+	TextColorWhite        = "§f"
+	TextColorMinecoinGold = "§g"
+
+	TextFormatObfuscated = "§k"
+	TextFormatBold       = "§l"
+	TextFormatItalic     = "§o"
+	TextFormatReset      = "§r"
+)
+
+// Colorize 用格式代码包裹文本，并在末尾重置格式
+//
+// 示例:
+//
+//	sdk.Colorize("警告", sdk.TextColorRed, sdk.TextFormatBold) // "§c§l警告§r"
+func Colorize(text string, codes ...string) string {
+	return strings.Join(codes, "") + text + TextFormatReset
+}
+
+// TextComponent 是 rawtext 中的一个文本组件
+// 每个组件只使用 Text、Translate、Score、Selector、RawText 中的一种
+type TextComponent struct {
+	Text      string          // 纯文本
+	Translate string          // 翻译键或带 %s / %%s 占位符的文本
+	With      []TextComponent // Translate 的参数
+	Score     *ScoreComponent // 计分板分数
+	Selector  string          // 选择器，显示匹配实体的名称
+	RawText   []TextComponent // 嵌套的组件
+}
+
+// ScoreComponent 是显示计分板分数的组件
+type ScoreComponent struct {
+	Name      string `json:"name"`      // 分数持有者（玩家名、选择器或 "*" 表示查看者）
+	Objective string `json:"objective"` // 计分板名称
+}
+
+// PlainText 创建纯文本组件
+func PlainText(text string) TextComponent {
+	return TextComponent{Text: text}
+}
+
+// TranslateText 创建翻译组件，with 为参数
+//
+// 示例:
+//
+//	sdk.TranslateText("commands.give.success", sdk.PlainText("钻石"), sdk.PlainText("1"), sdk.SelectorText("@s"))
+func TranslateText(key string, with ...TextComponent) TextComponent {
+	return TextComponent{Translate: key, With: with}
+}
+
+// ScoreText 创建计分板分数组件
+func ScoreText(name, objective string) TextComponent {
+	return TextComponent{Score: &ScoreComponent{Name: name, Objective: objective}}
+}
+
+// SelectorText 创建选择器组件，显示匹配实体的名称
+func SelectorText(target Target) TextComponent {
+	selector, err := FormatTarget(target)
+	if err != nil {
+		// 无效的选择器作为文本显示，不会被游戏解析
+		return TextComponent{Text: fmt.Sprint(target)}
+	}
+	return TextComponent{Selector: selector}
+}
+
+// Colored 返回以格式代码开头的纯文本组件副本，仅对纯文本组件生效
+func (c TextComponent) Colored(codes ...string) TextComponent {
+	if c.Text != "" {
+		c.Text = strings.Join(codes, "") + c.Text
+	}
+	return c
+}
+
+// textComponentJSON 是 TextComponent 的 JSON 结构
+type textComponentJSON struct {
+	Text      *string         `json:"text,omitempty"`
+	Translate string          `json:"translate,omitempty"`
+	With      json.RawMessage `json:"with,omitempty"`
+	Score     *ScoreComponent `json:"score,omitempty"`
+	Selector  string          `json:"selector,omitempty"`
+	RawText   []TextComponent `json:"rawtext,omitempty"`
+}
+
+// MarshalJSON 输出游戏使用的 JSON 格式
+// With 全部为纯文本时输出字符串数组，否则输出 {"rawtext": [...]}
+func (c TextComponent) MarshalJSON() ([]byte, error) {
+	out := textComponentJSON{
+		Translate: c.Translate,
+		Score:     c.Score,
+		Selector:  c.Selector,
+		RawText:   c.RawText,
+	}
+	if c.Text != "" || (c.Translate == "" && c.Score == nil && c.Selector == "" && len(c.RawText) == 0) {
+		text := c.Text
+		out.Text = &text
+	}
+	if len(c.With) > 0 {
+		var with interface{}
+		if plain, ok := plainTexts(c.With); ok {
+			with = plain
+		} else {
+			with = RawText(c.With)
+		}
+		data, err := json.Marshal(with)
+		if err != nil {
+			return nil, err
+		}
+		out.With = data
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON 解析游戏使用的 JSON 格式，with 可以是字符串数组或 rawtext 对象
+func (c *TextComponent) UnmarshalJSON(data []byte) error {
+	var in textComponentJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	*c = TextComponent{
+		Translate: in.Translate,
+		Score:     in.Score,
+		Selector:  in.Selector,
+		RawText:   in.RawText,
+	}
+	if in.Text != nil {
+		c.Text = *in.Text
+	}
+	if len(in.With) == 0 {
+		return nil
+	}
+	var plain []string
+	if err := json.Unmarshal(in.With, &plain); err == nil {
+		for _, text := range plain {
+			c.With = append(c.With, PlainText(text))
+		}
+		return nil
+	}
+	var with RawText
+	if err := json.Unmarshal(in.With, &with); err != nil {
+		return fmt.Errorf("无效的 with 参数: %w", err)
+	}
+	c.With = with
+	return nil
+}
+
+func plainTexts(components []TextComponent) ([]string, bool) {
+	texts := make([]string, len(components))
+	for i, c := range components {
+		if c.Translate != "" || c.Score != nil || c.Selector != "" || len(c.RawText) > 0 {
+			return nil, false
+		}
+		texts[i] = c.Text
+	}
+	return texts, true
+}
+
+// RawText 是 tellraw / titleraw 使用的 {"rawtext": [...]} 消息
+//
+// 示例:
+//
+//	msg := sdk.RawText{
+//	    sdk.PlainText("你的金币: ").Colored(sdk.TextColorGold),
+//	    sdk.ScoreText("*", "money"),
+//	}
+//	ctx.GameUtils().TellrawComponent("@a", msg)
+type RawText []TextComponent
+
+// NewRawText 由组件创建 RawText
+func NewRawText(components ...TextComponent) RawText {
+	return RawText(components)
+}
+
+// MarshalJSON 输出 {"rawtext": [...]}
+func (r RawText) MarshalJSON() ([]byte, error) {
+	components := []TextComponent(r)
+	if components == nil {
+		components = []TextComponent{}
+	}
+	return json.Marshal(struct {
+		RawText []TextComponent `json:"rawtext"`
+	}{components})
+}
+
+// UnmarshalJSON 解析 {"rawtext": [...]}
+func (r *RawText) UnmarshalJSON(data []byte) error {
+	var in struct {
+		RawText *[]TextComponent `json:"rawtext"`
+	}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if in.RawText == nil {
+		return fmt.Errorf("缺少 rawtext 字段")
+	}
+	*r = RawText(*in.RawText)
+	return nil
+}
+
+// String 返回 JSON 文本
+func (r RawText) String() string {
+	data, _ := json.Marshal(r)
+	return string(data)
+}
+
+// ParseRawText 从 JSON 文本解析 RawText（如插件配置中保存的 tellraw 消息）
+func ParseRawText(text string) (RawText, error) {
+	var r RawText
+	if err := json.Unmarshal([]byte(text), &r); err != nil {
+		return nil, fmt.Errorf("解析 rawtext 失败: %w", err)
+	}
+	return r, nil
+}
+
+// 标题显示位置
+const (
+	TitleKindTitle     = "title"     // 主标题
+	TitleKindSubtitle  = "subtitle"  // 副标题
+	TitleKindActionbar = "actionbar" // 物品栏上方
+)
+
+// TellrawComponent 使用 tellraw 向目标发送 rawtext 消息
+//
+// 示例:
+//
+//	ctx.GameUtils().TellrawComponent("@a", sdk.NewRawText(
+//	    sdk.SelectorText("@s"),
+//	    sdk.TranslateText("%%s 获得了 %%s 金币", sdk.PlainText("Steve"), sdk.ScoreText("Steve", "money")),
+//	))
+func (g *GameUtils) TellrawComponent(target Target, text RawText) error {
+	cmd, err := NewCommand("tellraw").Target(target).JSON(text).Build()
+	if err != nil {
+		return fmt.Errorf("构造 tellraw 消息失败: %w", err)
+	}
+	return g.SendCommand(cmd)
+}
+
+// TitleRawComponent 使用 titleraw 向目标显示 rawtext 标题
+// kind: TitleKindTitle、TitleKindSubtitle 或 TitleKindActionbar
+//
+// 示例:
+//
+//	ctx.GameUtils().TitleRawComponent("@a", sdk.TitleKindActionbar, sdk.NewRawText(
+//	    sdk.PlainText("金币: ").Colored(sdk.TextColorGold), sdk.ScoreText("*", "money"),
+//	))
+func (g *GameUtils) TitleRawComponent(target Target, kind string, text RawText) error {
+	switch kind {
+	case TitleKindTitle, TitleKindSubtitle, TitleKindActionbar:
+	default:
+		return fmt.Errorf("无效的标题类型: %s", kind)
+	}
+	cmd, err := NewCommand("titleraw").Target(target).Keyword(kind).JSON(text).Build()
+	if err != nil {
+		return fmt.Errorf("构造 titleraw 消息失败: %w", err)
+	}
+	return g.SendCommand(cmd)
+}
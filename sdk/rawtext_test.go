@@ -0,0 +1,66 @@
+package sdk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRawTextJSON(t *testing.T) {
+	msg := NewRawText(
+		PlainText("金币: ").Colored(TextColorGold),
+		ScoreText("*", "money"),
+		TranslateText("commands.give.success", PlainText("钻石"), PlainText("1")),
+		TranslateText("%%s 击杀了 %%s", SelectorText("@s"), SelectorText(AllEntities().Type("zombie").Count(1))),
+		TextComponent{RawText: []TextComponent{PlainText("嵌套")}},
+		PlainText(""),
+	)
+	want := `{"rawtext":[` +
+		`{"text":"§6金币: "},` +
+		`{"score":{"name":"*","objective":"money"}},` +
+		`{"translate":"commands.give.success","with":["钻石","1"]},` +
+		`{"translate":"%%s 击杀了 %%s","with":{"rawtext":[{"selector":"@s"},{"selector":"@e[type=zombie,c=1]"}]}},` +
+		`{"rawtext":[{"text":"嵌套"}]},` +
+		`{"text":""}]}`
+	if got := msg.String(); got != want {
+		t.Fatalf("JSON =\n%s\nwant\n%s", got, want)
+	}
+
+	parsed, err := ParseRawText(want)
+	if err != nil {
+		t.Fatalf("ParseRawText: %v", err)
+	}
+	if !reflect.DeepEqual(parsed, msg) {
+		t.Fatalf("parsed = %+v\nwant %+v", parsed, msg)
+	}
+
+	if _, err := ParseRawText(`{"text":"hi"}`); err == nil {
+		t.Fatalf("ParseRawText without rawtext: want error")
+	}
+	if got := Colorize("警告", TextColorRed, TextFormatBold); got != "§c§l警告§r" {
+		t.Fatalf("Colorize = %q", got)
+	}
+}
+
+func TestGameUtilsRawTextCommands(t *testing.T) {
+	commands := &legacyTestCommands{}
+	utils := NewGameUtils(LegacyGameInterface(&legacyTestGame{commands: commands}))
+	player := &Player{Name: "Steve Jobs", gameUtils: utils}
+
+	if err := player.TellrawComponent(NewRawText(ScoreText("*", "money"))); err != nil {
+		t.Fatalf("TellrawComponent: %v", err)
+	}
+	if err := player.TitleRawComponent(TitleKindActionbar, NewRawText(PlainText("hi"))); err != nil {
+		t.Fatalf("TitleRawComponent: %v", err)
+	}
+	if err := utils.TitleRawComponent("@a", "chat", NewRawText()); err == nil {
+		t.Fatalf("TitleRawComponent with invalid kind: want error")
+	}
+
+	want := []string{
+		`tellraw "Steve Jobs" {"rawtext":[{"score":{"name":"*","objective":"money"}}]}`,
+		`titleraw "Steve Jobs" actionbar {"rawtext":[{"text":"hi"}]}`,
+	}
+	if !reflect.DeepEqual(commands.sent, want) {
+		t.Fatalf("commands = %q, want %q", commands.sent, want)
+	}
+}
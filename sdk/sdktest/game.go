@@ -0,0 +1,364 @@
+package sdktest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/maoqijie/FIN-plugin/sdk"
+)
+
+// Response 是为命令编排的响应
+type Response struct {
+	Output   *sdk.CommandResult // 命令输出，nil 表示空输出（SuccessCount 为 0）
+	TimedOut bool               // 模拟命令超时
+	Err      error              // 模拟命令执行错误
+}
+
+// Succeed 返回执行成功的响应，parameters 作为第一条输出消息的参数
+//
+// 示例:
+//
+//	game.OnCommand("scoreboard players test Steve money", sdktest.Succeed("100"))
+func Succeed(parameters ...string) Response {
+	return Response{Output: &sdk.CommandResult{
+		SuccessCount:   1,
+		OutputMessages: []sdk.CommandOutputMessage{{Success: true, Parameters: parameters}},
+	}}
+}
+
+// Fail 返回执行失败（SuccessCount 为 0）的响应，message 为输出消息的翻译键
+func Fail(message string, parameters ...string) Response {
+	return Response{Output: &sdk.CommandResult{
+		OutputMessages: []sdk.CommandOutputMessage{{MessageID: message, Parameters: parameters}},
+	}}
+}
+
+// Timeout 返回超时的响应
+func Timeout() Response {
+	return Response{TimedOut: true}
+}
+
+// Error 返回执行出错的响应
+func Error(err error) Response {
+	return Response{Err: err}
+}
+
+// errCommandTimeout 是不带超时标志的方法（SendWSCommandWithResp）遇到超时响应时返回的错误
+var errCommandTimeout = errors.New("command timed out")
+
+// commandRule 是一条命令响应规则
+type commandRule struct {
+	match func(cmd string) (Response, bool)
+}
+
+// querytargetRule 是一条目标查询规则
+type querytargetRule struct {
+	results []sdk.QueryTargetResult
+	err     error
+}
+
+// SentPacket 记录插件通过 SendPacket 发送的数据包
+type SentPacket struct {
+	ID     uint32
+	Packet interface{}
+}
+
+// Game 是内存中的假游戏接口（实现 sdk.GameInterface），交给 sdk.NewGameUtils 使用
+// 记录所有命令、聊天与数据包；命令的响应可以通过 OnCommand 编排，未编排的命令执行成功且没有输出参数
+//
+// 示例:
+//
+//	game := sdktest.NewGame()
+//	game.SetScore("money", "Steve", 100)
+//	game.OnCommand("tag ", sdktest.Fail("commands.generic.unknown"))
+//	game.OnQuerytarget("Steve", sdk.QueryTargetResult{EntityName: "Steve", Position: sdk.Position{X: 1, Y: 64, Z: 2}})
+//
+//	utils := sdk.NewGameUtils(game)
+//	score, _ := utils.GetScore("money", "Steve", 1) // 100
+type Game struct {
+	mu       sync.Mutex
+	commands []string
+	queries  []string
+	said     []Message
+	packets  []SentPacket
+
+	rules   []commandRule
+	targets map[string]querytargetRule
+}
+
+var _ sdk.GameInterface = (*Game)(nil)
+
+// NewGame 创建假游戏接口
+func NewGame() *Game {
+	return &Game{targets: make(map[string]querytargetRule)}
+}
+
+// OnCommand 为以 prefix 开头的命令编排响应
+// 多条规则都匹配时，后添加的规则优先
+func (g *Game) OnCommand(prefix string, resp Response) {
+	g.OnCommandFunc(func(cmd string) (Response, bool) {
+		return resp, strings.HasPrefix(cmd, prefix)
+	})
+}
+
+// OnCommandFunc 添加自定义的命令响应规则，fn 返回 false 表示不处理该命令
+// 多条规则都匹配时，后添加的规则优先
+func (g *Game) OnCommandFunc(fn func(cmd string) (Response, bool)) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.rules = append(g.rules, commandRule{match: fn})
+}
+
+// SetScore 编排 GetScore(scoreboard, target) 返回的分数
+func (g *Game) SetScore(scoreboard, target string, score int) {
+	g.OnCommand(fmt.Sprintf("scoreboard players test %s %s ", target, scoreboard), Succeed(fmt.Sprint(score)))
+}
+
+// OnQuerytarget 编排目标选择器 selector 的查询结果（GetTarget、GetPos 使用）
+// 未编排的选择器查询结果为空
+func (g *Game) OnQuerytarget(selector string, results ...sdk.QueryTargetResult) {
+	rule := querytargetRule{results: results}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.targets[selector] = rule
+}
+
+// FailQuerytarget 编排目标选择器 selector 的查询错误
+func (g *Game) FailQuerytarget(selector string, err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.targets[selector] = querytargetRule{err: err}
+}
+
+// Commands 返回命令发送器
+func (g *Game) Commands() sdk.CommandSender {
+	return &gameCommands{game: g}
+}
+
+// Querytarget 返回目标查询器
+func (g *Game) Querytarget() sdk.TargetQuerier {
+	return &gameQuerytarget{game: g}
+}
+
+// SendPacket 记录发送的数据包
+func (g *Game) SendPacket(packetID uint32, packet interface{}) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.packets = append(g.packets, SentPacket{ID: packetID, Packet: packet})
+	return nil
+}
+
+// record 记录一条命令，tellraw 命令同时记录为消息
+func (g *Game) record(cmd string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.commands = append(g.commands, cmd)
+	if msg, ok := parseTellraw(cmd); ok {
+		g.said = append(g.said, msg)
+	}
+}
+
+// execute 记录命令并返回编排的响应
+func (g *Game) execute(cmd string) Response {
+	g.record(cmd)
+	return g.respond(cmd)
+}
+
+// respond 返回为命令编排的响应
+func (g *Game) respond(cmd string) Response {
+	g.mu.Lock()
+	rules := append([]commandRule(nil), g.rules...)
+	g.mu.Unlock()
+
+	resp := Succeed()
+	for i := len(rules) - 1; i >= 0; i-- {
+		if matched, ok := rules[i].match(cmd); ok {
+			resp = matched
+			break
+		}
+	}
+	if resp.Output == nil {
+		// 返回空输出而不是包含 nil 指针的 sdk.CommandOutput
+		resp.Output = &sdk.CommandResult{}
+	}
+	return resp
+}
+
+// CommandLog 返回记录的所有命令
+func (g *Game) CommandLog() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]string(nil), g.commands...)
+}
+
+// Queries 返回记录的所有目标查询
+func (g *Game) Queries() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]string(nil), g.queries...)
+}
+
+// Said 返回记录的所有消息
+func (g *Game) Said() []Message {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]Message(nil), g.said...)
+}
+
+// Packets 返回记录的所有数据包
+func (g *Game) Packets() []SentPacket {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]SentPacket(nil), g.packets...)
+}
+
+// Reset 清空记录，已编排的响应保持不变
+func (g *Game) Reset() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.commands = nil
+	g.queries = nil
+	g.said = nil
+	g.packets = nil
+}
+
+// parseTellraw 从 "tellraw <目标> {"rawtext":[...]}" 命令中取出目标与文本
+func parseTellraw(cmd string) (Message, bool) {
+	rest, ok := strings.CutPrefix(cmd, "tellraw ")
+	if !ok {
+		return Message{}, false
+	}
+	idx := strings.Index(rest, ` {"rawtext"`)
+	if idx < 0 {
+		return Message{}, false
+	}
+	var payload struct {
+		Rawtext []struct {
+			Text string `json:"text"`
+		} `json:"rawtext"`
+	}
+	if err := json.Unmarshal([]byte(rest[idx+1:]), &payload); err != nil {
+		return Message{}, false
+	}
+	var text strings.Builder
+	for _, part := range payload.Rawtext {
+		text.WriteString(part.Text)
+	}
+	return Message{Target: rest[:idx], Text: text.String()}, true
+}
+
+// gameCommands 是假游戏接口的命令发送器
+type gameCommands struct {
+	game *Game
+}
+
+var _ sdk.CommandBatchSender = (*gameCommands)(nil)
+
+func (c *gameCommands) SendWSCommand(cmd string) error {
+	c.game.record(cmd)
+	return nil
+}
+
+func (c *gameCommands) SendSettings(cmd string) error {
+	c.game.record(cmd)
+	return nil
+}
+
+func (c *gameCommands) SendWSCommandWithResp(cmd string) (sdk.CommandOutput, error) {
+	resp := c.game.execute(cmd)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	if resp.TimedOut {
+		return nil, errCommandTimeout
+	}
+	return resp.Output, nil
+}
+
+func (c *gameCommands) SendWSCommandWithTimeout(cmd string, timeout time.Duration) (sdk.CommandOutput, bool, error) {
+	resp := c.game.execute(cmd)
+	if resp.TimedOut {
+		return nil, true, nil
+	}
+	if resp.Err != nil {
+		return nil, false, resp.Err
+	}
+	return resp.Output, false, nil
+}
+
+// SendWSCommandBatch 像真实主进程一样流水线发送命令：
+// 依次记录全部命令，各条命令的响应异步产生，再通过 sdk.CommandPipeline 按请求 ID 对应回来
+func (c *gameCommands) SendWSCommandBatch(cmds []string, timeout time.Duration) []sdk.CommandBatchOutput {
+	var mu sync.Mutex
+	errs := make([]error, len(cmds))
+	next := 0
+	var pipeline *sdk.CommandPipeline
+	pipeline = sdk.NewCommandPipeline(func(cmd, requestID string) error {
+		c.game.record(cmd)
+		mu.Lock()
+		i := next
+		next++
+		mu.Unlock()
+		go func() {
+			resp := c.game.respond(cmd)
+			if resp.TimedOut {
+				return
+			}
+			output := &sdk.CommandResult{}
+			if resp.Err != nil {
+				mu.Lock()
+				errs[i] = resp.Err
+				mu.Unlock()
+			} else if resp.Output != nil {
+				*output = *resp.Output
+			}
+			output.Origin.RequestID = requestID
+			pipeline.Deliver(output)
+		}()
+		return nil
+	})
+
+	outputs := pipeline.SendBatch(cmds, timeout)
+	mu.Lock()
+	defer mu.Unlock()
+	for i, err := range errs {
+		if err != nil && !outputs[i].TimedOut {
+			outputs[i] = sdk.CommandBatchOutput{Err: err}
+		}
+	}
+	return outputs
+}
+
+// SendChat 记录机器人的聊天消息
+func (c *gameCommands) SendChat(message string) error {
+	c.game.mu.Lock()
+	defer c.game.mu.Unlock()
+	c.game.said = append(c.game.said, Message{Text: message})
+	return nil
+}
+
+// Title 记录以 actionbar 显示给所有玩家的消息
+func (c *gameCommands) Title(message string) error {
+	c.game.mu.Lock()
+	defer c.game.mu.Unlock()
+	c.game.said = append(c.game.said, Message{Target: "@a", Text: message})
+	return nil
+}
+
+// gameQuerytarget 是假游戏接口的目标查询器
+type gameQuerytarget struct {
+	game *Game
+}
+
+// DoQuerytarget 返回为 target 编排的查询结果
+func (q *gameQuerytarget) DoQuerytarget(target string) ([]sdk.QueryTargetResult, error) {
+	q.game.mu.Lock()
+	defer q.game.mu.Unlock()
+	q.game.queries = append(q.game.queries, target)
+	rule := q.game.targets[target]
+	return rule.results, rule.err
+}
@@ -0,0 +1,184 @@
+package sdktest
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/maoqijie/FIN-plugin/sdk"
+)
+
+func TestGameScriptedResponses(t *testing.T) {
+	game := NewGame()
+	utils := sdk.NewGameUtils(game)
+
+	game.SetScore("money", "Steve", 100)
+	score, err := utils.GetScore("money", "Steve", 1)
+	if err != nil || score != 100 {
+		t.Fatalf("GetScore = %d, %v; want 100", score, err)
+	}
+
+	game.OnCommand("scoreboard players test Alex", Timeout())
+	if _, err := utils.GetScore("money", "Alex", 1); err == nil {
+		t.Fatalf("GetScore on timeout: want error")
+	}
+
+	game.OnCommand("scoreboard players test Bob", Error(errors.New("no such objective")))
+	if _, err := utils.GetScore("money", "Bob", 1); err == nil {
+		t.Fatalf("GetScore on error: want error")
+	}
+
+	game.OnCommand("testfor ", Fail("commands.generic.noTargetMatch"))
+	game.OnCommand("testfor Steve", Succeed())
+	if ok, err := utils.IsCmdSuccess("testfor Steve", 1); err != nil || !ok {
+		t.Fatalf("IsCmdSuccess(Steve) = %v, %v; want true", ok, err)
+	}
+	if ok, err := utils.IsCmdSuccess("testfor Alex", 1); err != nil || ok {
+		t.Fatalf("IsCmdSuccess(Alex) = %v, %v; want false", ok, err)
+	}
+
+	want := []string{
+		"scoreboard players test Steve money * *",
+		"scoreboard players test Alex money * *",
+		"scoreboard players test Bob money * *",
+		"testfor Steve",
+		"testfor Alex",
+	}
+	if cmds := game.CommandLog(); !reflect.DeepEqual(cmds, want) {
+		t.Fatalf("commands = %q, want %q", cmds, want)
+	}
+}
+
+func TestGameQuerytarget(t *testing.T) {
+	game := NewGame()
+	utils := sdk.NewGameUtils(game)
+
+	game.OnQuerytarget("@a",
+		sdk.QueryTargetResult{EntityName: "Steve", Position: sdk.Position{X: 1, Y: 64, Z: 2, Dimension: 1, YRot: 90}},
+		sdk.QueryTargetResult{EntityName: "Alex"},
+	)
+	names, err := utils.GetTarget("@a", 1)
+	if err != nil || !reflect.DeepEqual(names, []string{"Steve", "Alex"}) {
+		t.Fatalf("GetTarget = %v, %v", names, err)
+	}
+
+	pos, err := utils.GetPos("@a")
+	if err != nil {
+		t.Fatalf("GetPos: %v", err)
+	}
+	if *pos != (sdk.Position{X: 1, Y: 64, Z: 2, Dimension: 1, YRot: 90}) {
+		t.Fatalf("GetPos = %+v", *pos)
+	}
+
+	if _, err := utils.GetPos("Herobrine"); err == nil {
+		t.Fatalf("GetPos of unknown player: want error")
+	}
+
+	game.FailQuerytarget("@e", errors.New("query failed"))
+	if _, err := utils.GetTarget("@e", 1); err == nil {
+		t.Fatalf("GetTarget on error: want error")
+	}
+
+	if queries := game.Queries(); !reflect.DeepEqual(queries, []string{"@a", "@a", "Herobrine", "@e"}) {
+		t.Fatalf("queries = %q", queries)
+	}
+}
+
+func TestGameTickingAreas(t *testing.T) {
+	game := NewGame()
+	utils := sdk.NewGameUtils(game)
+
+	game.OnCommand("tickingarea list", Response{Output: &sdk.CommandResult{
+		SuccessCount: 1,
+		OutputMessages: []sdk.CommandOutputMessage{
+			{Success: true, MessageID: "commands.tickingarea-list.success.allDimensions"},
+			{Success: true, MessageID: "overworld:"},
+			{Success: true, MessageID: "- my farm: 0 0 0 %commands.tickingarea-list.to 31 0 31"},
+			{Success: true, MessageID: "commands.tickingarea-list.circle", Parameters: []string{"- redstone:", "100 64 100", "Radius", "2", "nether"}},
+		},
+	}})
+	areas, err := utils.GetTickingAreas()
+	if err != nil {
+		t.Fatalf("GetTickingAreas: %v", err)
+	}
+	want := []sdk.TickingArea{
+		{Name: "my farm", Dimension: "overworld", Min: sdk.BlockPos{X: 0, Y: 0, Z: 0}, Max: sdk.BlockPos{X: 31, Y: 0, Z: 31}},
+		{Name: "redstone", Dimension: "nether", Circle: true, Min: sdk.BlockPos{X: 100, Y: 64, Z: 100}, Max: sdk.BlockPos{X: 100, Y: 64, Z: 100}, Radius: 2},
+	}
+	if !reflect.DeepEqual(areas, want) {
+		t.Fatalf("areas = %+v, want %+v", areas, want)
+	}
+
+	game.OnCommand("tickingarea", Succeed())
+	game.OnCommand("tickingarea remove \"gone\"", Fail("commands.tickingarea-remove.failure"))
+	if err := utils.AddTickingArea("my farm", sdk.BlockPos{X: 31, Y: 0, Z: 31}, sdk.BlockPos{}, true); err != nil {
+		t.Fatalf("AddTickingArea: %v", err)
+	}
+	if err := utils.AddTickingCircle("redstone", sdk.BlockPos{X: 100, Y: 64, Z: 100}, 5, false); err == nil {
+		t.Fatalf("AddTickingCircle with radius 5: want error")
+	}
+	if err := utils.RemoveTickingArea("gone"); err == nil {
+		t.Fatalf("RemoveTickingArea on failure: want error")
+	}
+
+	cmds := game.CommandLog()
+	if got := cmds[len(cmds)-2]; got != "tickingarea add 31 0 31 0 0 0 \"my farm\" true" {
+		t.Fatalf("add command = %q", got)
+	}
+}
+
+func TestGameMultiScore(t *testing.T) {
+	game := NewGame()
+	utils := sdk.NewGameUtils(game)
+
+	// 三条命令都在等待响应时才返回，逐条发送会超时
+	var inFlight sync.WaitGroup
+	inFlight.Add(3)
+	game.OnCommandFunc(func(cmd string) (Response, bool) {
+		inFlight.Done()
+		done := make(chan struct{})
+		go func() { inFlight.Wait(); close(done) }()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			return Timeout(), true
+		}
+		for name, score := range map[string]string{"Steve": "100", "Alex": "50"} {
+			if strings.HasPrefix(cmd, "scoreboard players test "+name+" ") {
+				return Succeed(score), true
+			}
+		}
+		return Error(errors.New("no such objective")), true
+	})
+
+	scores, err := utils.GetMultiScore("money", []string{"Steve", "Alex", "Bob"}, 2)
+	if err == nil || !strings.Contains(err.Error(), "Bob") {
+		t.Fatalf("GetMultiScore error = %v; want failure for Bob", err)
+	}
+	if want := map[string]int{"Steve": 100, "Alex": 50}; !reflect.DeepEqual(scores, want) {
+		t.Fatalf("scores = %v, want %v", scores, want)
+	}
+
+	game.OnCommand("testfor ", Succeed())
+	results := utils.SendCommandBatch([]string{"testfor Steve", "testfor Alex"}, 1)
+	if len(results) != 2 || results[0].Command != "testfor Steve" || !results[1].Succeeded() {
+		t.Fatalf("results = %+v", results)
+	}
+
+	// 启用命令队列时批量命令同样受 WS 通道限速：首条之后每 50ms 一条
+	utils.EnableCommandQueue(sdk.CommandQueueOptions{WSRate: 20})
+	defer utils.DisableCommandQueue(time.Second)
+	start := time.Now()
+	results = utils.SendCommandBatch([]string{"testfor A", "testfor B", "testfor C", "testfor D"}, 1)
+	if elapsed := time.Since(start); elapsed < 140*time.Millisecond {
+		t.Fatalf("4 commands at 20/s sent within %v", elapsed)
+	}
+	for _, r := range results {
+		if !r.Succeeded() {
+			t.Fatalf("results = %+v", results)
+		}
+	}
+}
@@ -0,0 +1,429 @@
+// Package sdktest 提供用于测试插件的内存假主进程
+//
+// Host 记录插件发送的命令、消息与日志，并可以向插件注入聊天、玩家进出、数据包与广播事件。
+// 同一个插件既可以直接运行在 Host 构造的 Context 上（与同进程插件相同），
+// 也可以经由真实的 GRPCServer/GRPCClient 通过内存连接运行（与跨平台插件相同），
+// 用于验证两种运行方式的行为一致。
+//
+// 示例:
+//
+//	func TestGreeting(t *testing.T) {
+//	    for _, mode := range sdktest.Modes {
+//	        t.Run(mode.String(), func(t *testing.T) {
+//	            host := sdktest.NewHost()
+//	            session, err := host.Load(&MyPlugin{}, mode)
+//	            if err != nil {
+//	                t.Fatal(err)
+//	            }
+//	            defer session.Stop()
+//
+//	            host.Chat("Steve", "hello")
+//	            if said := host.Said(); len(said) != 1 || said[0].Target != "Steve" {
+//	                t.Fatalf("unexpected messages: %v", said)
+//	            }
+//	        })
+//	    }
+//	}
+package sdktest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/maoqijie/FIN-plugin/sdk"
+)
+
+// LogEntry 表示插件写入的一条日志
+type LogEntry struct {
+	Level   string // "INFO"、"SUCCESS"、"WARNING"、"ERROR"，Logf 写入的日志为空
+	Message string
+}
+
+// Message 表示插件发送给玩家的一条消息
+type Message struct {
+	Target string // 目标选择器或玩家名，SendChat 发送的聊天消息为空
+	Text   string
+}
+
+// Host 是内存中的假主进程
+// 所有方法都可以并发调用
+type Host struct {
+	mu sync.Mutex
+
+	game    *Game
+	utils   *sdk.GameUtils
+	players *sdk.PlayerManager
+	apis    *sdk.PluginAPIRegistry
+
+	logs     []LogEntry
+	console  map[string]sdk.ConsoleCommand
+	nextID   int
+	handlers []*handler
+	waiters  map[string][]chan string
+}
+
+// handler 记录一个已注册的监听器
+type handler struct {
+	id       int
+	kind     string
+	priority int
+	name     string // 广播名称
+	ids      []uint32
+	fn       any
+}
+
+// NewHost 创建假主进程
+func NewHost() *Host {
+	h := &Host{
+		console: make(map[string]sdk.ConsoleCommand),
+		waiters: make(map[string][]chan string),
+		apis:    sdk.NewPluginAPIRegistry(),
+	}
+	h.game = NewGame()
+	h.utils = sdk.NewGameUtils(h.game)
+	h.players = sdk.NewPlayerManager(h.utils)
+	return h
+}
+
+// Game 返回 Host 使用的假游戏接口，用于设置命令响应或查看原始命令
+func (h *Host) Game() *Game {
+	return h.game
+}
+
+// Players 返回 Host 的玩家管理器，注入 PlayerJoin/PlayerLeave 时会自动更新
+func (h *Host) Players() *sdk.PlayerManager {
+	return h.players
+}
+
+// Options 返回由 Host 驱动的 ContextOptions
+func (h *Host) Options(pluginName string) sdk.ContextOptions {
+	return sdk.ContextOptions{
+		PluginName:            pluginName,
+		BotInfoFunc:           func() sdk.BotInfo { return sdk.BotInfo{Name: "bot"} },
+		ServerInfoFunc:        func() sdk.ServerInfo { return sdk.ServerInfo{} },
+		QQInfoFunc:            func() sdk.QQInfo { return sdk.QQInfo{} },
+		InterworkInfoFunc:     func() sdk.InterworkInfo { return sdk.InterworkInfo{LinkedGroups: map[string]int64{}} },
+		GameUtilsProvider:     func() *sdk.GameUtils { return h.utils },
+		PlayerManagerProvider: func() *sdk.PlayerManager { return h.players },
+		APIRegistryProvider:   func() *sdk.PluginAPIRegistry { return h.apis },
+		ConsoleRegistrar:      h.registerConsole,
+		ConsoleUnregistrar:    h.unregisterConsole,
+		Logger:                h.log,
+		RegisterPreload: func(fn sdk.PreloadHandler, priority int) (func(), error) {
+			return h.register(&handler{kind: "preload", priority: priority, fn: fn}), nil
+		},
+		RegisterActive: func(fn sdk.ActiveHandler, priority int) (func(), error) {
+			return h.register(&handler{kind: "active", priority: priority, fn: fn}), nil
+		},
+		RegisterPlayerJoin: func(fn sdk.PlayerEventHandler, priority int) (func(), error) {
+			return h.register(&handler{kind: "player_join", priority: priority, fn: fn}), nil
+		},
+		RegisterPlayerLeave: func(fn sdk.PlayerEventHandler, priority int) (func(), error) {
+			return h.register(&handler{kind: "player_leave", priority: priority, fn: fn}), nil
+		},
+		RegisterChat: func(fn sdk.ChatHandler, priority int) (func(), error) {
+			return h.register(&handler{kind: "chat", priority: priority, fn: fn}), nil
+		},
+		RegisterFrameExit: func(fn sdk.FrameExitHandler, priority int) (func(), error) {
+			return h.register(&handler{kind: "frame_exit", priority: priority, fn: fn}), nil
+		},
+		RegisterPacket: func(fn sdk.PacketHandler, ids []uint32, priority int) (func(), error) {
+			return h.register(&handler{kind: "packet", priority: priority, ids: ids, fn: fn}), nil
+		},
+		RegisterPacketAll: func(fn sdk.PacketHandler, priority int) (func(), error) {
+			return h.register(&handler{kind: "packet", priority: priority, fn: fn}), nil
+		},
+		RegisterBytesPacket: func(fn sdk.BytesPacketHandler, ids []uint32, priority int) (func(), error) {
+			return h.register(&handler{kind: "bytes_packet", priority: priority, ids: ids, fn: fn}), nil
+		},
+		RegisterBroadcast: func(name string, fn sdk.BroadcastHandler, priority int) (func(), error) {
+			return h.register(&handler{kind: "broadcast", priority: priority, name: name, fn: fn}), nil
+		},
+		TriggerBroadcast: h.Broadcast,
+		WaitPlayerMessage: func(playerName string, timeout time.Duration) (string, error) {
+			return h.waitMessage(context.Background(), playerName, timeout)
+		},
+		WaitPlayerMessageContext: h.waitMessage,
+	}
+}
+
+// Context 返回由 Host 驱动的 Context
+func (h *Host) Context(pluginName string) *sdk.Context {
+	return sdk.NewContext(h.Options(pluginName))
+}
+
+// register 记录监听器并返回移除函数
+func (h *Host) register(hd *handler) func() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.nextID++
+	hd.id = h.nextID
+	h.handlers = append(h.handlers, hd)
+	return func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		for i, existing := range h.handlers {
+			if existing.id == hd.id {
+				h.handlers = append(h.handlers[:i], h.handlers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// matching 按优先级从高到低返回指定类型的监听器，优先级相同时按注册顺序
+func (h *Host) matching(kind string, match func(*handler) bool) []*handler {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var result []*handler
+	for _, hd := range h.handlers {
+		if hd.kind == kind && (match == nil || match(hd)) {
+			result = append(result, hd)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].priority > result[j].priority
+	})
+	return result
+}
+
+// Handlers 返回指定类型当前注册的监听器数量
+// 类型为 "chat"、"player_join"、"player_leave"、"packet"、"bytes_packet"、"preload"、"active"、"frame_exit"、"broadcast"
+func (h *Host) Handlers(kind string) int {
+	return len(h.matching(kind, nil))
+}
+
+func (h *Host) registerConsole(cmd sdk.ConsoleCommand) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	triggers := append([]string{cmd.Name}, cmd.Triggers...)
+	for _, trigger := range triggers {
+		if _, exists := h.console[trigger]; exists {
+			return fmt.Errorf("控制台命令 %s 已存在", trigger)
+		}
+	}
+	for _, trigger := range triggers {
+		if trigger != "" {
+			h.console[trigger] = cmd
+		}
+	}
+	return nil
+}
+
+func (h *Host) unregisterConsole(cmd sdk.ConsoleCommand) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for trigger, registered := range h.console {
+		if registered.Name == cmd.Name {
+			delete(h.console, trigger)
+		}
+	}
+}
+
+// logLevels 是 Context 日志方法写入的前缀
+var logLevels = []string{"INFO", "SUCCESS", "WARNING", "ERROR"}
+
+func (h *Host) log(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	entry := LogEntry{Message: msg}
+	for _, level := range logLevels {
+		// Context.LogXxx 写入形如 "\x1b[44m INFO \x1b[0m 消息" 的日志
+		if _, rest, ok := strings.Cut(msg, " "+level+" \x1b[0m "); ok && strings.HasPrefix(msg, "\x1b[") {
+			entry = LogEntry{Level: level, Message: rest}
+			break
+		}
+	}
+	h.mu.Lock()
+	h.logs = append(h.logs, entry)
+	h.mu.Unlock()
+}
+
+// Logs 返回插件写入的所有日志
+func (h *Host) Logs() []LogEntry {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]LogEntry(nil), h.logs...)
+}
+
+// Commands 返回插件发送的所有游戏命令（包括 SayTo、Tellraw 生成的 tellraw 命令）
+func (h *Host) Commands() []string {
+	return h.game.CommandLog()
+}
+
+// Said 返回插件发送给玩家的所有消息（SayTo、Tellraw 与 SendChat）
+func (h *Host) Said() []Message {
+	return h.game.Said()
+}
+
+// Reset 清空已记录的命令、消息与日志，已注册的监听器保持不变
+func (h *Host) Reset() {
+	h.mu.Lock()
+	h.logs = nil
+	h.mu.Unlock()
+	h.game.Reset()
+}
+
+// waitMessage 等待玩家的下一条聊天消息，超时或 ctx 结束时移除等待者
+func (h *Host) waitMessage(ctx context.Context, playerName string, timeout time.Duration) (string, error) {
+	ch := make(chan string, 1)
+	h.mu.Lock()
+	h.waiters[playerName] = append(h.waiters[playerName], ch)
+	h.mu.Unlock()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case message := <-ch:
+		return message, nil
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for i, waiter := range h.waiters[playerName] {
+		if waiter == ch {
+			h.waiters[playerName] = append(h.waiters[playerName][:i], h.waiters[playerName][i+1:]...)
+			break
+		}
+	}
+	// 移除前消息可能已经送达
+	select {
+	case message := <-ch:
+		return message, nil
+	default:
+	}
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("等待 %s 的消息超时", playerName)
+}
+
+// Waiting 返回正在等待该玩家消息（Context.WaitMessage）的调用数
+func (h *Host) Waiting(playerName string) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.waiters[playerName])
+}
+
+// Chat 注入一条聊天消息，返回所有处理器处理后的事件
+func (h *Host) Chat(sender, message string) *sdk.ChatEvent {
+	return h.ChatEvent(&sdk.ChatEvent{Sender: sender, Message: message})
+}
+
+// ChatEvent 注入完整的聊天事件，返回处理后的事件
+// 有插件通过 WaitMessage 等待该玩家时，消息交给最早的等待者，不再传递给聊天处理器；
+// 事件被取消后不再传递给低优先级处理器
+func (h *Host) ChatEvent(event *sdk.ChatEvent) *sdk.ChatEvent {
+	h.mu.Lock()
+	if waiters := h.waiters[event.Sender]; len(waiters) > 0 {
+		h.waiters[event.Sender] = waiters[1:]
+		h.mu.Unlock()
+		waiters[0] <- event.Message
+		return event
+	}
+	h.mu.Unlock()
+
+	for _, hd := range h.matching("chat", nil) {
+		if event.Cancelled {
+			break
+		}
+		hd.fn.(sdk.ChatHandler)(event)
+	}
+	return event
+}
+
+// PlayerJoin 注入玩家加入事件，并把玩家加入 Players()
+func (h *Host) PlayerJoin(event sdk.PlayerEvent) {
+	h.players.AddPlayer(event.Name, event.UUID, event.XUID, event.EntityUniqueID, event.EntityRuntimeID)
+	for _, hd := range h.matching("player_join", nil) {
+		hd.fn.(sdk.PlayerEventHandler)(event)
+	}
+}
+
+// PlayerLeave 注入玩家离开事件，并把玩家从 Players() 中移除
+func (h *Host) PlayerLeave(event sdk.PlayerEvent) {
+	for _, hd := range h.matching("player_leave", nil) {
+		hd.fn.(sdk.PlayerEventHandler)(event)
+	}
+	h.players.RemovePlayer(event.Name)
+}
+
+// Packet 注入数据包，交给监听该 ID 的处理器与 ListenPacketAll 处理器
+// data 为原始协议字节，可以为 nil
+func (h *Host) Packet(id uint32, pk any, data []byte) {
+	event := sdk.PacketEvent{ID: id, Raw: pk, Data: data}
+	for _, hd := range h.matching("packet", func(hd *handler) bool { return listensTo(hd, id) }) {
+		hd.fn.(sdk.PacketHandler)(event)
+	}
+}
+
+// BytesPacket 注入原始字节数据包，返回是否被某个处理器拦截
+func (h *Host) BytesPacket(id uint32, data []byte) bool {
+	for _, hd := range h.matching("bytes_packet", func(hd *handler) bool { return listensTo(hd, id) }) {
+		if hd.fn.(sdk.BytesPacketHandler)(id, data) {
+			return true
+		}
+	}
+	return false
+}
+
+// listensTo 判断监听器是否关注该数据包 ID，未指定 ID 表示监听所有数据包
+func listensTo(hd *handler, id uint32) bool {
+	if len(hd.ids) == 0 {
+		return true
+	}
+	for _, want := range hd.ids {
+		if want == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Broadcast 注入广播事件，返回所有处理器的非 nil 返回值
+func (h *Host) Broadcast(broadcast sdk.Broadcast) []interface{} {
+	results := []interface{}{}
+	for _, hd := range h.matching("broadcast", func(hd *handler) bool { return hd.name == broadcast.Name }) {
+		if result := hd.fn.(sdk.BroadcastHandler)(broadcast); result != nil {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// Preload 注入预加载事件
+func (h *Host) Preload() {
+	for _, hd := range h.matching("preload", nil) {
+		hd.fn.(sdk.PreloadHandler)()
+	}
+}
+
+// Active 注入激活事件
+func (h *Host) Active() {
+	for _, hd := range h.matching("active", nil) {
+		hd.fn.(sdk.ActiveHandler)()
+	}
+}
+
+// FrameExit 注入框架退出事件
+func (h *Host) FrameExit(event sdk.FrameExitEvent) {
+	for _, hd := range h.matching("frame_exit", nil) {
+		hd.fn.(sdk.FrameExitHandler)(event)
+	}
+}
+
+// Console 执行插件注册的控制台命令
+func (h *Host) Console(trigger string, args ...string) error {
+	h.mu.Lock()
+	cmd, ok := h.console[trigger]
+	h.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("控制台命令 %s 不存在", trigger)
+	}
+	return cmd.Handler(args)
+}
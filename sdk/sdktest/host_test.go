@@ -0,0 +1,538 @@
+package sdktest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/maoqijie/FIN-plugin/sdk"
+)
+
+// echoPlugin 是覆盖常用事件的示例插件
+type echoPlugin struct {
+	ctx *sdk.Context
+
+	mu      sync.Mutex
+	joined  []string
+	packets []uint32
+}
+
+func (p *echoPlugin) GetInfo() sdk.PluginInfo {
+	return sdk.PluginInfo{Name: "echo", Version: "1.0.0"}
+}
+
+func (p *echoPlugin) Init(ctx *sdk.Context) error {
+	p.ctx = ctx
+	ctx.LogInfo("echo loaded")
+
+	if _, err := ctx.ListenChat(func(event *sdk.ChatEvent) {
+		switch event.Message {
+		case "secret":
+			event.Cancelled = true
+		case "ping":
+			ctx.GameUtils().SayTo(event.Sender, "pong")
+			ctx.GameUtils().SendCommand("give " + event.Sender + " apple")
+			event.Message = "ping!"
+		}
+	}); err != nil {
+		return err
+	}
+	if _, err := ctx.ListenPlayerJoin(func(event sdk.PlayerEvent) {
+		p.mu.Lock()
+		p.joined = append(p.joined, event.Name)
+		p.mu.Unlock()
+	}); err != nil {
+		return err
+	}
+	if _, err := ctx.ListenPacket(func(event sdk.PacketEvent) {
+		p.mu.Lock()
+		p.packets = append(p.packets, event.ID)
+		p.mu.Unlock()
+	}, 9); err != nil {
+		return err
+	}
+	if _, err := ctx.ListenBroadcast("echo.ping", func(b sdk.Broadcast) interface{} {
+		return fmt.Sprint("pong:", b.Data["from"])
+	}); err != nil {
+		return err
+	}
+	return ctx.RegisterConsoleCommand(sdk.ConsoleCommand{
+		Name:    "echo",
+		Handler: func(args []string) error { return nil },
+	})
+}
+
+func (p *echoPlugin) Start() error {
+	p.ctx.LogSuccess("echo started")
+	return nil
+}
+
+func (p *echoPlugin) Stop() error {
+	return nil
+}
+
+func load(t *testing.T, host *Host, impl sdk.Plugin, mode Mode) *Session {
+	t.Helper()
+	session, err := host.Load(impl, mode)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	t.Cleanup(func() { session.Stop() })
+	return session
+}
+
+func TestHostParity(t *testing.T) {
+	for _, mode := range Modes {
+		t.Run(mode.String(), func(t *testing.T) {
+			host := NewHost()
+			plugin := &echoPlugin{}
+			load(t, host, plugin, mode)
+
+			wantLogs := []LogEntry{{Level: "INFO", Message: "echo loaded"}, {Level: "SUCCESS", Message: "echo started"}}
+			if logs := host.Logs(); !reflect.DeepEqual(logs, wantLogs) {
+				t.Fatalf("logs = %v, want %v", logs, wantLogs)
+			}
+
+			if event := host.Chat("Steve", "ping"); event.Message != "ping!" || event.Cancelled {
+				t.Fatalf("chat event = %+v", event)
+			}
+			if event := host.Chat("Steve", "secret"); !event.Cancelled {
+				t.Fatalf("chat event not cancelled")
+			}
+			if said := host.Said(); !reflect.DeepEqual(said, []Message{{Target: "Steve", Text: "pong"}}) {
+				t.Fatalf("said = %v", said)
+			}
+			if cmds := host.Commands(); len(cmds) != 2 || cmds[1] != "give Steve apple" {
+				t.Fatalf("commands = %q", cmds)
+			}
+
+			host.PlayerJoin(sdk.PlayerEvent{Name: "Alex"})
+			host.Packet(9, map[string]interface{}{"Message": "hi"}, nil)
+			host.Packet(10, map[string]interface{}{}, nil)
+			plugin.mu.Lock()
+			joined, packets := plugin.joined, plugin.packets
+			plugin.mu.Unlock()
+			if !reflect.DeepEqual(joined, []string{"Alex"}) {
+				t.Fatalf("joined = %v", joined)
+			}
+			if !reflect.DeepEqual(packets, []uint32{9}) {
+				t.Fatalf("packets = %v", packets)
+			}
+			if host.Players().GetPlayerByName("Alex") == nil {
+				t.Fatalf("player Alex not tracked")
+			}
+
+			results := host.Broadcast(sdk.Broadcast{Name: "echo.ping", Data: map[string]interface{}{"from": "test"}})
+			if !reflect.DeepEqual(results, []interface{}{"pong:test"}) {
+				t.Fatalf("broadcast results = %v", results)
+			}
+		})
+	}
+}
+
+func TestHostStopRemovesHandlers(t *testing.T) {
+	host := NewHost()
+	session := load(t, host, &echoPlugin{}, GRPC)
+	if n := host.Handlers("chat"); n != 1 {
+		t.Fatalf("chat handlers = %d, want 1", n)
+	}
+	if err := session.Stop(); err != nil {
+		t.Fatalf("stop: %v", err)
+	}
+	if n := host.Handlers("chat"); n != 0 {
+		t.Fatalf("chat handlers after stop = %d, want 0", n)
+	}
+	if err := host.Console("echo"); err == nil {
+		t.Fatalf("console command still registered after stop")
+	}
+
+	// 重新加载（如崩溃后重启）时可以再次注册同名命令
+	load(t, host, &echoPlugin{}, GRPC)
+	if err := host.Console("echo", "hi"); err != nil {
+		t.Fatalf("console after reload: %v", err)
+	}
+}
+
+// inventoryPlugin 在 Init 中开始跟踪背包
+type inventoryPlugin struct {
+	ctx *sdk.Context
+}
+
+func (p *inventoryPlugin) GetInfo() sdk.PluginInfo { return sdk.PluginInfo{Name: "inventory"} }
+
+func (p *inventoryPlugin) Init(ctx *sdk.Context) error {
+	p.ctx = ctx
+	ctx.Inventory().SetItemName(257, "minecraft:apple")
+	return nil
+}
+
+func (p *inventoryPlugin) Start() error { return nil }
+
+func (p *inventoryPlugin) Stop() error { return nil }
+
+func TestHostInventory(t *testing.T) {
+	for _, mode := range Modes {
+		t.Run(mode.String(), func(t *testing.T) {
+			host := NewHost()
+			plugin := &inventoryPlugin{}
+			load(t, host, plugin, mode)
+
+			host.Packet(sdk.PacketIDInventoryContent, map[string]interface{}{
+				"WindowID": 0,
+				"Content": []interface{}{
+					map[string]interface{}{"Stack": map[string]interface{}{"ItemType": map[string]interface{}{"NetworkID": 257}, "Count": 3}},
+				},
+			}, nil)
+
+			slots, err := plugin.ctx.GameUtils().GetInventory("@s")
+			if err != nil {
+				t.Fatalf("GetInventory: %v", err)
+			}
+			if len(slots) != 1 || slots[0].ItemID != "minecraft:apple" || slots[0].Count != 3 {
+				t.Fatalf("slots = %+v", slots)
+			}
+		})
+	}
+}
+
+// contextPlugin 只保存 Context，供测试直接调用 GameUtils
+type contextPlugin struct {
+	ctx *sdk.Context
+}
+
+func (p *contextPlugin) GetInfo() sdk.PluginInfo {
+	return sdk.PluginInfo{Name: "context", Version: "1.0.0"}
+}
+
+func (p *contextPlugin) Init(ctx *sdk.Context) error {
+	p.ctx = ctx
+	return nil
+}
+
+func (p *contextPlugin) Start() error { return nil }
+
+func (p *contextPlugin) Stop() error { return nil }
+
+func TestHostGameUtilsParity(t *testing.T) {
+	for _, mode := range Modes {
+		t.Run(mode.String(), func(t *testing.T) {
+			host := NewHost()
+			plugin := &contextPlugin{}
+			load(t, host, plugin, mode)
+			utils := plugin.ctx.GameUtils()
+
+			host.Game().OnCommand("testfor Alex", Fail("commands.generic.noTargetMatch"))
+			if ok, err := utils.IsCmdSuccess("testfor Steve", 1); err != nil || !ok {
+				t.Fatalf("IsCmdSuccess(Steve) = %v, %v", ok, err)
+			}
+			if ok, err := utils.IsCmdSuccess("testfor Alex", 1); err != nil || ok {
+				t.Fatalf("IsCmdSuccess(Alex) = %v, %v", ok, err)
+			}
+			if err := utils.ClearEffect("@a", -1); err != nil {
+				t.Fatalf("ClearEffect: %v", err)
+			}
+			if err := utils.TakeItemOutItemFrame(1, 2, 3); err != nil {
+				t.Fatalf("TakeItemOutItemFrame: %v", err)
+			}
+			if err := utils.SendChat("hello"); err != nil {
+				t.Fatalf("SendChat: %v", err)
+			}
+			if err := utils.Title("notice"); err != nil {
+				t.Fatalf("Title: %v", err)
+			}
+
+			wantCmds := []string{
+				"testfor Steve",
+				"testfor Alex",
+				"effect @a clear",
+				"kill @e[type=item_frame,x=1,y=2,z=3,r=1]",
+			}
+			if cmds := host.Commands(); !reflect.DeepEqual(cmds, wantCmds) {
+				t.Fatalf("commands = %q, want %q", cmds, wantCmds)
+			}
+			wantSaid := []Message{{Text: "hello"}, {Target: "@a", Text: "notice"}}
+			if said := host.Said(); !reflect.DeepEqual(said, wantSaid) {
+				t.Fatalf("said = %v, want %v", said, wantSaid)
+			}
+		})
+	}
+}
+
+// hungPacketPlugin 的数据包处理器在 release 关闭前一直阻塞
+type hungPacketPlugin struct {
+	release chan struct{}
+	entered atomic.Int32
+}
+
+func (p *hungPacketPlugin) GetInfo() sdk.PluginInfo { return sdk.PluginInfo{Name: "hung"} }
+
+func (p *hungPacketPlugin) Init(ctx *sdk.Context) error {
+	if _, err := ctx.ListenPacket(func(event sdk.PacketEvent) {
+		p.entered.Add(1)
+		<-p.release
+	}, 9); err != nil {
+		return err
+	}
+	_, err := ctx.ListenChat(func(event *sdk.ChatEvent) {
+		event.Message = "seen"
+	})
+	return err
+}
+
+func (p *hungPacketPlugin) Start() error { return nil }
+
+func (p *hungPacketPlugin) Stop() error { return nil }
+
+func TestHostHungPacketHandlerDoesNotBlockChat(t *testing.T) {
+	host := NewHost()
+	plugin := &hungPacketPlugin{release: make(chan struct{})}
+	load(t, host, plugin, GRPC)
+	t.Cleanup(func() { close(plugin.release) })
+
+	// 一个处理中、64 个排队，其余的数据包改用单次调用投递，同样交给处理器而不是被丢弃
+	const packets = 80
+	for i := 0; i < packets; i++ {
+		go host.Packet(9, map[string]interface{}{}, nil)
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for plugin.entered.Load() < packets-64 {
+		if time.Now().After(deadline) {
+			t.Fatalf("only %d packet events reached the handler while it is hung, want %d", plugin.entered.Load(), packets-64)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	start := time.Now()
+	if event := host.Chat("Steve", "hi"); event.Message != "seen" {
+		t.Fatalf("chat event = %+v", event)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("chat event took %v behind the hung packet handler", elapsed)
+	}
+}
+
+func TestHostWaitMessageCancel(t *testing.T) {
+	for _, mode := range Modes {
+		t.Run(mode.String(), func(t *testing.T) {
+			host := NewHost()
+			plugin := &contextPlugin{}
+			load(t, host, plugin, mode)
+
+			answer := make(chan string, 1)
+			go func() {
+				message, _ := plugin.ctx.WaitMessage("Steve", 5*time.Second)
+				answer <- message
+			}()
+			waitFor(t, func() bool { return host.Waiting("Steve") == 1 })
+			host.Chat("Steve", "yes")
+			if message := <-answer; message != "yes" {
+				t.Fatalf("WaitMessage = %q, want %q", message, "yes")
+			}
+
+			// 放弃等待后主进程必须移除等待者，否则它会吞掉该玩家的下一条消息
+			waitCtx, cancel := context.WithCancel(context.Background())
+			go func() {
+				waitFor(t, func() bool { return host.Waiting("Steve") == 1 })
+				cancel()
+			}()
+			if _, err := plugin.ctx.WaitMessageContext(waitCtx, "Steve", 5*time.Second); !errors.Is(err, context.Canceled) {
+				t.Fatalf("WaitMessageContext after cancel: %v", err)
+			}
+			waitFor(t, func() bool { return host.Waiting("Steve") == 0 })
+		})
+	}
+}
+
+// waitFor 在 1 秒内轮询 cond，超时则测试失败
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Errorf("condition not met within 1s")
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestHostGetBlockFallback(t *testing.T) {
+	host := NewHost()
+	utils := host.Context("blocks").GameUtils()
+
+	host.Game().OnCommand("testforblock 1 2 3", Fail("commands.testforblock.failed.tile"))
+	if name, err := utils.GetBlock(1, 2, 3); err == nil {
+		t.Fatalf("GetBlock on a non-air block = %q, want error", name)
+	}
+	if name, err := utils.GetBlock(0, 0, 0); err != nil || name != "minecraft:air" {
+		t.Fatalf("GetBlock on air = %q, %v", name, err)
+	}
+}
+
+func TestHostCommandQueueRateLimitsAllCommands(t *testing.T) {
+	for _, mode := range Modes {
+		t.Run(mode.String(), func(t *testing.T) {
+			host := NewHost()
+			plugin := &contextPlugin{}
+			load(t, host, plugin, mode)
+			utils := plugin.ctx.GameUtils()
+
+			// 首条命令之后每 100ms 发送一条
+			queue := utils.EnableCommandQueue(sdk.CommandQueueOptions{WSRate: 10})
+			for _, send := range []func() error{
+				func() error { return utils.ClearEffect("@a", -1) },
+				func() error { return utils.ClearEffect("@a", -1) },
+				func() error { return utils.SetEffect("Steve", 1, sdk.EffectOptions{Duration: 10}) },
+				func() error { return utils.Tellraw("@a", "hi") },
+				func() error { return utils.SayTo("Steve", "hello") },
+			} {
+				if err := send(); err != nil {
+					t.Fatalf("send: %v", err)
+				}
+			}
+			if stats := queue.Stats()[sdk.CommandChannelWS]; stats.Pending < 4 {
+				t.Fatalf("stats right after sending = %+v, want at least 4 pending", stats)
+			}
+			if err := utils.DisableCommandQueue(2 * time.Second); err != nil {
+				t.Fatalf("DisableCommandQueue: %v", err)
+			}
+			if stats := queue.Stats()[sdk.CommandChannelWS]; stats.Sent != 5 {
+				t.Fatalf("stats after drain = %+v", stats)
+			}
+
+			wantCmds := []string{
+				"effect @a clear",
+				"effect @a clear",
+				"effect Steve 1 10 0",
+				`tellraw @a {"rawtext":[{"text":"hi"}]}`,
+				`tellraw Steve {"rawtext":[{"text":"hello"}]}`,
+			}
+			if cmds := host.Commands(); !reflect.DeepEqual(cmds, wantCmds) {
+				t.Fatalf("commands = %q, want %q", cmds, wantCmds)
+			}
+		})
+	}
+}
+
+// apiPlugin 导出一个 API 并监听二进制数据包
+type apiPlugin struct{}
+
+func (p *apiPlugin) GetInfo() sdk.PluginInfo { return sdk.PluginInfo{Name: "api"} }
+
+func (p *apiPlugin) Init(ctx *sdk.Context) error {
+	if !ctx.HostSupports(sdk.CapabilityPluginAPI) {
+		return fmt.Errorf("HostSupports(%s) = false", sdk.CapabilityPluginAPI)
+	}
+	if _, err := ctx.ListenBytesPacket(func(id uint32, data []byte) bool { return true }, 9); err != nil {
+		return err
+	}
+	return ctx.ExportPluginAPI("greeter", sdk.PluginAPIVersion{Major: 1}, sdk.PluginAPIMethods{
+		"Greet": sdk.JSONMethod(func(name string) (string, error) { return "hello " + name, nil }),
+	})
+}
+
+func (p *apiPlugin) Start() error { return nil }
+
+func (p *apiPlugin) Stop() error { return nil }
+
+// 协议版本 1 的主进程不公布能力，不能因此把已实现的功能当作不支持
+func TestHostProtocolV1Capabilities(t *testing.T) {
+	for _, mode := range Modes {
+		t.Run(mode.String(), func(t *testing.T) {
+			host := NewHost()
+			load(t, host, &apiPlugin{}, mode)
+
+			consumer := &contextPlugin{}
+			load(t, host, consumer, mode)
+			client, err := consumer.ctx.GetPluginAPIClient("greeter")
+			if err != nil {
+				t.Fatalf("GetPluginAPIClient: %v", err)
+			}
+			var greeting string
+			if err := client.CallJSON("Greet", "Steve", &greeting); err != nil || greeting != "hello Steve" {
+				t.Fatalf("Greet = %q, %v", greeting, err)
+			}
+			if !host.BytesPacket(9, []byte{1}) {
+				t.Fatalf("bytes packet was not intercepted")
+			}
+		})
+	}
+}
+
+// paramPlugin 原地修改聊天事件的第一个参数
+type paramPlugin struct{}
+
+func (p *paramPlugin) GetInfo() sdk.PluginInfo { return sdk.PluginInfo{Name: "param"} }
+
+func (p *paramPlugin) Init(ctx *sdk.Context) error {
+	_, err := ctx.ListenChat(func(event *sdk.ChatEvent) {
+		if len(event.Parameters) > 0 {
+			event.Parameters[0] = "edited"
+		}
+	})
+	return err
+}
+
+func (p *paramPlugin) Start() error { return nil }
+
+func (p *paramPlugin) Stop() error { return nil }
+
+func TestHostChatParametersEditedInPlace(t *testing.T) {
+	for _, mode := range Modes {
+		t.Run(mode.String(), func(t *testing.T) {
+			host := NewHost()
+			load(t, host, &paramPlugin{}, mode)
+
+			event := host.ChatEvent(&sdk.ChatEvent{Sender: "Steve", Message: "hi", Parameters: []string{"original", "x"}})
+			if want := []string{"edited", "x"}; !reflect.DeepEqual(event.Parameters, want) {
+				t.Fatalf("parameters = %q, want %q", event.Parameters, want)
+			}
+		})
+	}
+}
+
+func TestHostCommandBatch(t *testing.T) {
+	for _, mode := range Modes {
+		t.Run(mode.String(), func(t *testing.T) {
+			host := NewHost()
+			plugin := &contextPlugin{}
+			load(t, host, plugin, mode)
+			host.Game().OnCommand("testfor Steve", Succeed("Steve"))
+			host.Game().OnCommand("testfor Alex", Fail("commands.generic.noTargetMatch"))
+			host.Game().OnCommand("testfor Bob", Timeout())
+			host.Game().OnCommand("testfor Eve", Error(errors.New("no connection")))
+
+			cmds := []string{"testfor Steve", "testfor Alex", "testfor Bob", "testfor Eve"}
+			results := plugin.ctx.GameUtils().SendCommandBatch(cmds, 0.2)
+			if len(results) != len(cmds) {
+				t.Fatalf("got %d results, want %d", len(results), len(cmds))
+			}
+			for i, r := range results {
+				if r.Command != cmds[i] {
+					t.Fatalf("result %d is for %q, want %q", i, r.Command, cmds[i])
+				}
+			}
+			if r := results[0]; !r.Succeeded() || r.Result.OutputMessages[0].Parameters[0] != "Steve" {
+				t.Errorf("Steve = %+v", r)
+			}
+			if r := results[1]; r.Succeeded() || r.Err != nil || r.TimedOut || r.Result == nil {
+				t.Errorf("Alex = %+v, want failed command output", r)
+			}
+			if r := results[2]; !r.TimedOut {
+				t.Errorf("Bob = %+v, want timeout", r)
+			}
+			if r := results[3]; r.Err == nil || !strings.Contains(r.Err.Error(), "no connection") {
+				t.Errorf("Eve = %+v, want error", r)
+			}
+			if log := host.Game().CommandLog(); !reflect.DeepEqual(log, cmds) {
+				t.Errorf("command log = %v, want %v", log, cmds)
+			}
+		})
+	}
+}
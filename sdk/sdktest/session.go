@@ -0,0 +1,215 @@
+package sdktest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	"github.com/maoqijie/FIN-plugin/sdk"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Mode 是插件在 Host 上的运行方式
+type Mode int
+
+const (
+	// Direct 插件直接运行在 Host 构造的 Context 上，与同进程插件相同
+	Direct Mode = iota
+	// GRPC 插件经由真实的 GRPCServer/GRPCClient 通过内存连接运行，与跨平台插件相同
+	GRPC
+	// GRPCV1 与 GRPC 相同，但双方使用协议版本 1（不协商能力、不使用事件流），
+	// 与未使用 VersionedPlugins 的主进程或插件相同
+	GRPCV1
+)
+
+// Modes 是全部运行方式，用于编写对所有方式都成立的测试
+var Modes = []Mode{Direct, GRPC, GRPCV1}
+
+func (m Mode) String() string {
+	switch m {
+	case Direct:
+		return "direct"
+	case GRPC:
+		return "grpc"
+	case GRPCV1:
+		return "grpc-v1"
+	default:
+		return fmt.Sprintf("Mode(%d)", int(m))
+	}
+}
+
+// bufSize 是内存连接的缓冲区大小
+const bufSize = 1 << 20
+
+// Session 是加载到 Host 上的插件
+type Session struct {
+	// Plugin 是主进程一侧看到的插件：Direct 方式为插件本身，GRPC 方式为 *sdk.GRPCClient
+	Plugin sdk.Plugin
+
+	stopOnce sync.Once
+	stopErr  error
+	cleanup  func()
+}
+
+// Stop 停止插件并释放内存连接，可以重复调用
+func (s *Session) Stop() error {
+	s.stopOnce.Do(func() {
+		s.stopErr = s.Plugin.Stop()
+		if s.cleanup != nil {
+			s.cleanup()
+		}
+	})
+	return s.stopErr
+}
+
+// Load 以指定方式初始化并启动插件
+// 插件名取自 impl.GetInfo().Name
+func (h *Host) Load(impl sdk.Plugin, mode Mode) (*Session, error) {
+	name := impl.GetInfo().Name
+	switch mode {
+	case Direct:
+		if err := impl.Init(h.Context(name)); err != nil {
+			return nil, err
+		}
+		if err := impl.Start(); err != nil {
+			return nil, err
+		}
+		return &Session{Plugin: impl}, nil
+	case GRPC:
+		return h.loadGRPC(impl, name, sdk.ProtocolVersion)
+	case GRPCV1:
+		return h.loadGRPC(impl, name, sdk.ProtocolVersion1)
+	default:
+		return nil, fmt.Errorf("unknown mode %v", mode)
+	}
+}
+
+// loadGRPC 通过内存连接把插件挂到真实的 GRPCServer/GRPCClient 上，双方使用协议版本 version
+func (h *Host) loadGRPC(impl sdk.Plugin, name string, version int) (*Session, error) {
+	broker := newMemoryBroker()
+
+	server := grpc.NewServer()
+	sdk.RegisterPluginServiceServer(server, (&sdk.PluginGRPC{Impl: impl, ProtocolVersion: version}).NewGRPCServer(broker))
+	lis := bufconn.Listen(bufSize)
+	go server.Serve(lis)
+
+	conn, err := dialListener(lis)
+	if err != nil {
+		server.Stop()
+		broker.Close()
+		return nil, err
+	}
+	cleanup := func() {
+		conn.Close()
+		server.Stop()
+		broker.Close()
+	}
+
+	client := (&sdk.PluginGRPC{ProtocolVersion: version}).NewGRPCClient(conn, broker)
+	if err := client.Init(h.Context(name)); err != nil {
+		client.Close()
+		cleanup()
+		return nil, err
+	}
+	if err := client.Start(); err != nil {
+		client.Stop()
+		cleanup()
+		return nil, err
+	}
+	return &Session{Plugin: client, cleanup: cleanup}, nil
+}
+
+// dialListener 建立到内存监听器的 gRPC 连接
+func dialListener(lis *bufconn.Listener) (*grpc.ClientConn, error) {
+	return grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+}
+
+// memoryBroker 是基于内存连接的 sdk.Broker 实现
+type memoryBroker struct {
+	nextID atomic.Uint32
+
+	mu        sync.Mutex
+	cond      *sync.Cond
+	closed    bool
+	listeners map[uint32]*bufconn.Listener
+	servers   []*grpc.Server
+	conns     []*grpc.ClientConn
+}
+
+func newMemoryBroker() *memoryBroker {
+	b := &memoryBroker{listeners: make(map[uint32]*bufconn.Listener)}
+	b.cond = sync.NewCond(&b.mu)
+	return b
+}
+
+func (b *memoryBroker) NextId() uint32 {
+	return b.nextID.Add(1)
+}
+
+// AcceptAndServe 在 id 上提供服务，直到 Broker 关闭
+func (b *memoryBroker) AcceptAndServe(id uint32, newServer func([]grpc.ServerOption) *grpc.Server) {
+	server := newServer(nil)
+	lis := bufconn.Listen(bufSize)
+
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return
+	}
+	b.listeners[id] = lis
+	b.servers = append(b.servers, server)
+	b.cond.Broadcast()
+	b.mu.Unlock()
+
+	server.Serve(lis)
+}
+
+// Dial 等待 id 上的服务就绪后建立连接
+func (b *memoryBroker) Dial(id uint32) (*grpc.ClientConn, error) {
+	b.mu.Lock()
+	for b.listeners[id] == nil && !b.closed {
+		b.cond.Wait()
+	}
+	if b.closed {
+		b.mu.Unlock()
+		return nil, errors.New("broker closed")
+	}
+	lis := b.listeners[id]
+	b.mu.Unlock()
+
+	conn, err := dialListener(lis)
+	if err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	b.conns = append(b.conns, conn)
+	b.mu.Unlock()
+	return conn, nil
+}
+
+// Close 关闭所有连接与服务
+func (b *memoryBroker) Close() {
+	b.mu.Lock()
+	b.closed = true
+	conns, servers := b.conns, b.servers
+	b.conns, b.servers = nil, nil
+	b.cond.Broadcast()
+	b.mu.Unlock()
+
+	for _, conn := range conns {
+		conn.Close()
+	}
+	for _, server := range servers {
+		server.Stop()
+	}
+}
@@ -0,0 +1,462 @@
+package sdk
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Target 是 GameUtils 与 Player 方法的目标参数
+// 可以是 string（玩家名或选择器字符串）、Selector 或 *Selector
+//
+// 示例:
+//
+//	utils.SayTo("Steve", "你好")
+//	utils.SayTo("@a[tag=vip]", "欢迎")
+//	utils.SayTo(sdk.AllPlayers().Tag("vip"), "欢迎")
+type Target interface{}
+
+// 选择器类型
+const (
+	SelectorAllPlayers    = "a"         // @a 所有玩家
+	SelectorNearestPlayer = "p"         // @p 最近的玩家
+	SelectorRandomPlayer  = "r"         // @r 随机玩家
+	SelectorAllEntities   = "e"         // @e 所有实体
+	SelectorSelf          = "s"         // @s 命令执行者
+	SelectorInitiator     = "initiator" // @initiator 与 NPC 交互的玩家
+)
+
+// SelectorArg 是选择器中的一个参数
+type SelectorArg struct {
+	Key   string // 参数名（如 "r"、"tag"）
+	Value string // 规范化后的参数值（如 "5"、"!vip"、"{money=10..}"）
+}
+
+// Selector 表示一个目标选择器
+// 可以通过 AllPlayers 等函数构建，也可以通过 ParseSelector 从字符串解析；
+// 参数按添加顺序输出，字符串值在需要时加引号并转义
+//
+// 示例:
+//
+//	sel := sdk.AllPlayers().Pos(0, 64, 0).Radius(10).Score("money", 100, -1).NotTag("banned")
+//	// @a[x=0,y=64,z=0,r=10,scores={money=100..},tag=!banned]
+//	names, err := ctx.GameUtils().GetTarget(sel, 5)
+type Selector struct {
+	Kind string        // 选择器类型（如 SelectorAllPlayers）
+	Args []SelectorArg // 选择器参数
+}
+
+// NewSelector 创建指定类型的选择器（如 SelectorAllEntities）
+func NewSelector(kind string) *Selector {
+	return &Selector{Kind: kind}
+}
+
+// AllPlayers 返回 @a
+func AllPlayers() *Selector { return NewSelector(SelectorAllPlayers) }
+
+// NearestPlayer 返回 @p
+func NearestPlayer() *Selector { return NewSelector(SelectorNearestPlayer) }
+
+// RandomPlayer 返回 @r
+func RandomPlayer() *Selector { return NewSelector(SelectorRandomPlayer) }
+
+// AllEntities 返回 @e
+func AllEntities() *Selector { return NewSelector(SelectorAllEntities) }
+
+// Self 返回 @s
+func Self() *Selector { return NewSelector(SelectorSelf) }
+
+// Pos 设置搜索的基准坐标 x/y/z
+func (s *Selector) Pos(x, y, z float64) *Selector {
+	return s.set("x", formatNumber(x)).set("y", formatNumber(y)).set("z", formatNumber(z))
+}
+
+// Volume 设置搜索的长方体范围 dx/dy/dz
+func (s *Selector) Volume(dx, dy, dz float64) *Selector {
+	return s.set("dx", formatNumber(dx)).set("dy", formatNumber(dy)).set("dz", formatNumber(dz))
+}
+
+// Radius 设置最大搜索半径 r
+func (s *Selector) Radius(max float64) *Selector {
+	return s.set("r", formatNumber(max))
+}
+
+// RadiusRange 设置搜索半径范围 rm 与 r
+func (s *Selector) RadiusRange(min, max float64) *Selector {
+	return s.set("rm", formatNumber(min)).set("r", formatNumber(max))
+}
+
+// Count 设置最多选择的目标数 c，负数表示从最远处开始选择
+func (s *Selector) Count(c int) *Selector {
+	return s.set("c", strconv.Itoa(c))
+}
+
+// Level 设置经验等级范围 lm 与 l，max 为负数时不限制上限
+func (s *Selector) Level(min, max int) *Selector {
+	s.set("lm", strconv.Itoa(min))
+	if max >= 0 {
+		s.set("l", strconv.Itoa(max))
+	}
+	return s
+}
+
+// GameMode 设置游戏模式 m（如 "survival"、"creative"、"0"）
+func (s *Selector) GameMode(mode string) *Selector {
+	return s.set("m", QuoteName(mode))
+}
+
+// NotGameMode 排除游戏模式
+func (s *Selector) NotGameMode(mode string) *Selector {
+	return s.add("m", "!"+QuoteName(mode))
+}
+
+// Tag 要求目标拥有标签，可以多次调用
+func (s *Selector) Tag(tag string) *Selector {
+	return s.add("tag", QuoteName(tag))
+}
+
+// NotTag 要求目标没有标签，可以多次调用
+func (s *Selector) NotTag(tag string) *Selector {
+	return s.add("tag", "!"+QuoteName(tag))
+}
+
+// Name 要求目标名称
+func (s *Selector) Name(name string) *Selector {
+	return s.set("name", QuoteName(name))
+}
+
+// NotName 排除目标名称，可以多次调用
+func (s *Selector) NotName(name string) *Selector {
+	return s.add("name", "!"+QuoteName(name))
+}
+
+// Type 要求实体类型（如 "minecraft:zombie"）
+func (s *Selector) Type(entityType string) *Selector {
+	return s.set("type", QuoteName(entityType))
+}
+
+// NotType 排除实体类型，可以多次调用
+func (s *Selector) NotType(entityType string) *Selector {
+	return s.add("type", "!"+QuoteName(entityType))
+}
+
+// Family 要求实体族（如 "monster"），可以多次调用
+func (s *Selector) Family(family string) *Selector {
+	return s.add("family", QuoteName(family))
+}
+
+// NotFamily 排除实体族，可以多次调用
+func (s *Selector) NotFamily(family string) *Selector {
+	return s.add("family", "!"+QuoteName(family))
+}
+
+// Score 要求计分板分数在 [min, max] 范围内，min 或 max 为负数时不限制该端
+// 需要负数边界时使用 ScoreRange
+func (s *Selector) Score(objective string, min, max int) *Selector {
+	r := ""
+	switch {
+	case min >= 0 && max >= 0 && min == max:
+		r = strconv.Itoa(min)
+	case min >= 0 && max >= 0:
+		r = fmt.Sprintf("%d..%d", min, max)
+	case min >= 0:
+		r = fmt.Sprintf("%d..", min)
+	case max >= 0:
+		r = fmt.Sprintf("..%d", max)
+	default:
+		r = ".."
+	}
+	return s.ScoreRange(objective, r)
+}
+
+// ScoreRange 以范围字符串（如 "10"、"1..5"、"!0"、"..-1"）要求计分板分数
+func (s *Selector) ScoreRange(objective, r string) *Selector {
+	entry := QuoteName(objective) + "=" + r
+	for i, arg := range s.Args {
+		if arg.Key == "scores" {
+			s.Args[i].Value = strings.TrimSuffix(arg.Value, "}") + "," + entry + "}"
+			return s
+		}
+	}
+	return s.add("scores", "{"+entry+"}")
+}
+
+// HasItemFilter 是 hasitem 的一个条件
+type HasItemFilter struct {
+	Item     string // 物品 ID（必填）
+	Data     string // 数据值
+	Quantity string // 数量范围（如 "1.."、"!0"）
+	Location string // 槽位类型（如 "slot.weapon.mainhand"）
+	Slot     string // 槽位编号范围
+}
+
+func (f HasItemFilter) String() string {
+	parts := []string{"item=" + QuoteName(f.Item)}
+	for _, field := range []struct{ key, value string }{
+		{"data", f.Data}, {"quantity", f.Quantity}, {"location", f.Location}, {"slot", f.Slot},
+	} {
+		if field.value != "" {
+			parts = append(parts, field.key+"="+QuoteName(field.value))
+		}
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+// HasItem 要求目标持有物品，多个条件需要同时满足
+func (s *Selector) HasItem(filters ...HasItemFilter) *Selector {
+	if len(filters) == 1 {
+		return s.set("hasitem", filters[0].String())
+	}
+	parts := make([]string, len(filters))
+	for i, f := range filters {
+		parts[i] = f.String()
+	}
+	return s.set("hasitem", "["+strings.Join(parts, ",")+"]")
+}
+
+// Get 返回参数 key 的所有值
+func (s *Selector) Get(key string) []string {
+	var values []string
+	for _, arg := range s.Args {
+		if arg.Key == key {
+			values = append(values, arg.Value)
+		}
+	}
+	return values
+}
+
+// String 返回选择器字符串
+func (s *Selector) String() string {
+	if s == nil {
+		return ""
+	}
+	if len(s.Args) == 0 {
+		return "@" + s.Kind
+	}
+	parts := make([]string, len(s.Args))
+	for i, arg := range s.Args {
+		parts[i] = arg.Key + "=" + arg.Value
+	}
+	return "@" + s.Kind + "[" + strings.Join(parts, ",") + "]"
+}
+
+// set 设置只能出现一次的参数
+func (s *Selector) set(key, value string) *Selector {
+	for i, arg := range s.Args {
+		if arg.Key == key {
+			s.Args[i].Value = value
+			return s
+		}
+	}
+	return s.add(key, value)
+}
+
+// add 追加可以重复的参数
+func (s *Selector) add(key, value string) *Selector {
+	s.Args = append(s.Args, SelectorArg{Key: key, Value: value})
+	return s
+}
+
+func formatNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// 选择器参数的取值类型
+const (
+	selectorValueCoord    = iota // 坐标，允许 ~ 与 ^
+	selectorValueNumber          // 数值
+	selectorValueInt             // 整数
+	selectorValueString          // 字符串，允许 ! 取反
+	selectorValueCompound        // {...} 或 [...]
+)
+
+// selectorKeys 是支持的选择器参数
+var selectorKeys = map[string]int{
+	"x": selectorValueCoord, "y": selectorValueCoord, "z": selectorValueCoord,
+	"dx": selectorValueNumber, "dy": selectorValueNumber, "dz": selectorValueNumber,
+	"r": selectorValueNumber, "rm": selectorValueNumber,
+	"rx": selectorValueNumber, "rxm": selectorValueNumber, "ry": selectorValueNumber, "rym": selectorValueNumber,
+	"c": selectorValueInt, "l": selectorValueInt, "lm": selectorValueInt,
+	"m": selectorValueString, "tag": selectorValueString, "name": selectorValueString,
+	"type": selectorValueString, "family": selectorValueString,
+	"scores": selectorValueCompound, "hasitem": selectorValueCompound,
+	"haspermission": selectorValueCompound, "has_property": selectorValueCompound,
+}
+
+var (
+	selectorKindPattern  = regexp.MustCompile(`^@([a-z]+)`)
+	selectorCoordPattern = regexp.MustCompile(`^[~^]?(-?\d+(\.\d+)?)?$`)
+)
+
+// ParseSelector 解析并规范化选择器字符串，可用于检查控制台命令等用户输入
+// 会去除多余空白，拒绝未知的类型与参数、非法的数值以及未闭合的括号和引号
+//
+// 示例:
+//
+//	sel, err := sdk.ParseSelector(`@a[ r = 10 , tag = "vip" ]`)
+//	// sel.String() == "@a[r=10,tag=vip]"
+func ParseSelector(text string) (*Selector, error) {
+	text = strings.TrimSpace(text)
+	m := selectorKindPattern.FindStringSubmatch(text)
+	if m == nil {
+		return nil, fmt.Errorf("无效的目标选择器: %q", text)
+	}
+	switch m[1] {
+	case SelectorAllPlayers, SelectorNearestPlayer, SelectorRandomPlayer, SelectorAllEntities, SelectorSelf, SelectorInitiator:
+	default:
+		return nil, fmt.Errorf("未知的选择器类型: @%s", m[1])
+	}
+	sel := NewSelector(m[1])
+
+	rest := strings.TrimSpace(text[len(m[0]):])
+	if rest == "" {
+		return sel, nil
+	}
+	if rest[0] != '[' || rest[len(rest)-1] != ']' {
+		return nil, fmt.Errorf("无效的目标选择器: %q", text)
+	}
+	body := rest[1 : len(rest)-1]
+	if strings.TrimSpace(body) == "" {
+		return sel, nil
+	}
+
+	entries, err := splitSelectorArgs(body)
+	if err != nil {
+		return nil, fmt.Errorf("无效的目标选择器 %q: %w", text, err)
+	}
+	for _, entry := range entries {
+		key, value, ok := strings.Cut(entry, "=")
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("无效的选择器参数: %q", entry)
+		}
+		kind, known := selectorKeys[key]
+		if !known {
+			return nil, fmt.Errorf("未知的选择器参数: %s", key)
+		}
+		normalized, err := normalizeSelectorValue(kind, value)
+		if err != nil {
+			return nil, fmt.Errorf("选择器参数 %s: %w", key, err)
+		}
+		sel.add(key, normalized)
+	}
+	return sel, nil
+}
+
+// splitSelectorArgs 在最外层的逗号处拆分参数，忽略引号与括号内的逗号
+func splitSelectorArgs(body string) ([]string, error) {
+	var entries []string
+	depth := 0
+	inQuote := false
+	escaped := false
+	start := 0
+	for i, r := range body {
+		switch {
+		case r == '\n' || r == '\r':
+			return nil, fmt.Errorf("不能包含换行")
+		case escaped:
+			escaped = false
+		case inQuote && r == '\\':
+			escaped = true
+		case r == '"':
+			inQuote = !inQuote
+		case inQuote:
+		case r == '[' || r == '{':
+			depth++
+		case r == ']' || r == '}':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("括号不匹配")
+			}
+		case r == ',' && depth == 0:
+			entries = append(entries, body[start:i])
+			start = i + 1
+		}
+	}
+	if inQuote || depth != 0 {
+		return nil, fmt.Errorf("括号或引号未闭合")
+	}
+	return append(entries, body[start:]), nil
+}
+
+// normalizeSelectorValue 检查并规范化参数值
+func normalizeSelectorValue(kind int, value string) (string, error) {
+	switch kind {
+	case selectorValueCoord:
+		if value == "" || !selectorCoordPattern.MatchString(value) {
+			return "", fmt.Errorf("无效的坐标: %q", value)
+		}
+		return value, nil
+	case selectorValueNumber:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return "", fmt.Errorf("无效的数值: %q", value)
+		}
+		return formatNumber(f), nil
+	case selectorValueInt:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return "", fmt.Errorf("无效的整数: %q", value)
+		}
+		return strconv.Itoa(n), nil
+	case selectorValueString:
+		negate := strings.HasPrefix(value, "!")
+		value = strings.TrimSpace(strings.TrimPrefix(value, "!"))
+		value, err := unquoteSelectorString(value)
+		if err != nil {
+			return "", err
+		}
+		if negate {
+			return "!" + QuoteName(value), nil
+		}
+		if value == "" {
+			return "", nil
+		}
+		return QuoteName(value), nil
+	default:
+		if len(value) < 2 || !(value[0] == '{' && value[len(value)-1] == '}' || value[0] == '[' && value[len(value)-1] == ']') {
+			return "", fmt.Errorf("应为 {...} 或 [...]: %q", value)
+		}
+		inner, err := splitSelectorArgs(value[1 : len(value)-1])
+		if err != nil {
+			return "", err
+		}
+		for i, entry := range inner {
+			entry = strings.TrimSpace(entry)
+			if strings.HasPrefix(entry, "{") {
+				if entry, err = normalizeSelectorValue(selectorValueCompound, entry); err != nil {
+					return "", err
+				}
+			} else if key, value, ok := strings.Cut(entry, "="); ok {
+				entry = strings.TrimSpace(key) + "=" + strings.TrimSpace(value)
+			}
+			inner[i] = entry
+		}
+		return value[:1] + strings.Join(inner, ",") + value[len(value)-1:], nil
+	}
+}
+
+// unquoteSelectorString 去除字符串值两侧的引号并还原转义
+func unquoteSelectorString(value string) (string, error) {
+	if !strings.HasPrefix(value, `"`) {
+		if strings.ContainsAny(value, `"[]{},=`) {
+			return "", fmt.Errorf("无效的字符串: %q", value)
+		}
+		return value, nil
+	}
+	if !isQuotedString(value) {
+		return "", fmt.Errorf("引号未闭合: %q", value)
+	}
+	var b strings.Builder
+	escaped := false
+	for _, r := range value[1 : len(value)-1] {
+		if !escaped && r == '\\' {
+			escaped = true
+			continue
+		}
+		escaped = false
+		b.WriteRune(r)
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,95 @@
+package sdk
+
+import "testing"
+
+func TestSelectorBuilder(t *testing.T) {
+	cases := []struct {
+		sel  *Selector
+		want string
+	}{
+		{AllPlayers(), "@a"},
+		{AllPlayers().Pos(0, 64.5, -3).Radius(10).Score("money", 100, -1).NotTag("banned"),
+			"@a[x=0,y=64.5,z=-3,r=10,scores={money=100..},tag=!banned]"},
+		{AllEntities().Type("minecraft:zombie").Family("monster").NotFamily("undead").Count(3),
+			`@e[type="minecraft:zombie",family=monster,family=!undead,c=3]`},
+		{NearestPlayer().Name("Steve Jobs").GameMode("creative").Level(5, -1),
+			`@p[name="Steve Jobs",m=creative,lm=5]`},
+		{RandomPlayer().Score("kills", 1, 5).Score("deaths", -1, 0).Volume(10, 5, 10),
+			"@r[scores={kills=1..5,deaths=..0},dx=10,dy=5,dz=10]"},
+		{Self().HasItem(HasItemFilter{Item: "diamond", Quantity: "1.."}, HasItemFilter{Item: "apple", Location: "slot.weapon.mainhand"}),
+			`@s[hasitem=[{item=diamond,quantity="1.."},{item=apple,location="slot.weapon.mainhand"}]]`},
+		{AllPlayers().Name(`x"],@e[`), `@a[name="x\"],@e["]`},
+	}
+	for _, c := range cases {
+		if got := c.sel.String(); got != c.want {
+			t.Errorf("String() = %q, want %q", got, c.want)
+		}
+		if formatted, err := FormatTarget(c.sel); err != nil || formatted != c.want {
+			t.Errorf("FormatTarget(%q) = %q, %v", c.want, formatted, err)
+		}
+	}
+}
+
+func TestParseSelector(t *testing.T) {
+	cases := map[string]string{
+		"@a": "@a",
+		" @e[ type = zombie , r = 10.0 , c=-1 ] ": "@e[type=zombie,r=10,c=-1]",
+		`@a[tag="vip",tag=!"new player"]`:         `@a[tag=vip,tag=!"new player"]`,
+		"@p[x=~,y=~1.5,z=^-2,rm=1]":               "@p[x=~,y=~1.5,z=^-2,rm=1]",
+		"@a[scores={ money = 10.. , kills=1 }]":   "@a[scores={money=10..,kills=1}]",
+		"@s[hasitem=[{ item = apple },{item=x}]]": "@s[hasitem=[{item=apple},{item=x}]]",
+		"@s[hasitem={item=diamond,quantity=1..}]": "@s[hasitem={item=diamond,quantity=1..}]",
+		"@initiator[]": "@initiator",
+	}
+	for input, want := range cases {
+		sel, err := ParseSelector(input)
+		if err != nil {
+			t.Errorf("ParseSelector(%q): %v", input, err)
+			continue
+		}
+		if got := sel.String(); got != want {
+			t.Errorf("ParseSelector(%q) = %q, want %q", input, got, want)
+		}
+	}
+
+	invalid := []string{
+		"Steve",
+		"@x",
+		"@a[r=ten]",
+		"@a[foo=1]",
+		"@a[name=\"x]",
+		"@a[tag=x] @e",
+		"@a[tag=x]] say hi",
+		"@a[scores=10]",
+		"@a[c=1.5]",
+		"@a[tag=a\nb]",
+	}
+	for _, input := range invalid {
+		if sel, err := ParseSelector(input); err == nil {
+			t.Errorf("ParseSelector(%q) = %q; want error", input, sel)
+		}
+	}
+}
+
+func TestGameUtilsAcceptsSelector(t *testing.T) {
+	commands := &legacyTestCommands{}
+	utils := NewGameUtils(LegacyGameInterface(&legacyTestGame{commands: commands}))
+
+	if err := utils.SetEffect(AllPlayers().Tag("vip"), 1, EffectOptions{}); err != nil {
+		t.Fatalf("SetEffect: %v", err)
+	}
+	if err := utils.SetEffect("@a[tag = vip]", 1, EffectOptions{}); err != nil {
+		t.Fatalf("SetEffect: %v", err)
+	}
+	if err := utils.SetEffect(42, 1, EffectOptions{}); err == nil {
+		t.Fatalf("SetEffect with int target: want error")
+	}
+	for _, cmd := range commands.sent {
+		if cmd != "effect @a[tag=vip] 1 30 0" {
+			t.Fatalf("command = %q", cmd)
+		}
+	}
+	if len(commands.sent) != 2 {
+		t.Fatalf("commands = %q", commands.sent)
+	}
+}
@@ -0,0 +1,205 @@
+package sdk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// TickingArea 表示一个常加载区域
+type TickingArea struct {
+	Name      string   // 区域名称
+	Dimension string   // 所在维度（如 "overworld"），输出中没有维度时为空
+	Circle    bool     // 是否为圆形区域
+	Min       BlockPos // 矩形区域的最小角坐标（圆形区域为中心）
+	Max       BlockPos // 矩形区域的最大角坐标（圆形区域为中心）
+	Radius    int      // 圆形区域的半径（区块）
+	Preload   bool     // 是否预加载
+}
+
+// Center 返回区域的中心坐标
+func (a TickingArea) Center() BlockPos {
+	return BlockPos{(a.Min.X + a.Max.X) / 2, (a.Min.Y + a.Max.Y) / 2, (a.Min.Z + a.Max.Z) / 2}
+}
+
+// GetTickingAreas 获取所有维度的常加载区域
+//
+// 示例:
+//   areas, err := ctx.GameUtils().GetTickingAreas()
+//   if err == nil {
+//       for _, area := range areas {
+//           ctx.Logf("%s: %v - %v", area.Name, area.Min, area.Max)
+//       }
+//   }
+func (g *GameUtils) GetTickingAreas() ([]TickingArea, error) {
+	result, timedOut, err := g.SendCommandWithResponse("tickingarea list all-dimensions", 5.0)
+	if timedOut {
+		return nil, fmt.Errorf("获取常加载区域超时")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取常加载区域失败: %v", err)
+	}
+	return parseTickingAreas(result), nil
+}
+
+// AddTickingArea 添加矩形常加载区域，a、b 为对角坐标
+// preload: 为 true 时区域在加载完成前阻止世界继续运行
+//
+// 示例:
+//   err := ctx.GameUtils().AddTickingArea("farm", sdk.BlockPos{0, 0, 0}, sdk.BlockPos{31, 0, 31}, false)
+func (g *GameUtils) AddTickingArea(name string, a, b BlockPos, preload bool) error {
+	cmd := NewCommand("tickingarea add").BlockPos(a).BlockPos(b).Quoted(name)
+	if preload {
+		cmd.Bool(true)
+	}
+	return g.tickingAreaCommand(cmd, "添加常加载区域失败")
+}
+
+// AddTickingCircle 添加圆形常加载区域
+// radius: 半径（区块），范围 1-4
+//
+// 示例:
+//   err := ctx.GameUtils().AddTickingCircle("redstone", sdk.BlockPos{100, 64, 100}, 2, false)
+func (g *GameUtils) AddTickingCircle(name string, center BlockPos, radius int, preload bool) error {
+	if radius < 1 || radius > 4 {
+		return fmt.Errorf("常加载区域半径必须在 1-4 之间")
+	}
+	cmd := NewCommand("tickingarea add circle").BlockPos(center).Int(radius).Quoted(name)
+	if preload {
+		cmd.Bool(true)
+	}
+	return g.tickingAreaCommand(cmd, "添加常加载区域失败")
+}
+
+// RemoveTickingArea 按名称移除常加载区域
+//
+// 示例:
+//   // 插件停止时清理自己添加的区域
+//   func (p *MyPlugin) Stop() error {
+//       return p.ctx.GameUtils().RemoveTickingArea("farm")
+//   }
+func (g *GameUtils) RemoveTickingArea(name string) error {
+	return g.tickingAreaCommand(NewCommand("tickingarea remove").Quoted(name), "移除常加载区域失败")
+}
+
+// RemoveTickingAreaAt 移除包含指定坐标的常加载区域
+func (g *GameUtils) RemoveTickingAreaAt(pos BlockPos) error {
+	return g.tickingAreaCommand(NewCommand("tickingarea remove").BlockPos(pos), "移除常加载区域失败")
+}
+
+// RemoveAllTickingAreas 移除当前维度的所有常加载区域
+func (g *GameUtils) RemoveAllTickingAreas() error {
+	return g.tickingAreaCommand(NewCommand("tickingarea remove_all"), "移除常加载区域失败")
+}
+
+// tickingAreaCommand 执行 tickingarea 命令，失败时返回带有游戏输出的错误
+func (g *GameUtils) tickingAreaCommand(cmd *Command, failure string) error {
+	built, err := cmd.Build()
+	if err != nil {
+		return fmt.Errorf("%s: %v", failure, err)
+	}
+	result, timedOut, err := g.SendCommandWithResponse(built, 5.0)
+	if timedOut {
+		return fmt.Errorf("%s: 命令执行超时", failure)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %v", failure, err)
+	}
+	if !result.Succeeded() {
+		return fmt.Errorf("%s: %s", failure, result.Render(nil))
+	}
+	return nil
+}
+
+// tickingAreaPlaceholders 是列表输出中未翻译的占位符
+var tickingAreaPlaceholders = strings.NewReplacer(
+	"%commands.tickingarea-list.to", " to ",
+	"%commands.tickingarea-list.circle.radius", " Radius ",
+	"%commands.tickingarea-list.type.circle", " Circle ",
+	"%commands.tickingarea-list.preload", " preload ",
+	"(", " ", ")", " ", ",", " ",
+)
+
+// parseTickingAreas 解析 tickingarea list 的输出
+// 兼容两种格式: 每个区域一条以 "- name: x y z to x y z" 为文本的消息，
+// 或以 commands.tickingarea-list.* 为翻译键、区域信息为参数的消息
+func parseTickingAreas(result *CommandResult) []TickingArea {
+	if result == nil {
+		return nil
+	}
+	translator := NewTranslator()
+	var areas []TickingArea
+	dimension := ""
+	for _, msg := range result.OutputMessages {
+		id := msg.MessageID
+		if strings.HasPrefix(id, "commands.tickingarea-list.success") || strings.HasPrefix(id, "commands.tickingarea-list.failure") {
+			continue
+		}
+
+		// 区域名称可能包含空格，先取出名称再拆分其余部分
+		var name, rest string
+		circle := strings.Contains(id, "circle")
+		if strings.HasPrefix(id, "commands.tickingarea-list.") {
+			if len(msg.Parameters) == 0 {
+				continue
+			}
+			name, rest = msg.Parameters[0], strings.Join(msg.Parameters[1:], " ")
+		} else {
+			line := translator.StripColorCodes(strings.Join(append([]string{id}, msg.Parameters...), " "))
+			index := strings.Index(line, ":")
+			if index < 0 {
+				continue
+			}
+			name, rest = line[:index], line[index+1:]
+		}
+		name = strings.Trim(strings.TrimSpace(translator.StripColorCodes(name)), "-:\" ")
+
+		// 单独一行的维度标题（如 "overworld:"）
+		if isDimensionName(name) && strings.TrimSpace(rest) == "" {
+			dimension = name
+			continue
+		}
+		if name == "" {
+			continue
+		}
+
+		area := TickingArea{Name: name, Dimension: dimension, Circle: circle}
+		var numbers []int
+		for _, token := range strings.Fields(tickingAreaPlaceholders.Replace(translator.StripColorCodes(rest))) {
+			if n, err := strconv.Atoi(token); err == nil {
+				numbers = append(numbers, n)
+				continue
+			}
+			switch lower := strings.ToLower(strings.Trim(token, ":")); {
+			case lower == "circle" || lower == "radius":
+				area.Circle = true
+			case lower == "preload" || lower == "true":
+				area.Preload = true
+			case isDimensionName(lower):
+				area.Dimension = lower
+			}
+		}
+
+		switch {
+		case area.Circle && len(numbers) >= 4:
+			area.Min = BlockPos{numbers[0], numbers[1], numbers[2]}
+			area.Max = area.Min
+			area.Radius = numbers[3]
+		case !area.Circle && len(numbers) >= 6:
+			area.Min = BlockPos{minInt(numbers[0], numbers[3]), minInt(numbers[1], numbers[4]), minInt(numbers[2], numbers[5])}
+			area.Max = BlockPos{maxInt(numbers[0], numbers[3]), maxInt(numbers[1], numbers[4]), maxInt(numbers[2], numbers[5])}
+		default:
+			continue
+		}
+		areas = append(areas, area)
+	}
+	return areas
+}
+
+func isDimensionName(name string) bool {
+	switch name {
+	case "overworld", "nether", "the_end":
+		return true
+	}
+	return false
+}
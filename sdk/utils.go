@@ -139,10 +139,13 @@ func (u *Utils) FillIntList(list []int, referenceLen int, defaultValue int) []in
 //   // 返回: "@a[name=\"玩家1\"]"
 func (u *Utils) ToPlayerSelector(playerName string) string {
 	if strings.HasPrefix(playerName, "@") {
-		// 已经是选择器，直接返回
-		return playerName
+		// 已经是选择器，检查后返回规范化的形式
+		if sel, err := ParseSelector(playerName); err == nil {
+			return sel.String()
+		}
 	}
-	return `@a[name="` + playerName + `"]`
+	// 名称中的引号与反斜杠会被转义，无法闭合选择器
+	return "@a[name=" + QuoteString(playerName) + "]"
 }
 
 // ResultCallback 表示一对回调锁（getter 和 setter）
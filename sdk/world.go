@@ -0,0 +1,359 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// 方块查询相关的数据包 ID
+const (
+	PacketIDStructureTemplateDataRequest  uint32 = 132 // StructureTemplateDataRequest，请求导出结构
+	PacketIDStructureTemplateDataResponse uint32 = 133 // StructureTemplateDataResponse，结构数据
+)
+
+// structureChunkSize 是单次结构导出的最大边长，更大的区域会被拆分为多次请求
+const structureChunkSize = 64
+
+// DefaultBlockQueryTimeout 是单次结构导出请求的默认超时
+const DefaultBlockQueryTimeout = 5 * time.Second
+
+// MaxBlockQueryVolume 是 GetBlocks 单次查询的最大方块数（16 个 64×64×64 的子区域）
+// 更大的区域需要调用方自行拆分，避免一次分配过多内存并长时间占用结构导出
+const MaxBlockQueryVolume = 16 * structureChunkSize * structureChunkSize * structureChunkSize
+
+// BlockPos 表示方块坐标
+type BlockPos struct {
+	X, Y, Z int
+}
+
+// Block 表示一种方块
+type Block struct {
+	Name   string         // 方块 ID（如 "minecraft:stone"）
+	States map[string]any // 方块状态（如 {"stone_type": "granite"}）
+}
+
+// BlockRegion 是一个长方体区域内所有方块的快照
+// 方块按 x、y、z 的顺序存储（z 变化最快），与结构文件一致
+type BlockRegion struct {
+	Min     BlockPos // 最小角坐标
+	Size    BlockPos // 区域大小
+	Palette []Block  // 区域内出现的方块
+
+	indices []int // 每个位置在 Palette 中的下标，-1 表示未知
+}
+
+// Max 返回区域的最大角坐标
+func (r *BlockRegion) Max() BlockPos {
+	return BlockPos{r.Min.X + r.Size.X - 1, r.Min.Y + r.Size.Y - 1, r.Min.Z + r.Size.Z - 1}
+}
+
+// Contains 判断坐标是否在区域内
+func (r *BlockRegion) Contains(pos BlockPos) bool {
+	return pos.X >= r.Min.X && pos.X < r.Min.X+r.Size.X &&
+		pos.Y >= r.Min.Y && pos.Y < r.Min.Y+r.Size.Y &&
+		pos.Z >= r.Min.Z && pos.Z < r.Min.Z+r.Size.Z
+}
+
+// At 返回指定世界坐标处的方块，坐标不在区域内或方块未知时返回 false
+func (r *BlockRegion) At(pos BlockPos) (Block, bool) {
+	if !r.Contains(pos) {
+		return Block{}, false
+	}
+	index := r.indices[r.offset(pos)]
+	if index < 0 {
+		return Block{}, false
+	}
+	return r.Palette[index], true
+}
+
+// ForEach 按存储顺序遍历区域内所有已知方块，fn 返回 false 时停止
+func (r *BlockRegion) ForEach(fn func(pos BlockPos, block Block) bool) {
+	i := 0
+	for x := 0; x < r.Size.X; x++ {
+		for y := 0; y < r.Size.Y; y++ {
+			for z := 0; z < r.Size.Z; z++ {
+				if index := r.indices[i]; index >= 0 {
+					pos := BlockPos{r.Min.X + x, r.Min.Y + y, r.Min.Z + z}
+					if !fn(pos, r.Palette[index]) {
+						return
+					}
+				}
+				i++
+			}
+		}
+	}
+}
+
+func (r *BlockRegion) offset(pos BlockPos) int {
+	x, y, z := pos.X-r.Min.X, pos.Y-r.Min.Y, pos.Z-r.Min.Z
+	return (x*r.Size.Y+y)*r.Size.Z + z
+}
+
+// World 通过结构导出（StructureTemplateDataRequest）查询世界中的方块
+// 机器人需要拥有管理员权限，查询的区域需要已加载
+//
+// 示例:
+//
+//	world := ctx.World()
+//	block, err := world.GetBlock(0, 64, 0)
+//	region, err := world.GetBlocks(sdk.BlockPos{0, 60, 0}, sdk.BlockPos{15, 70, 15})
+type World struct {
+	send    func(packetID uint32, packet interface{}) error
+	timeout time.Duration
+
+	seq     atomic.Uint64
+	mu      sync.Mutex
+	pending map[string]chan structureResponse
+}
+
+// NewWorld 创建方块查询器，send 用于发送数据包（通常为 GameUtils.SendPacket）
+// 需要把 StructureTemplateDataResponse 数据包交给 HandlePacket，或调用 Attach 自动监听
+func NewWorld(send func(packetID uint32, packet interface{}) error) *World {
+	return &World{
+		send:    send,
+		timeout: DefaultBlockQueryTimeout,
+		pending: make(map[string]chan structureResponse),
+	}
+}
+
+// SetTimeout 设置单次结构导出请求的超时
+func (w *World) SetTimeout(timeout time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.timeout = timeout
+}
+
+// Attach 通过 ctx 监听结构数据响应
+func (w *World) Attach(ctx *Context) (*ListenerHandle, error) {
+	return ctx.ListenPacket(w.HandlePacket, PacketIDStructureTemplateDataResponse)
+}
+
+// HandlePacket 处理 StructureTemplateDataResponse 数据包，可以直接作为 PacketHandler 使用
+func (w *World) HandlePacket(event PacketEvent) {
+	if event.ID != PacketIDStructureTemplateDataResponse || event.Raw == nil {
+		return
+	}
+	var resp structureResponse
+	if decodePacket(event.Raw, &resp) != nil {
+		return
+	}
+	w.mu.Lock()
+	ch, ok := w.pending[resp.StructureName]
+	delete(w.pending, resp.StructureName)
+	w.mu.Unlock()
+	if ok {
+		ch <- resp
+	}
+}
+
+// GetBlock 返回指定坐标的方块
+func (w *World) GetBlock(x, y, z int) (Block, error) {
+	pos := BlockPos{x, y, z}
+	region, err := w.GetBlocks(pos, pos)
+	if err != nil {
+		return Block{}, err
+	}
+	block, ok := region.At(pos)
+	if !ok {
+		return Block{}, fmt.Errorf("无法获取坐标 (%d, %d, %d) 的方块", x, y, z)
+	}
+	return block, nil
+}
+
+// GetBlocks 返回以 a、b 为对角的长方体区域的快照
+// 边长超过 64 的区域会被拆分为多次请求，方块数超过 MaxBlockQueryVolume 时返回错误
+func (w *World) GetBlocks(a, b BlockPos) (*BlockRegion, error) {
+	min := BlockPos{minInt(a.X, b.X), minInt(a.Y, b.Y), minInt(a.Z, b.Z)}
+	max := BlockPos{maxInt(a.X, b.X), maxInt(a.Y, b.Y), maxInt(a.Z, b.Z)}
+	region := &BlockRegion{
+		Min:  min,
+		Size: BlockPos{max.X - min.X + 1, max.Y - min.Y + 1, max.Z - min.Z + 1},
+	}
+	if !withinVolume(region.Size, MaxBlockQueryVolume) {
+		return nil, fmt.Errorf("查询区域 %d×%d×%d 超过 %d 个方块的上限", region.Size.X, region.Size.Y, region.Size.Z, MaxBlockQueryVolume)
+	}
+	region.indices = make([]int, region.Size.X*region.Size.Y*region.Size.Z)
+	for i := range region.indices {
+		region.indices[i] = -1
+	}
+
+	palette := make(map[string]int)
+	for x := min.X; x <= max.X; x += structureChunkSize {
+		for y := min.Y; y <= max.Y; y += structureChunkSize {
+			for z := min.Z; z <= max.Z; z += structureChunkSize {
+				origin := BlockPos{x, y, z}
+				size := BlockPos{
+					minInt(structureChunkSize, max.X-x+1),
+					minInt(structureChunkSize, max.Y-y+1),
+					minInt(structureChunkSize, max.Z-z+1),
+				}
+				part, err := w.export(origin, size)
+				if err != nil {
+					return nil, err
+				}
+				region.merge(part, palette)
+			}
+		}
+	}
+	return region, nil
+}
+
+// merge 把子区域合并到 r，palette 记录方块到 r.Palette 下标的映射
+func (r *BlockRegion) merge(part *BlockRegion, palette map[string]int) {
+	mapping := make([]int, len(part.Palette))
+	for i, block := range part.Palette {
+		key := blockKey(block)
+		index, ok := palette[key]
+		if !ok {
+			index = len(r.Palette)
+			r.Palette = append(r.Palette, block)
+			palette[key] = index
+		}
+		mapping[i] = index
+	}
+	i := 0
+	for x := 0; x < part.Size.X; x++ {
+		for y := 0; y < part.Size.Y; y++ {
+			for z := 0; z < part.Size.Z; z++ {
+				pos := BlockPos{part.Min.X + x, part.Min.Y + y, part.Min.Z + z}
+				if index := part.indices[i]; index >= 0 && index < len(mapping) && r.Contains(pos) {
+					r.indices[r.offset(pos)] = mapping[index]
+				}
+				i++
+			}
+		}
+	}
+}
+
+// export 导出单个不超过 structureChunkSize 的区域
+func (w *World) export(origin, size BlockPos) (*BlockRegion, error) {
+	if w.send == nil {
+		return nil, fmt.Errorf("无法发送数据包")
+	}
+	name := fmt.Sprintf("fin:query_%d", w.seq.Add(1))
+	ch := make(chan structureResponse, 1)
+
+	w.mu.Lock()
+	w.pending[name] = ch
+	timeout := w.timeout
+	w.mu.Unlock()
+	defer func() {
+		w.mu.Lock()
+		delete(w.pending, name)
+		w.mu.Unlock()
+	}()
+
+	request := map[string]interface{}{
+		"StructureName": name,
+		"Position":      []int{origin.X, origin.Y, origin.Z},
+		"Settings": map[string]interface{}{
+			"PaletteName":    "default",
+			"IgnoreEntities": true,
+			"IgnoreBlocks":   false,
+			"Size":           []int{size.X, size.Y, size.Z},
+			"Offset":         []int{0, 0, 0},
+			"Integrity":      100,
+			"Pivot":          []float32{0, 0, 0},
+		},
+		"RequestType": 1, // 从保存模式导出
+	}
+	if err := w.send(PacketIDStructureTemplateDataRequest, request); err != nil {
+		return nil, fmt.Errorf("发送结构导出请求失败: %w", err)
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case resp := <-ch:
+		if !resp.Success {
+			return nil, fmt.Errorf("导出结构失败（区域未加载或机器人没有权限）")
+		}
+		return parseStructure(resp.StructureTemplate, origin, size)
+	case <-timer.C:
+		return nil, fmt.Errorf("查询方块超时")
+	}
+}
+
+// structureResponse 与 StructureTemplateDataResponse 数据包的字段名一致
+type structureResponse struct {
+	StructureName     string
+	Success           bool
+	StructureTemplate map[string]any
+}
+
+// structureTemplate 是结构 NBT 中用到的部分
+type structureTemplate struct {
+	Size      []int `json:"size"`
+	Structure struct {
+		BlockIndices [][]int `json:"block_indices"`
+		Palette      map[string]struct {
+			BlockPalette []struct {
+				Name   string         `json:"name"`
+				States map[string]any `json:"states"`
+			} `json:"block_palette"`
+		} `json:"palette"`
+	} `json:"structure"`
+}
+
+// parseStructure 把结构 NBT 解析为 origin 处大小为 size 的区域
+// 结构的大小与请求不一致时返回错误，避免把数据写到请求区域之外
+func parseStructure(nbt map[string]any, origin, size BlockPos) (*BlockRegion, error) {
+	var tpl structureTemplate
+	if err := decodePacket(nbt, &tpl); err != nil {
+		return nil, fmt.Errorf("解析结构数据失败: %w", err)
+	}
+	if len(tpl.Size) == 3 {
+		if got := (BlockPos{tpl.Size[0], tpl.Size[1], tpl.Size[2]}); got != size {
+			return nil, fmt.Errorf("结构大小 %v 与请求的 %v 不一致", got, size)
+		}
+	}
+	region := &BlockRegion{Min: origin, Size: size}
+	for _, block := range tpl.Structure.Palette["default"].BlockPalette {
+		region.Palette = append(region.Palette, Block{Name: block.Name, States: block.States})
+	}
+
+	total := size.X * size.Y * size.Z
+	region.indices = make([]int, total)
+	for i := range region.indices {
+		region.indices[i] = -1
+	}
+	if len(tpl.Structure.BlockIndices) > 0 {
+		layer := tpl.Structure.BlockIndices[0]
+		if len(layer) != total {
+			return nil, fmt.Errorf("结构数据大小不匹配: %d != %d", len(layer), total)
+		}
+		copy(region.indices, layer)
+	}
+	return region, nil
+}
+
+// withinVolume 判断区域的方块数是否不超过 limit，计算过程不会溢出
+func withinVolume(size BlockPos, limit int) bool {
+	if size.X <= 0 || size.Y <= 0 || size.Z <= 0 {
+		return false
+	}
+	return size.X <= limit && size.Y <= limit/size.X && size.Z <= limit/(size.X*size.Y)
+}
+
+// blockKey 返回用于合并调色板的方块键
+func blockKey(block Block) string {
+	states, _ := json.Marshal(block.States)
+	return block.Name + string(states)
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,145 @@
+package sdk
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// fakeStructureHost 按请求的区域生成结构数据：y < 64 为石头，其余为空气
+type fakeStructureHost struct {
+	world    *World
+	requests int
+	grow     int // 响应的结构比请求的区域在每个方向上大 grow 格
+}
+
+func (h *fakeStructureHost) send(packetID uint32, packet interface{}) error {
+	if packetID != PacketIDStructureTemplateDataRequest {
+		return nil
+	}
+	h.requests++
+	var req struct {
+		StructureName string
+		Position      [3]int
+		Settings      struct{ Size [3]int }
+	}
+	if err := decodePacket(packet, &req); err != nil {
+		return err
+	}
+	size := req.Settings.Size
+	for i := range size {
+		size[i] += h.grow
+	}
+	indices := make([]any, 0, size[0]*size[1]*size[2])
+	for x := 0; x < size[0]; x++ {
+		for y := 0; y < size[1]; y++ {
+			for z := 0; z < size[2]; z++ {
+				if req.Position[1]+y < 64 {
+					indices = append(indices, float64(1))
+				} else {
+					indices = append(indices, float64(0))
+				}
+			}
+		}
+	}
+	// 每个子区域的调色板顺序不同，用于检查合并
+	palette := []any{
+		map[string]any{"name": "minecraft:air", "states": map[string]any{}},
+		map[string]any{"name": "minecraft:stone", "states": map[string]any{"stone_type": "granite"}},
+	}
+	go h.world.HandlePacket(PacketEvent{ID: PacketIDStructureTemplateDataResponse, Raw: map[string]any{
+		"StructureName": req.StructureName,
+		"Success":       true,
+		"StructureTemplate": map[string]any{
+			"size": []any{float64(size[0]), float64(size[1]), float64(size[2])},
+			"structure": map[string]any{
+				"block_indices": []any{indices, []any{}},
+				"palette":       map[string]any{"default": map[string]any{"block_palette": palette}},
+			},
+		},
+	}})
+	return nil
+}
+
+func TestWorldGetBlocks(t *testing.T) {
+	host := &fakeStructureHost{}
+	host.world = NewWorld(host.send)
+
+	region, err := host.world.GetBlocks(BlockPos{70, 65, 2}, BlockPos{0, 62, 0})
+	if err != nil {
+		t.Fatalf("GetBlocks: %v", err)
+	}
+	if host.requests != 2 {
+		t.Fatalf("requests = %d, want 2", host.requests)
+	}
+	if region.Min != (BlockPos{0, 62, 0}) || region.Max() != (BlockPos{70, 65, 2}) {
+		t.Fatalf("region = %+v .. %+v", region.Min, region.Max())
+	}
+	if len(region.Palette) != 2 {
+		t.Fatalf("palette = %+v", region.Palette)
+	}
+
+	stone, ok := region.At(BlockPos{68, 63, 1})
+	if !ok || stone.Name != "minecraft:stone" || stone.States["stone_type"] != "granite" {
+		t.Fatalf("At(68, 63, 1) = %+v, %v", stone, ok)
+	}
+	if air, ok := region.At(BlockPos{3, 64, 2}); !ok || air.Name != "minecraft:air" {
+		t.Fatalf("At(3, 64, 2) = %+v, %v", air, ok)
+	}
+	if _, ok := region.At(BlockPos{71, 64, 0}); ok {
+		t.Fatalf("At outside region: want false")
+	}
+
+	count := 0
+	region.ForEach(func(pos BlockPos, block Block) bool {
+		if block.Name == "minecraft:stone" {
+			count++
+		}
+		return true
+	})
+	if count != 71*2*3 {
+		t.Fatalf("stone count = %d, want %d", count, 71*2*3)
+	}
+}
+
+func TestWorldGetBlocksBounds(t *testing.T) {
+	host := &fakeStructureHost{grow: 1}
+	host.world = NewWorld(host.send)
+	if _, err := host.world.GetBlocks(BlockPos{0, 60, 0}, BlockPos{3, 63, 3}); err == nil {
+		t.Fatalf("GetBlocks with oversized structure: want error")
+	}
+
+	host = &fakeStructureHost{}
+	host.world = NewWorld(host.send)
+	if _, err := host.world.GetBlocks(BlockPos{0, 0, 0}, BlockPos{1023, 255, 1023}); err == nil {
+		t.Fatalf("GetBlocks above MaxBlockQueryVolume: want error")
+	}
+	if _, err := host.world.GetBlocks(BlockPos{math.MinInt, 0, 0}, BlockPos{math.MaxInt, 0, 0}); err == nil {
+		t.Fatalf("GetBlocks with overflowing size: want error")
+	}
+	if host.requests != 0 {
+		t.Fatalf("requests = %d, want 0", host.requests)
+	}
+}
+
+func TestGameUtilsGetBlock(t *testing.T) {
+	utils := NewGameUtils(nil)
+	if _, err := utils.GetBlocks(BlockPos{}, BlockPos{}); err == nil {
+		t.Fatalf("GetBlocks without world: want error")
+	}
+
+	host := &fakeStructureHost{}
+	host.world = NewWorld(host.send)
+	utils.SetWorld(host.world)
+	if name, err := utils.GetBlock(0, 10, 0); err != nil || name != "minecraft:stone" {
+		t.Fatalf("GetBlock = %q, %v", name, err)
+	}
+
+	// 主进程不响应时超时
+	silent := NewWorld(func(uint32, interface{}) error { return nil })
+	silent.SetTimeout(10 * time.Millisecond)
+	utils.SetWorld(silent)
+	if _, err := utils.GetBlockState(0, 10, 0); err == nil {
+		t.Fatalf("GetBlockState without response: want error")
+	}
+}
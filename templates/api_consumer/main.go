@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 
+	"github.com/hashicorp/go-plugin"
 	sdk "github.com/maoqijie/FIN-plugin/sdk"
 )
 
@@ -11,15 +12,22 @@ type APIConsumerPlugin struct {
 	ctx *sdk.Context
 }
 
-// ExampleAPI 定义 API 接口（可选，用于类型安全和 IDE 提示）
-// 实际使用时，可以从 API 插件包导入，或定义接口契约
-type ExampleAPI interface {
-	Greet(name string) string
-	SendMessage(message string) error
-	GetServerStatus() map[string]interface{}
-	CalculateDistance(x1, y1, z1, x2, y2, z2 float32) float32
+// Point 三维坐标，与 example-api 约定的 JSON 结构一致
+type Point struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
 }
 
+// DistanceArgs CalculateDistance 的参数
+type DistanceArgs struct {
+	From Point `json:"from"`
+	To   Point `json:"to"`
+}
+
+// requiredVersion 本插件需要的 example-api 版本
+var requiredVersion = sdk.PluginAPIVersion{Major: 0, Minor: 1, Patch: 0}
+
 // Init 插件初始化
 func (p *APIConsumerPlugin) Init(ctx *sdk.Context) error {
 	p.ctx = ctx
@@ -43,23 +51,15 @@ func (p *APIConsumerPlugin) Init(ctx *sdk.Context) error {
 
 // onPreload 预加载回调
 func (p *APIConsumerPlugin) onPreload() {
-	// 方式 1: 获取 API 不检查版本
-	api, version, err := p.ctx.GetPluginAPI("example-api")
+	// 获取 API 客户端（不检查版本）
+	client, err := p.ctx.GetPluginAPIClient("example-api")
 	if err != nil {
 		p.ctx.Logf("§c获取 example-api 失败: %v", err)
 		p.ctx.Logf("§e请确保 example-api 插件已加载")
 		return
 	}
 
-	p.ctx.Logf("§a成功获取 example-api，版本: %s", version.String())
-
-	// 方式 2: 使用类型断言调用方法
-	// 注意: 这里需要知道实际的插件类型，或使用接口定义
-	// 如果有 example-api 的源码，可以直接导入其类型
-
-	// 使用反射或类型断言调用方法
-	// 这里演示直接调用（需要在实际环境中根据具体类型调整）
-	p.ctx.Logf("§aAPI 插件实例获取成功，可以通过类型断言调用方法")
+	p.ctx.Logf("§a成功获取 example-api，版本: %s，方法: %v", client.Version().String(), client.Methods())
 
 	// 列出所有可用的 API
 	apis := p.ctx.ListPluginAPIs()
@@ -71,40 +71,39 @@ func (p *APIConsumerPlugin) onPreload() {
 
 // handleTestAPI 测试 API 命令处理器
 func (p *APIConsumerPlugin) handleTestAPI(args []string) error {
-	// 方式 3: 使用版本检查获取 API
-	api, err := p.ctx.GetPluginAPIWithVersion("example-api", sdk.PluginAPIVersion{
-		Major: 0,
-		Minor: 0,
-		Patch: 1,
-	})
+	// 使用版本检查获取 API 客户端，之后的每次调用都会重新检查版本
+	client, err := p.ctx.GetPluginAPIClientWithVersion("example-api", requiredVersion)
 	if err != nil {
 		return fmt.Errorf("获取 API 失败: %w", err)
 	}
 
 	p.ctx.Logf("§a成功获取 example-api (版本检查通过)")
 
-	// 使用类型断言调用方法
-	// 注意: 实际使用时需要根据 API 插件的实际类型进行断言
-	// 这里演示的是概念，实际需要导入 API 插件的包
-
-	// 示例: 如果能导入 API 插件类型
-	// if exampleAPI, ok := api.(*ExampleAPIPlugin); ok {
-	//     message := exampleAPI.Greet("World")
-	//     p.ctx.Logf("API 返回: %s", message)
-	//
-	//     exampleAPI.SendMessage("§e来自 API 消费者插件的消息")
-	//
-	//     status := exampleAPI.GetServerStatus()
-	//     p.ctx.Logf("服务器状态: %+v", status)
-	//
-	//     distance := exampleAPI.CalculateDistance(0, 0, 0, 10, 10, 10)
-	//     p.ctx.Logf("距离: %.2f", distance)
-	// }
-
-	// 如果无法导入类型，可以使用接口
-	// 在实际项目中，API 提供者应该定义一个接口供消费者使用
-	p.ctx.Logf("§a已获取 API 实例: %T", api)
-	p.ctx.Logf("§e提示: 在实际使用中，需要通过类型断言或接口来调用具体方法")
+	var message string
+	if err := client.CallJSON("Greet", "World", &message); err != nil {
+		return fmt.Errorf("调用 Greet 失败: %w", err)
+	}
+	p.ctx.Logf("API 返回: %s", message)
+
+	if err := client.CallJSON("SendMessage", "§e来自 API 消费者插件的消息", nil); err != nil {
+		p.ctx.Logf("§c调用 SendMessage 失败: %v", err)
+	}
+
+	var status map[string]interface{}
+	if err := client.CallJSON("GetServerStatus", nil, &status); err != nil {
+		return fmt.Errorf("调用 GetServerStatus 失败: %w", err)
+	}
+	p.ctx.Logf("服务器状态: %+v", status)
+
+	var distance float64
+	err = client.CallJSON("CalculateDistance", DistanceArgs{
+		From: Point{0, 0, 0},
+		To:   Point{10, 10, 10},
+	}, &distance)
+	if err != nil {
+		return fmt.Errorf("调用 CalculateDistance 失败: %w", err)
+	}
+	p.ctx.Logf("距离: %.2f", distance)
 
 	return nil
 }
@@ -121,7 +120,22 @@ func (p *APIConsumerPlugin) Stop() error {
 	return nil
 }
 
-// NewPlugin 插件入口点
-func NewPlugin() sdk.Plugin {
-	return &APIConsumerPlugin{}
+// GetInfo 返回插件信息
+func (p *APIConsumerPlugin) GetInfo() sdk.PluginInfo {
+	return sdk.PluginInfo{
+		Name:        "api-consumer",
+		DisplayName: "API 消费者示例",
+		Version:     "0.1.0",
+		Description: "演示跨进程调用 example-api",
+		Author:      "作者名",
+	}
+}
+
+// main 函数作为 go-plugin 服务器运行
+func main() {
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig:  sdk.HandshakeConfig,
+		VersionedPlugins: sdk.VersionedPlugins(&APIConsumerPlugin{}),
+		GRPCServer:       plugin.DefaultGRPCServer,
+	})
 }
@@ -2,31 +2,51 @@ package main
 
 import (
 	"fmt"
+	"math"
 
+	"github.com/hashicorp/go-plugin"
 	sdk "github.com/maoqijie/FIN-plugin/sdk"
 )
 
 // ExampleAPIPlugin 示例 API 插件（前置插件）
-// 此插件提供 API 供其他插件调用
+// 此插件导出 API 方法供其他插件调用，调用方可以运行在其他进程中
 type ExampleAPIPlugin struct {
 	ctx *sdk.Context
 }
 
+// Point 三维坐标（API 参数类型，消费者需使用相同的 JSON 结构）
+type Point struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+// DistanceArgs CalculateDistance 的参数
+type DistanceArgs struct {
+	From Point `json:"from"`
+	To   Point `json:"to"`
+}
+
 // Init 插件初始化
 func (p *ExampleAPIPlugin) Init(ctx *sdk.Context) error {
 	p.ctx = ctx
 
-	// 注册为 API 插件，其他插件可以通过 "example-api" 名称获取此插件实例
-	err := ctx.RegisterPluginAPI("example-api", sdk.PluginAPIVersion{
+	// 导出 API 方法，其他插件可以通过 "example-api" 名称按方法名调用
+	err := ctx.ExportPluginAPI("example-api", sdk.PluginAPIVersion{
 		Major: 0,
-		Minor: 0,
-		Patch: 1,
-	}, p)
+		Minor: 1,
+		Patch: 0,
+	}, sdk.PluginAPIMethods{
+		"Greet":             sdk.JSONMethod(p.Greet),
+		"SendMessage":       sdk.JSONMethod(p.SendMessage),
+		"GetServerStatus":   sdk.JSONMethod(p.GetServerStatus),
+		"CalculateDistance": sdk.JSONMethod(p.CalculateDistance),
+	})
 	if err != nil {
-		return fmt.Errorf("注册 API 插件失败: %w", err)
+		return fmt.Errorf("导出 API 失败: %w", err)
 	}
 
-	ctx.Logf("示例 API 插件已注册，版本 0.0.1")
+	ctx.Logf("示例 API 插件已注册，版本 0.1.0")
 	return nil
 }
 
@@ -42,26 +62,40 @@ func (p *ExampleAPIPlugin) Stop() error {
 	return nil
 }
 
-// ===== 以下是提供给其他插件的公共 API 方法 =====
+// GetInfo 返回插件信息
+func (p *ExampleAPIPlugin) GetInfo() sdk.PluginInfo {
+	return sdk.PluginInfo{
+		Name:        "example-api",
+		DisplayName: "示例 API 插件",
+		Version:     "0.1.0",
+		Description: "导出可跨进程调用的 API 方法",
+		Author:      "作者名",
+	}
+}
+
+// ===== 以下是导出给其他插件的 API 方法 =====
 
 // Greet 向指定对象问候（示例方法）
-func (p *ExampleAPIPlugin) Greet(name string) string {
+func (p *ExampleAPIPlugin) Greet(name string) (string, error) {
 	message := fmt.Sprintf("Hello, %s!", name)
 	p.ctx.Logf("Greet 被调用: %s", message)
-	return message
+	return message, nil
 }
 
 // SendMessage 向所有玩家发送消息（示例方法）
-func (p *ExampleAPIPlugin) SendMessage(message string) error {
+func (p *ExampleAPIPlugin) SendMessage(message string) (bool, error) {
 	utils := p.ctx.GameUtils()
 	if utils == nil {
-		return fmt.Errorf("GameUtils 未初始化")
+		return false, fmt.Errorf("GameUtils 未初始化")
 	}
-	return utils.SayTo("@a", message)
+	if err := utils.SayTo("@a", message); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
-// GetServerStatus 获取服务器状态信息（示例方法）
-func (p *ExampleAPIPlugin) GetServerStatus() map[string]interface{} {
+// GetServerStatus 获取服务器状态信息（示例方法，无参数）
+func (p *ExampleAPIPlugin) GetServerStatus(struct{}) (map[string]interface{}, error) {
 	serverInfo := p.ctx.ServerInfo()
 	botInfo := p.ctx.BotInfo()
 
@@ -69,30 +103,22 @@ func (p *ExampleAPIPlugin) GetServerStatus() map[string]interface{} {
 		"bot_name":     botInfo.Name,
 		"server_code":  serverInfo.Code,
 		"has_passcode": serverInfo.PasscodeSet,
-	}
+	}, nil
 }
 
 // CalculateDistance 计算两点之间的距离（示例方法）
-func (p *ExampleAPIPlugin) CalculateDistance(x1, y1, z1, x2, y2, z2 float32) float32 {
-	dx := x2 - x1
-	dy := y2 - y1
-	dz := z2 - z1
-	return float32(sqrt(float64(dx*dx + dy*dy + dz*dz)))
-}
-
-// sqrt 计算平方根
-func sqrt(x float64) float64 {
-	if x == 0 {
-		return 0
-	}
-	z := x
-	for i := 0; i < 10; i++ {
-		z = (z + x/z) / 2
-	}
-	return z
+func (p *ExampleAPIPlugin) CalculateDistance(args DistanceArgs) (float64, error) {
+	dx := args.To.X - args.From.X
+	dy := args.To.Y - args.From.Y
+	dz := args.To.Z - args.From.Z
+	return math.Sqrt(dx*dx + dy*dy + dz*dz), nil
 }
 
-// NewPlugin 插件入口点
-func NewPlugin() sdk.Plugin {
-	return &ExampleAPIPlugin{}
+// main 函数作为 go-plugin 服务器运行
+func main() {
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig:  sdk.HandshakeConfig,
+		VersionedPlugins: sdk.VersionedPlugins(&ExampleAPIPlugin{}),
+		GRPCServer:       plugin.DefaultGRPCServer,
+	})
 }
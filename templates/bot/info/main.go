@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/go-plugin"
 	sdk "github.com/maoqijie/FIN-plugin/sdk"
 )
 
@@ -14,10 +15,10 @@ type InfoPlugin struct {
 
 func (p *InfoPlugin) Init(ctx *sdk.Context) error {
 	p.ctx = ctx
-	_ = ctx.ListenActive(func() {
+	_, _ = ctx.ListenActive(func() {
 		p.ctx.Logf("Info 插件已连接到服务器")
 	})
-	_ = ctx.ListenChat(func(evt sdk.ChatEvent) {
+	_, _ = ctx.ListenChat(func(evt *sdk.ChatEvent) {
 		if strings.TrimSpace(evt.Message) == "" {
 			return
 		}
@@ -43,6 +44,16 @@ func (p *InfoPlugin) Stop() error {
 	return nil
 }
 
+// GetInfo 返回插件信息
+func (p *InfoPlugin) GetInfo() sdk.PluginInfo {
+	return sdk.PluginInfo{
+		Name:        "info",
+		DisplayName: "Info",
+		Version:     "1.0.0",
+		Description: "查看机器人与服务器运行状态",
+	}
+}
+
 func (p *InfoPlugin) handleInfoCommand(args []string) error {
 	bot := p.ctx.BotInfo()
 	fmt.Printf("机器人昵称: %s\n", bot.Name)
@@ -62,3 +73,11 @@ func (p *InfoPlugin) handleInfoCommand(args []string) error {
 func NewPlugin() sdk.Plugin {
 	return &InfoPlugin{}
 }
+
+func main() {
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig:  sdk.HandshakeConfig,
+		VersionedPlugins: sdk.VersionedPlugins(&InfoPlugin{}),
+		GRPCServer:       plugin.DefaultGRPCServer,
+	})
+}
@@ -72,9 +72,8 @@ func (p *ExamplePlugin) GetInfo() sdk.PluginInfo {
 func main() {
 	plugin.Serve(&plugin.ServeConfig{
 		HandshakeConfig: sdk.HandshakeConfig,
-		Plugins: map[string]plugin.Plugin{
-			"plugin": &sdk.PluginGRPC{Impl: &ExamplePlugin{}},
-		},
+		// 同时提供协议版本 1 与 2，新版主进程使用事件流，旧版主进程回退到协议版本 1
+		VersionedPlugins: sdk.VersionedPlugins(&ExamplePlugin{}),
 		// 使用 gRPC 协议
 		GRPCServer: plugin.DefaultGRPCServer,
 	})